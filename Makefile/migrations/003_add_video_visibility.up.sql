@@ -0,0 +1,15 @@
+-- 影片可見度：public（公開）、unlisted（不公開，持連結可播放）、private（私人，上傳者與分享名單）
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS member_id  VARCHAR(64);
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public';
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;   -- 排程公開時間
+
+CREATE INDEX IF NOT EXISTS idx_videos_member_id ON videos(member_id);
+CREATE INDEX IF NOT EXISTS idx_videos_visibility ON videos(visibility);
+CREATE INDEX IF NOT EXISTS idx_videos_publish_at ON videos(publish_at);
+
+-- 私人影片分享名單
+CREATE TABLE IF NOT EXISTS video_shares (
+    video_id  INT         NOT NULL,
+    member_id VARCHAR(64) NOT NULL,
+    PRIMARY KEY (video_id, member_id)
+);
@@ -0,0 +1,36 @@
+-- 影片分類
+CREATE TABLE IF NOT EXISTS categories (
+    id   SERIAL PRIMARY KEY,
+    slug VARCHAR(64) NOT NULL,   -- 英文代稱
+    name VARCHAR(64) NOT NULL    -- 顯示名稱
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_slug ON categories(slug);
+
+INSERT INTO categories (slug, name) VALUES
+('music', '音樂'),
+('gaming', '遊戲'),
+('education', '教育'),
+('sports', '運動'),
+('news', '新聞'),
+('entertainment', '娛樂'),
+('technology', '科技'),
+('travel', '旅遊')
+ON CONFLICT (slug) DO NOTHING;
+
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS category_id INT;
+CREATE INDEX IF NOT EXISTS idx_videos_category_id ON videos(category_id);
+
+-- 自由標籤，名稱統一小寫
+CREATE TABLE IF NOT EXISTS tags (
+    id   SERIAL PRIMARY KEY,
+    name VARCHAR(64) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_name ON tags(name);
+
+-- 影片與標籤多對多關聯
+CREATE TABLE IF NOT EXISTS video_tags (
+    video_id INT NOT NULL,
+    tag_id   INT NOT NULL,
+    PRIMARY KEY (video_id, tag_id)
+);
+CREATE INDEX IF NOT EXISTS idx_video_tags_tag_id ON video_tags(tag_id);
@@ -0,0 +1,23 @@
+-- 播放清單，kind: custom（自建）、watch_later（每位會員內建的稍後觀看）
+CREATE TABLE IF NOT EXISTS playlists (
+    id         SERIAL PRIMARY KEY,
+    member_id  VARCHAR(64),
+    title      VARCHAR(150),
+    kind       VARCHAR(20) DEFAULT 'custom',
+    visibility VARCHAR(20) DEFAULT 'private',   -- "public", "private"
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_playlists_member_id ON playlists(member_id);
+-- 每位會員只會有一個稍後觀看
+CREATE UNIQUE INDEX IF NOT EXISTS idx_playlists_watch_later ON playlists(member_id) WHERE kind = 'watch_later';
+
+-- 播放清單項目，同一部影片在清單中只會出現一次
+CREATE TABLE IF NOT EXISTS playlist_items (
+    playlist_id INT NOT NULL,
+    video_id    INT NOT NULL,
+    position    INT NOT NULL DEFAULT 0,   -- 從 0 開始的播放順序
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (playlist_id, video_id)
+);
+CREATE INDEX IF NOT EXISTS idx_playlist_items_position ON playlist_items(position);
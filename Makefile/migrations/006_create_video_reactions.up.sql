@@ -0,0 +1,14 @@
+-- 影片按讚 / 倒讚數，與 video_reactions 於同一個交易內更新
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS like_count INT DEFAULT 0;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS dislike_count INT DEFAULT 0;
+
+-- 會員對影片的表態，每位會員對每部影片只有一筆，清除表態時刪除該筆
+CREATE TABLE IF NOT EXISTS video_reactions (
+    video_id   INT NOT NULL,
+    member_id  VARCHAR(64) NOT NULL,
+    reaction   VARCHAR(10),   -- "like", "dislike"
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (video_id, member_id)
+);
+CREATE INDEX IF NOT EXISTS idx_video_reactions_updated_at ON video_reactions(updated_at);
+CREATE INDEX IF NOT EXISTS idx_video_reactions_member_id ON video_reactions(member_id, reaction);
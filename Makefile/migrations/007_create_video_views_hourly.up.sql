@@ -0,0 +1,8 @@
+-- 每部影片每小時的瀏覽次數，供熱門排行榜計算時間衰減分數
+CREATE TABLE IF NOT EXISTS video_views_hourly (
+    video_id INT NOT NULL,
+    hour     TIMESTAMP NOT NULL,   -- 整點（UTC）
+    views    BIGINT DEFAULT 0,
+    PRIMARY KEY (video_id, hour)
+);
+CREATE INDEX IF NOT EXISTS idx_video_views_hourly_hour ON video_views_hourly(hour);
@@ -0,0 +1,11 @@
+-- 會員追蹤的頻道（上傳者），短影音動態會優先穿插追蹤頻道的新影片
+CREATE TABLE IF NOT EXISTS channel_follows (
+    member_id  VARCHAR(64) NOT NULL,
+    channel_id VARCHAR(64) NOT NULL,   -- 被追蹤的上傳者 member_id
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, channel_id)
+);
+CREATE INDEX IF NOT EXISTS idx_channel_follows_channel_id ON channel_follows(channel_id);
+
+-- 短影音動態依 ID 由新到舊列出
+CREATE INDEX IF NOT EXISTS idx_videos_type_id ON videos(type, id DESC);
@@ -0,0 +1,8 @@
+-- 上傳者設定的章節，未設定時由說明欄的 "00:00 標題" 解析
+CREATE TABLE IF NOT EXISTS video_chapters (
+    video_id INT NOT NULL,
+    seq      INT NOT NULL,            -- 章節順序，從 0 開始
+    start_ms BIGINT NOT NULL,         -- 距離影片開頭的毫秒數
+    title    VARCHAR(100),
+    PRIMARY KEY (video_id, seq)
+);
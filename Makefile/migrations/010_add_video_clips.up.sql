@@ -0,0 +1,6 @@
+-- 由其他影片剪輯出的片段，記錄來源影片與剪輯範圍（毫秒）
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS source_video_id INT;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS clip_start_ms BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS clip_end_ms BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_videos_source_video_id ON videos(source_video_id);
@@ -0,0 +1,13 @@
+-- 浮水印設定：owner 為頻道（上傳者 member_id）或 'platform'（平台浮水印，燒錄在免費方案的影片）
+CREATE TABLE IF NOT EXISTS watermarks (
+    owner      VARCHAR(64) PRIMARY KEY,
+    object_key TEXT,                      -- 圖片在 MinIO 上的 object key
+    position   VARCHAR(20),               -- top-left, top-right, bottom-left, bottom-right
+    opacity    DOUBLE PRECISION,
+    enabled    BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP
+);
+
+-- 上傳時決定要燒錄的浮水印
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS channel_watermark BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS platform_watermark BOOLEAN NOT NULL DEFAULT FALSE;
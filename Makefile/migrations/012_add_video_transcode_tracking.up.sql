@@ -0,0 +1,6 @@
+-- 轉碼狀態追蹤：停留在 upload / processing 超過逾時的影片由 StuckJobReconciler 重新發布或標記為 failed
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS transcode_attempts INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_videos_updated_at ON videos(updated_at);
@@ -0,0 +1,16 @@
+-- 交易式外送箱：領域事件與影片記錄在同一個交易內寫入，由 OutboxRelay 發布到 RabbitMQ
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_id BIGINT,
+    event_type VARCHAR(64) NOT NULL,
+    topic VARCHAR(128) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INT DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate_id ON outbox_events(aggregate_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_status ON outbox_events(status);
@@ -0,0 +1,9 @@
+-- 儲存生命週期：ready 後處理原始檔、長期沒有瀏覽的影片移到 archive/ 冷儲存
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS ready_at TIMESTAMP;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS original_state VARCHAR(20) NOT NULL DEFAULT 'stored';
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS storage_tier VARCHAR(20) NOT NULL DEFAULT 'hot';
+
+-- 既有的 ready 影片以最後更新時間作為轉碼完成時間
+UPDATE videos SET ready_at = updated_at WHERE status = 'ready' AND ready_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_videos_ready_at ON videos(ready_at);
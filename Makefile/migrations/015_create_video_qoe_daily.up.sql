@@ -0,0 +1,16 @@
+-- 播放 QoE：播放器回報的 beacon 依影片、rendition、UTC 日期累加，用來找出表現不佳的 rendition 或轉碼設定
+CREATE TABLE IF NOT EXISTS video_qoe_daily (
+    video_id BIGINT NOT NULL,
+    rendition VARCHAR(32) NOT NULL,
+    day DATE NOT NULL,
+    plays BIGINT DEFAULT 0,
+    startup_ms_sum BIGINT DEFAULT 0,
+    rebuffer_count BIGINT DEFAULT 0,
+    rebuffer_ms_sum BIGINT DEFAULT 0,
+    bitrate_switches BIGINT DEFAULT 0,
+    errors BIGINT DEFAULT 0,
+    dropped_frames BIGINT DEFAULT 0,
+    PRIMARY KEY (video_id, rendition, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_video_qoe_daily_day ON video_qoe_daily(day);
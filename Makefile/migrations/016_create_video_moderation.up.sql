@@ -0,0 +1,42 @@
+-- 檢舉與審核：管理員下架的影片狀態為 blocked；隱藏與分級另以欄位標示，不影響上傳者設定的可見度
+-- 分級：上傳者設定 all / 13+ / 18+，管理員年齡限制或覆寫後鎖定
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS content_rating VARCHAR(8) NOT NULL DEFAULT 'all';
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS rating_locked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_videos_content_rating ON videos (content_rating);
+
+CREATE TABLE IF NOT EXISTS video_reports (
+    id BIGSERIAL PRIMARY KEY,
+    video_id BIGINT NOT NULL,
+    reporter_id VARCHAR(64) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    detail TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    claimed_by VARCHAR(64),
+    claimed_at TIMESTAMP,
+    resolution VARCHAR(20),
+    resolved_by VARCHAR(64),
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_video_reports_video_id ON video_reports(video_id);
+CREATE INDEX IF NOT EXISTS idx_video_reports_reporter_id ON video_reports(reporter_id);
+CREATE INDEX IF NOT EXISTS idx_video_reports_status ON video_reports(status);
+CREATE INDEX IF NOT EXISTS idx_video_reports_created_at ON video_reports(created_at);
+
+-- 稽核紀錄：每次認領與處理各一筆，只新增不修改
+CREATE TABLE IF NOT EXISTS moderation_audits (
+    id BIGSERIAL PRIMARY KEY,
+    report_id BIGINT NOT NULL,
+    video_id BIGINT NOT NULL,
+    actor_id VARCHAR(64) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_audits_report_id ON moderation_audits(report_id);
+CREATE INDEX IF NOT EXISTS idx_moderation_audits_video_id ON moderation_audits(video_id);
+CREATE INDEX IF NOT EXISTS idx_moderation_audits_created_at ON moderation_audits(created_at);
@@ -0,0 +1,3 @@
+-- 影片分級（欄位見 016）：會員以生日或成年驗證決定可觀看的分級
+ALTER TABLE member ADD COLUMN IF NOT EXISTS birthdate DATE DEFAULT NULL;
+ALTER TABLE member ADD COLUMN IF NOT EXISTS age_verified BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- 動畫預覽：轉碼時由影片具代表性的片段產生無聲低碼率 MP4，存於 processed/{id}/preview.mp4
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS has_preview BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,16 @@
+-- 縮圖：轉碼時依場景變化、亮度與對比挑選的候選縮圖，以及上傳者上傳的自訂縮圖
+-- 各尺寸圖片存於 processed/{video_id}/thumb_{name}_{size}.jpg
+CREATE TABLE IF NOT EXISTS video_thumbnails (
+    id BIGSERIAL PRIMARY KEY,
+    video_id BIGINT NOT NULL,
+    name VARCHAR(32) NOT NULL,
+    source VARCHAR(10) NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    time_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_video_thumbnails_video_id ON video_thumbnails(video_id);
+
+-- 使用中的縮圖名稱，空值表示使用轉碼時擷取的 poster.jpg
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS thumbnail_name VARCHAR(32) NOT NULL DEFAULT '';
@@ -0,0 +1,24 @@
+-- 離線下載：依畫質產生的 faststart MP4 快取在 downloads/{video_id}/{quality}.mp4
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS downloads_disabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS video_downloads (
+    video_id BIGINT NOT NULL,
+    quality VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    object_key TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (video_id, quality)
+);
+
+-- 發出的下載連結，用來計算每位會員 24 小時內的下載次數
+CREATE TABLE IF NOT EXISTS download_grants (
+    id BIGSERIAL PRIMARY KEY,
+    member_id VARCHAR(64) NOT NULL,
+    video_id BIGINT NOT NULL,
+    quality VARCHAR(10) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_grants_member_id ON download_grants(member_id);
+CREATE INDEX IF NOT EXISTS idx_download_grants_created_at ON download_grants(created_at);
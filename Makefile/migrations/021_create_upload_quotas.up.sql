@@ -0,0 +1,39 @@
+-- 上傳配額：原始檔大小計入上傳者的儲存量，刪除影片時釋放
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS size_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- 管理員為會員個別設定的配額，整組取代角色配額，0 表示不限制
+CREATE TABLE IF NOT EXISTS member_quotas (
+    member_id VARCHAR(64) PRIMARY KEY,
+    max_storage_bytes BIGINT NOT NULL DEFAULT 0,
+    max_videos BIGINT NOT NULL DEFAULT 0,
+    max_uploads_per_day BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS quota_usages (
+    member_id VARCHAR(64) PRIMARY KEY,
+    stored_bytes BIGINT NOT NULL DEFAULT 0,
+    video_count BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- 上傳記錄，接收檔案前預留配額時建立，video_id 在影片建立後填入，上傳失敗時刪除
+-- 用來計算每位會員 24 小時內的上傳次數
+CREATE TABLE IF NOT EXISTS upload_records (
+    id BIGSERIAL PRIMARY KEY,
+    member_id VARCHAR(64) NOT NULL,
+    video_id BIGINT NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_upload_records_member_id ON upload_records(member_id);
+CREATE INDEX IF NOT EXISTS idx_upload_records_created_at ON upload_records(created_at);
+
+-- 既有影片計入影片數；上線前的影片沒有記錄大小，不計入儲存量
+INSERT INTO quota_usages (member_id, stored_bytes, video_count, updated_at)
+SELECT member_id, COALESCE(SUM(size_bytes), 0), COUNT(*), NOW()
+FROM videos
+WHERE member_id IS NOT NULL AND member_id <> ''
+GROUP BY member_id
+ON CONFLICT (member_id) DO NOTHING;
@@ -0,0 +1,7 @@
+-- 第一次按讚的時間，熱門排行榜依此計算按讚時間；改為倒讚再改回按讚時不變
+ALTER TABLE video_reactions ADD COLUMN IF NOT EXISTS liked_at TIMESTAMP;
+
+-- 既有的按讚以最後表態時間為準
+UPDATE video_reactions SET liked_at = updated_at WHERE reaction = 'like' AND liked_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_video_reactions_liked_at ON video_reactions(liked_at);
@@ -0,0 +1,3 @@
+-- 會員角色，登入時寫入 token：member（免費方案）、premium（付費方案）、admin
+-- 免費方案的上傳會燒錄平台浮水印，付費方案由訂閱流程更新此欄位
+ALTER TABLE member ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member';
@@ -0,0 +1,2 @@
+-- 可公開提供的影片複製到 public/{videoID}/，bucket 與 CDN 只讀取此 prefix；既有影片由 PublishScheduler 補上副本
+ALTER TABLE videos ADD COLUMN IF NOT EXISTS public_copy BOOLEAN NOT NULL DEFAULT FALSE;
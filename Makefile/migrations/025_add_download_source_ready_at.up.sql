@@ -0,0 +1,2 @@
+-- 下載檔案記錄產生時影片的 ready_at，影片重新轉碼後快取的 MP4 會重新產生
+ALTER TABLE video_downloads ADD COLUMN IF NOT EXISTS source_ready_at TIMESTAMP;
@@ -71,6 +71,46 @@ const docTemplate = `{
                 }
             }
         },
+        "/member/birthdate": {
+            "put": {
+                "description": "会员填写生日（YYYY-MM-DD），填写后不可自行修改。生日用于分级内容的观看限制，下次登录后生效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "填寫生日",
+                "parameters": [
+                    {
+                        "description": "生日，member_id 由 token 取得",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/member.SetBirthdateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "填写成功",
+                        "schema": {
+                            "$ref": "#/definitions/member.SetBirthdateRes"
+                        }
+                    },
+                    "400": {
+                        "description": "请求错误",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/member/find": {
             "get": {
                 "description": "根据邮箱查找用户信息",
@@ -250,9 +290,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/streaming/recommendations": {
+        "/member/{member_id}/age-verification": {
+            "post": {
+                "description": "仅限管理员，设置会员是否已验证成年，会员下次登录后生效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "summary": "驗證會員成年",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "是否已验证成年，member_id 由路径取得",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/member.SetAgeVerifiedReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "设置成功",
+                        "schema": {
+                            "$ref": "#/definitions/member.SetAgeVerifiedRes"
+                        }
+                    },
+                    "400": {
+                        "description": "请求错误",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/admin/moderation/audit": {
             "get": {
-                "description": "Retrieves recommended videos based on view counts.",
+                "description": "Admin only. Lists claims and resolutions of a video or a report, newest first. At least one of video_id and report_id is required.",
                 "consumes": [
                     "application/json"
                 ],
@@ -260,23 +353,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Moderation"
                 ],
-                "summary": "Get recommended videos",
+                "summary": "List moderation audit trail",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Number of recommendations",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "report_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Recommendations response",
+                        "description": "List moderation audit response",
                         "schema": {
-                            "$ref": "#/definitions/streaming.GetRecommendationsRes"
+                            "$ref": "#/definitions/streaming.ListModerationAuditRes"
                         }
                     },
                     "400": {
@@ -284,13 +394,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/streaming/search": {
-            "get": {
-                "description": "Searches for videos by keyword.",
+        "/streaming/admin/quota/{member_id}": {
+            "put": {
+                "description": "Admin only. Replaces the member's role-based limits with the given limits; 0 means unlimited.",
                 "consumes": [
                     "application/json"
                 ],
@@ -298,23 +414,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Quota"
                 ],
-                "summary": "Search videos",
+                "summary": "Set a member's upload quota",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search keyword",
-                        "name": "key_word",
-                        "in": "query",
+                        "description": "Member ID",
+                        "name": "member_id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Limits",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetMemberQuotaBody"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Search response",
+                        "description": "Set member quota response",
                         "schema": {
-                            "$ref": "#/definitions/streaming.SearchRes"
+                            "$ref": "#/definitions/streaming.SetMemberQuotaRes"
                         }
                     },
                     "400": {
@@ -322,58 +447,60 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/streaming/upload": {
-            "post": {
-                "description": "Uploads a video file by first sending video metadata then streaming video chunks",
+        "/streaming/admin/reports": {
+            "get": {
+                "description": "Admin only. Lists reports with the given status, oldest first.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Moderation"
                 ],
-                "summary": "Upload Video via gRPC streaming",
+                "summary": "List moderation queue",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Video Title",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
+                        "description": "open, claimed or resolved (default open)",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Video Description",
-                        "name": "description",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Only reports of this video",
+                        "name": "video_id",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Video Type (short or long)",
-                        "name": "type",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "Video File",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Upload success response",
+                        "description": "List reports response",
                         "schema": {
-                            "$ref": "#/definitions/streaming.UploadVideoRes"
+                            "$ref": "#/definitions/streaming.ListReportsRes"
                         }
                     },
                     "400": {
@@ -382,8 +509,8 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
@@ -391,33 +518,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/streaming/video/hls/{video_id}/index": {
-            "get": {
-                "description": "Retrieves the m3u8 playlist file content.",
+        "/streaming/admin/reports/{report_id}/claim": {
+            "post": {
+                "description": "Admin only. Claims a report so that other admins cannot claim it for 30 minutes. The claim is recorded in the audit trail.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/vnd.apple.mpegurl"
+                    "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Moderation"
                 ],
-                "summary": "Get HLS index (m3u8) playlist",
+                "summary": "Claim a report",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID",
-                        "name": "video_id",
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "report_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "m3u8 playlist content",
+                        "description": "Report response",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/streaming.ReportRes"
                         }
                     },
                     "400": {
@@ -425,44 +552,52 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/streaming/video/hls/{video_id}/{segment}": {
-            "get": {
-                "description": "Retrieves a TS segment file content for video streaming.",
+        "/streaming/admin/reports/{report_id}/resolve": {
+            "post": {
+                "description": "Admin only. Resolves a report claimed by the caller. age_restrict rates the video 18+ and locks the rating, hide removes the video from every list and only lets the uploader play it, remove blocks the video so nobody can play it. Other pending reports of the same video are resolved with the same action. Every resolution is recorded in the audit trail.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "video/mp2t"
+                    "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Moderation"
                 ],
-                "summary": "Get HLS segment (TS file)",
+                "summary": "Resolve a report",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Video ID",
-                        "name": "video_id",
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "report_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Segment filename",
-                        "name": "segment",
-                        "in": "path",
-                        "required": true
+                        "description": "Resolution",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ResolveReportBody"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "TS segment file content",
+                        "description": "Report response",
                         "schema": {
-                            "type": "bytes"
+                            "$ref": "#/definitions/streaming.ReportRes"
                         }
                     },
                     "400": {
@@ -470,13 +605,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/streaming/video/{video_id}": {
+        "/streaming/admin/watermark": {
             "get": {
-                "description": "Retrieves video streaming info including the HLS URL for playback.",
+                "description": "Admin only. Retrieves the platform-wide watermark settings for free-tier content.",
                 "consumes": [
                     "application/json"
                 ],
@@ -484,23 +625,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Streaming"
+                    "Watermark"
                 ],
-                "summary": "Get video streaming info",
+                "summary": "Get platform watermark",
+                "responses": {
+                    "200": {
+                        "description": "Watermark response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.WatermarkRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Admin only. Sets the platform-wide watermark burned into videos uploaded by free-tier members.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watermark"
+                ],
+                "summary": "Set platform watermark",
                 "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Watermark image, required the first time",
+                        "name": "image",
+                        "in": "formData"
+                    },
                     {
                         "type": "string",
-                        "description": "Video ID",
-                        "name": "video_id",
-                        "in": "path",
-                        "required": true
+                        "description": "top-left, top-right, bottom-left or bottom-right; omitted keeps the current setting (default bottom-right the first time)",
+                        "name": "position",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Opacity between 0 and 1; omitted keeps the current setting (default 0.6 the first time)",
+                        "name": "opacity",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether the watermark is enabled; omitted keeps the current setting (default true the first time)",
+                        "name": "enabled",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Get video response",
+                        "description": "Watermark response",
                         "schema": {
-                            "$ref": "#/definitions/streaming.GetVideoRes"
+                            "$ref": "#/definitions/streaming.WatermarkRes"
                         }
                     },
                     "400": {
@@ -509,90 +700,3472 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Video not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "member.LoginReq": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
+        },
+        "/streaming/categories": {
+            "get": {
+                "description": "Lists video categories with pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "List categories",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page (from 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List categories response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListCategoriesRes"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/categories/{category_id}": {
+            "get": {
+                "description": "Lists public videos of a category with pagination, ordered by view count. Only ratings the caller may watch are listed; guests see general-audience videos only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Browse videos of a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page (from 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Browse category response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.BrowseCategoryRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/channel/watermark": {
+            "get": {
+                "description": "Retrieves the watermark settings of the current member's channel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watermark"
+                ],
+                "summary": "Get channel watermark",
+                "responses": {
+                    "200": {
+                        "description": "Watermark response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.WatermarkRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Uploads the channel watermark image (PNG or JPEG, up to 1MB) and sets its position and opacity. The image may be omitted to only change settings. Uploads choose whether to burn it in with the watermark form field.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watermark"
+                ],
+                "summary": "Set channel watermark",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Watermark image, required the first time",
+                        "name": "image",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "top-left, top-right, bottom-left or bottom-right; omitted keeps the current setting (default bottom-right the first time)",
+                        "name": "position",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Opacity between 0 and 1; omitted keeps the current setting (default 0.6 the first time)",
+                        "name": "opacity",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether the watermark is enabled; omitted keeps the current setting (default true the first time)",
+                        "name": "enabled",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Watermark response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.WatermarkRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/channels/{channel_id}/follow": {
+            "post": {
+                "description": "Follows the channel of an uploader; new shorts of followed channels are mixed into the shorts feed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shorts"
+                ],
+                "summary": "Follow a channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Channel (uploader member) ID",
+                        "name": "channel_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Follow channel response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.FollowChannelRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops following the channel of an uploader.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shorts"
+                ],
+                "summary": "Unfollow a channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Channel (uploader member) ID",
+                        "name": "channel_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unfollow channel response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.FollowChannelRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/comments/{comment_id}": {
+            "delete": {
+                "description": "Deletes a comment posted by the current member. A top-level comment with replies keeps its thread and only its content is removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Delete my comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete comment response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.DeleteCommentRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Edits the content of a comment posted by the current member.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Edit my comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CommentBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Edit comment response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.EditCommentRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/comments/{comment_id}/pin": {
+            "post": {
+                "description": "Pins a top-level comment on the current member's video, replacing any previously pinned comment. Set pinned to false to unpin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Pin / unpin a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pin",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PinCommentBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pin comment response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.PinCommentRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/comments/{comment_id}/replies": {
+            "get": {
+                "description": "Lists replies of a top-level comment in chronological order with cursor pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "List comment replies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List replies response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListCommentsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/liked": {
+            "get": {
+                "description": "Lists videos liked by the current member with pagination, most recently liked first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "List liked videos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page (from 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List liked videos response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListLikedVideosRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/playlists": {
+            "get": {
+                "description": "Lists playlists of the current member. The built-in \"Watch later\" list is always first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "List my playlists",
+                "responses": {
+                    "200": {
+                        "description": "List playlists response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListPlaylistsRes"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a playlist owned by the current member. Visibility defaults to private.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Create playlist",
+                "parameters": [
+                    {
+                        "description": "Playlist",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlaylistBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create playlist response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.CreatePlaylistRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/playlists/{playlist_id}": {
+            "get": {
+                "description": "Retrieves a playlist with its items in play order, including playback info. Use \"watch_later\" as playlist_id for the current member's watch later list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Get playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID or watch_later",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get playlist response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetPlaylistRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a playlist. The built-in \"Watch later\" list cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Delete playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete playlist response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.DeletePlaylistRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Renames a playlist or changes its visibility. Empty fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Update playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Playlist",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlaylistBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update playlist response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UpdatePlaylistRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/playlists/{playlist_id}/items": {
+            "put": {
+                "description": "Sets the play order of a playlist. video_ids must contain every video of the playlist exactly once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Reorder playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID or watch_later",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReorderPlaylistBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reorder playlist response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ReorderPlaylistRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Appends a video to the end of a playlist. Use \"watch_later\" as playlist_id for the watch later list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Add video to playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID or watch_later",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Video",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlaylistItemBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Add playlist item response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.AddPlaylistItemRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/playlists/{playlist_id}/items/{video_id}": {
+            "delete": {
+                "description": "Removes a video from a playlist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playlist"
+                ],
+                "summary": "Remove video from playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Playlist ID or watch_later",
+                        "name": "playlist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Remove playlist item response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.RemovePlaylistItemRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/qoe": {
+            "post": {
+                "description": "Accepts a batch of up to 200 player beacons (startup time, rebuffer, bitrate switch, error, dropped frames). Beacons are queued and aggregated asynchronously; invalid beacons are skipped and counted as rejected. A beacon is invalid if its video does not exist, its rendition is not default or one of 144p, 240p, 360p, 480p, 720p, 1080p, 1440p, 2160p, or its event time is more than 24 hours old. Each startup beacon counts as one view of the video.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Report playback QoE beacons",
+                "parameters": [
+                    {
+                        "description": "Beacons",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReportQoEBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Report QoE response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ReportQoERes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/quota": {
+            "get": {
+                "description": "Returns the caller's upload limits (total stored bytes, number of videos and uploads per 24 hours, 0 meaning unlimited) and current usage. Limits come from the caller's role unless an admin has set a per-member quota.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quota"
+                ],
+                "summary": "Get upload quota and usage",
+                "responses": {
+                    "200": {
+                        "description": "Quota usage",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetQuotaUsageRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/recommendations": {
+            "get": {
+                "description": "Retrieves recommended videos based on view counts. Only ratings the caller is old enough to watch are recommended; guests only get videos rated for all ages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get recommended videos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of recommendations",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated preferred tags",
+                        "name": "tags",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recommendations response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetRecommendationsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/search": {
+            "get": {
+                "description": "Searches for videos by keyword. Only ratings the caller is old enough to watch are listed; guests only see videos rated for all ages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Search videos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "key_word",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.SearchRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/shorts": {
+            "get": {
+                "description": "Returns an endless, deduplicated page of short videos mixing followed channels, trending and fresh shorts. Pass next_cursor of the previous page to continue; each item carries poster and first-segment URLs for prefetching. Only ratings the caller may watch are listed; guests see general-audience shorts only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shorts"
+                ],
+                "summary": "Get shorts feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of shorts",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shorts feed response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetShortsFeedRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/trending": {
+            "get": {
+                "description": "Lists trending videos ranked by time-decayed views, likes and comments of the last 48 hours, optionally filtered by category and type.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get trending videos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Video type (short, long)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page (from 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Trending videos response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetTrendingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/upload": {
+            "post": {
+                "description": "Uploads a video file by first sending video metadata then streaming video chunks. Upload quotas (total stored bytes, number of videos and uploads per 24 hours) are checked before the file is accepted; see GET /streaming/quota.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Upload Video via gRPC streaming",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video Title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Video Description",
+                        "name": "description",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Video Type (short or long)",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Video File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Visibility (public, unlisted or private)",
+                        "name": "visibility",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Scheduled publish time (unix seconds)",
+                        "name": "publish_at",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags",
+                        "name": "tags",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Burn the channel watermark into the video",
+                        "name": "watermark",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Content rating (all, 13+ or 18+, defaults to all)",
+                        "name": "content_rating",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload success response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UploadVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Video count or daily upload quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UploadVideoRes"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "507": {
+                        "description": "Storage quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UploadVideoRes"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/uploads": {
+            "get": {
+                "description": "Lists videos uploaded by the current member, including clips and videos still being transcoded, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "List my uploads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page (from 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List uploads response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListUploadsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/hls/{video_id}/index": {
+            "get": {
+                "description": "Retrieves the m3u8 playlist file content. Videos rated 13+ or 18+ are refused to guests and to members under that age.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.apple.mpegurl"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get HLS index (m3u8) playlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "m3u8 playlist content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/hls/{video_id}/{segment}": {
+            "get": {
+                "description": "Retrieves a TS segment file content for video streaming. The poster image (poster.jpg), thumbnails (thumb_{name}_{size}.jpg) and the hover preview (preview.mp4) are served from the same path. Videos rated 13+ or 18+ are refused to guests and to members under that age.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "video/mp2t"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get HLS segment (TS file)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Segment filename",
+                        "name": "segment",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "TS segment file content",
+                        "schema": {
+                            "type": "bytes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}": {
+            "get": {
+                "description": "Retrieves video streaming info including the HLS URL for playback and a list of candidate playback URLs (CDN, public bucket, gateway) to fall back through in order. Videos rated 13+ or 18+ are refused to guests and to members under that age; the age comes from the birthdate or verified-age flag in the token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get video streaming info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client region for region-specific CDN hosts (defaults to the X-Region header)",
+                        "name": "region",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Video not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Uploader or admin only. Deletes the video together with its tags, shares, chapters, playlist entries, reactions, thumbnails, downloads and view statistics, and releases its stored bytes and video count from the uploader's quota; uploads already counted in the last 24 hours are not released. The public copy is removed immediately; other stored objects are reclaimed by the orphan object collector.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Delete a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.DeleteVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/chapters": {
+            "put": {
+                "description": "Replaces the chapters of a video. The first chapter must start at 0 and start times must increase. An empty list falls back to chapters parsed from \"00:00 Title\" lines of the description. Only the uploader may change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Set video chapters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Chapters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetChaptersBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set chapters response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.SetChaptersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/chapters.vtt": {
+            "get": {
+                "description": "Retrieves the chapters of a video as a WebVTT track for \u003ctrack kind=\"chapters\"\u003e.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/vtt"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get WebVTT chapters track",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "WebVTT content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/clips": {
+            "post": {
+                "description": "Cuts a 15-60 second clip out of a ready video. The clip becomes a new short video linked to its source, keeps the source's visibility and content rating, goes through transcoding like a normal upload and is listed under the clipper's uploads.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Create a clip",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clip range",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateClipBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Create clip response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.CreateClipRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/comments": {
+            "get": {
+                "description": "Lists top-level comments of a video with cursor pagination. The pinned comment is returned separately on the first page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "List video comments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "top (default) or newest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List comments response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListCommentsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Posts a comment on a video, or a reply when parent_id is set. The video owner is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Post a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CommentBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post comment response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.PostCommentRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/download": {
+            "put": {
+                "description": "Uploader only. Disabling downloads stops new download links from being issued to other members; links already issued stay valid until they expire.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Download"
+                ],
+                "summary": "Enable or disable downloads of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Download setting",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetDownloadEnabledBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set download enabled response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.SetDownloadEnabledRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Returns a short-lived signed link to a faststart MP4 of the video at the requested quality. The file is produced on demand and cached; while it is being produced the response is 202 with status \"preparing\" and a Retry-After header, and the client should request again. Each member can obtain 10 download links per 24 hours. Uploaders can disable downloads of their videos.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Download"
+                ],
+                "summary": "Request an offline download",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quality",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RequestDownloadBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Download link",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.RequestDownloadRes"
+                        }
+                    },
+                    "202": {
+                        "description": "Download is being prepared",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.RequestDownloadRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Download quota exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.RequestDownloadRes"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/qoe": {
+            "get": {
+                "description": "Returns startup time, rebuffering, bitrate switches, errors and dropped frames of a video over the last days, overall and per rendition. Only the uploader or an admin may view it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get playback QoE summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of days including today (default 7, max 90)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "QoE summary response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetQoESummaryRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/rating": {
+            "put": {
+                "description": "The uploader can change the rating (all, 13+ or 18+) unless an admin has locked it. An admin can override the rating of any video, which locks it and is recorded in the audit trail.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Set the content rating of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rating",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetContentRatingBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set content rating response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.SetContentRatingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/reaction": {
+            "post": {
+                "description": "Sets the current member's reaction to a video. An empty reaction clears it. Sending the same reaction again does not count twice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Like / dislike a video",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReactionBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "React to video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ReactToVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/reactions": {
+            "get": {
+                "description": "Retrieves like / dislike counts of a video and the current member's reaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reaction"
+                ],
+                "summary": "Get video reactions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Get video reactions response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetVideoReactionsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/related": {
+            "get": {
+                "description": "Retrieves videos sharing the most tags with the given video, filled up with popular videos of the same category. Only ratings the caller may watch are listed; guests see general-audience videos only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Get related videos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of related videos",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Related videos response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.GetRelatedVideosRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/report": {
+            "post": {
+                "description": "Reports a video for moderation with a reason code. The reason \"other\" requires a detail. A member cannot report their own video or report the same video again while a previous report is pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Moderation"
+                ],
+                "summary": "Report a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Report",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReportVideoBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Report video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ReportVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/share": {
+            "post": {
+                "description": "Adds members to the share list of a private video. Only the uploader may change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Share a private video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Members to share with",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ShareVideoBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Share video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ShareVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes members from the share list of a private video. Only the uploader may change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Unshare a private video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Members to remove",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ShareVideoBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unshare video response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UnshareVideoRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/thumbnail": {
+            "put": {
+                "description": "Uploader only. Makes a candidate or custom thumbnail the poster of the video. A custom thumbnail stays active when the video is transcoded again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Thumbnail"
+                ],
+                "summary": "Choose the active thumbnail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Thumbnail",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SetActiveThumbnailBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thumbnail response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ThumbnailRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/thumbnails": {
+            "get": {
+                "description": "Uploader only. Lists the candidate thumbnails picked during transcoding (scored by scene change, brightness and contrast, best first) followed by uploaded custom thumbnails. Each thumbnail has large (1280x720), medium (640x360) and small (320x180) images.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Thumbnail"
+                ],
+                "summary": "List thumbnails of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List thumbnails response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ListThumbnailsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Uploader only. Uploads a PNG or JPEG image (up to 2MB, at least 640x360), which is resized into every thumbnail size. Use the thumbnail endpoint to make it active. A video keeps at most 5 custom thumbnails.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Thumbnail"
+                ],
+                "summary": "Upload a custom thumbnail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Thumbnail image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Thumbnail response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.ThumbnailRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/streaming/video/{video_id}/visibility": {
+            "post": {
+                "description": "Sets visibility (public, unlisted, private) and optional scheduled publish time. Only the uploader may change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streaming"
+                ],
+                "summary": "Update video visibility",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visibility setting",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateVisibilityBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update visibility response",
+                        "schema": {
+                            "$ref": "#/definitions/streaming.UpdateVisibilityRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.ChapterBody": {
+            "type": "object",
+            "properties": {
+                "start_ms": {
+                    "description": "距離影片開頭的毫秒數",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CommentBody": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "回覆的留言 ID，空值為頂層留言（僅新增時使用）",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateClipBody": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "end_ms": {
+                    "description": "片段在來源影片中的結束時間（毫秒），長度需介於 15 到 60 秒",
+                    "type": "integer"
+                },
+                "start_ms": {
+                    "description": "片段在來源影片中的開始時間（毫秒）",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "空值時使用來源影片標題",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PinCommentBody": {
+            "type": "object",
+            "properties": {
+                "pinned": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.PlaylistBody": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "description": "\"public\", \"private\"",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PlaylistItemBody": {
+            "type": "object",
+            "properties": {
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.QoEBeaconBody": {
+            "type": "object",
+            "properties": {
+                "at_ms": {
+                    "description": "事件發生時間（unix 毫秒），0 為收到的時間，不可早於 24 小時前",
+                    "type": "integer"
+                },
+                "error_code": {
+                    "description": "error 事件的錯誤代碼",
+                    "type": "string"
+                },
+                "event": {
+                    "description": "startup, rebuffer, bitrate_switch, error, dropped_frames",
+                    "type": "string"
+                },
+                "rendition": {
+                    "description": "144p ~ 2160p，空值為 default",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "startup / rebuffer 為毫秒，dropped_frames 為影格數",
+                    "type": "integer"
+                },
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ReactionBody": {
+            "type": "object",
+            "properties": {
+                "reaction": {
+                    "description": "\"like\", \"dislike\"，空值代表清除",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ReorderPlaylistBody": {
+            "type": "object",
+            "properties": {
+                "video_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handlers.ReportQoEBody": {
+            "type": "object",
+            "properties": {
+                "beacons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.QoEBeaconBody"
+                    }
+                }
+            }
+        },
+        "handlers.ReportVideoBody": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "reason 為 other 時必填，1000 字以內",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "spam, harassment, hate, violence, sexual, child_safety, copyright, misinformation, other",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RequestDownloadBody": {
+            "type": "object",
+            "properties": {
+                "quality": {
+                    "description": "1080p, 720p, 480p, 360p（預設 720p）",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ResolveReportBody": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "dismiss, age_restrict, hide, remove",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "寫入稽核紀錄，1000 字以內",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.SetActiveThumbnailBody": {
+            "type": "object",
+            "properties": {
+                "thumbnail_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.SetChaptersBody": {
+            "type": "object",
+            "properties": {
+                "chapters": {
+                    "description": "空值代表清除自訂章節，改回由說明欄解析",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ChapterBody"
+                    }
+                }
+            }
+        },
+        "handlers.SetContentRatingBody": {
+            "type": "object",
+            "properties": {
+                "content_rating": {
+                    "description": "all, 13+, 18+",
+                    "type": "string"
+                },
+                "note": {
+                    "description": "管理員覆寫時寫入稽核紀錄，1000 字以內",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.SetDownloadEnabledBody": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.SetMemberQuotaBody": {
+            "type": "object",
+            "properties": {
+                "max_storage_bytes": {
+                    "type": "integer"
+                },
+                "max_uploads_per_day": {
+                    "type": "integer"
+                },
+                "max_videos": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.ShareVideoBody": {
+            "type": "object",
+            "properties": {
+                "member_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.UpdateVisibilityBody": {
+            "type": "object",
+            "properties": {
+                "publish_at": {
+                    "description": "排程公開時間（unix 秒），0 表示不排程",
+                    "type": "integer"
+                },
+                "visibility": {
+                    "description": "\"public\", \"unlisted\", \"private\"",
+                    "type": "string"
+                }
+            }
+        },
+        "member.LoginReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 }
             }
         },
-        "member.RegisterReq": {
+        "member.RegisterReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "member.RegisterRes": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "member.SetAgeVerifiedReq": {
+            "type": "object",
+            "properties": {
+                "member_id": {
+                    "type": "string"
+                },
+                "verified": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "member.SetAgeVerifiedRes": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "member.SetBirthdateReq": {
+            "type": "object",
+            "properties": {
+                "birthdate": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "member.SetBirthdateRes": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.AddPlaylistItemRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.BrowseCategoryRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.Category": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.Chapter": {
+            "type": "object",
+            "properties": {
+                "start_ms": {
+                    "description": "距離影片開頭的毫秒數",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.Comment": {
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "description": "已刪除的留言為空值",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "description": "0 表示未修改",
+                    "type": "integer"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "空值為頂層留言",
+                    "type": "string"
+                },
+                "pinned": {
+                    "type": "boolean"
+                },
+                "reply_count": {
+                    "type": "integer"
+                },
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.CreateClipRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "video_id": {
+                    "description": "片段的影片 ID，轉碼完成前狀態為 uploaded",
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.CreatePlaylistRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "playlist": {
+                    "$ref": "#/definitions/streaming.Playlist"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.DeleteCommentRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.DeletePlaylistRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.DeleteVideoRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.EditCommentRes": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/streaming.Comment"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.FollowChannelRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.GetPlaylistRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "items": {
+                    "description": "依播放順序",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.PlaylistItem"
+                    }
+                },
+                "playlist": {
+                    "$ref": "#/definitions/streaming.Playlist"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.GetQoESummaryRes": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "overall": {
+                    "$ref": "#/definitions/streaming.QoEStats"
+                },
+                "renditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.QoEStats"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.GetQuotaUsageRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "limits": {
+                    "$ref": "#/definitions/streaming.QuotaLimits"
+                },
+                "source": {
+                    "description": "\"member\"：管理員個別設定，\"role\"：依角色",
+                    "type": "string"
+                },
+                "stored_bytes": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "uploads_today": {
+                    "description": "最近 24 小時的上傳次數",
+                    "type": "integer"
+                },
+                "video_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.GetRecommendationsRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.GetRelatedVideosRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.GetShortsFeedRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.ShortsItem"
+                    }
+                },
+                "next_cursor": {
+                    "description": "動態不會結束，永遠帶回下一頁游標",
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.GetTrendingRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "description": "排行榜中的影片數",
+                    "type": "integer"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.GetVideoReactionsRes": {
+            "type": "object",
+            "properties": {
+                "dislike_count": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "my_reaction": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.GetVideoRes": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "chapters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Chapter"
+                    }
+                },
+                "chapters_url": {
+                    "description": "WebVTT chapters track，沒有章節時為空值",
+                    "type": "string"
+                },
+                "content_rating": {
+                    "description": "\"all\", \"13+\", \"18+\"",
+                    "type": "string"
+                },
+                "dislike_count": {
+                    "type": "integer"
+                },
+                "download_enabled": {
+                    "description": "上傳者未停用下載",
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "hls_url": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "my_reaction": {
+                    "description": "呼叫者的表態：\"like\", \"dislike\"，未表態為空值",
+                    "type": "string"
+                },
+                "playback_urls": {
+                    "description": "候選播放網址，hls_url 為第一個；播放失敗時依序改用下一個",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.PlaybackURL"
+                    }
+                },
+                "source_video_id": {
+                    "description": "剪輯來源影片，非剪輯的影片為 0",
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.ListCategoriesRes": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Category"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ListCommentsRes": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Comment"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "$ref": "#/definitions/streaming.Comment"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ListLikedVideosRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.ListModerationAuditRes": {
+            "type": "object",
+            "properties": {
+                "audits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.ModerationAudit"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ListPlaylistsRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "playlists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Playlist"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ListReportsRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.VideoReport"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ListThumbnailsRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "thumbnails": {
+                    "description": "自動縮圖依分數排在前面，自訂縮圖依上傳順序排在後面",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Thumbnail"
+                    }
+                }
+            }
+        },
+        "streaming.ListUploadsRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "video": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.SearchFeedBack"
+                    }
+                }
+            }
+        },
+        "streaming.ModerationAudit": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "\"claim\", \"dismiss\", \"age_restrict\", \"hide\", \"remove\", \"rate\"",
+                    "type": "string"
+                },
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "report_id": {
+                    "type": "integer"
+                },
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.PinCommentRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.PlaybackURL": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "description": "\"cdn\", \"storage\", \"gateway\"",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.Playlist": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "\"custom\", \"watch_later\"",
+                    "type": "string"
+                },
+                "member_id": {
+                    "type": "string"
+                },
+                "playlist_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "visibility": {
+                    "description": "\"public\", \"private\"",
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.PlaylistItem": {
+            "type": "object",
+            "properties": {
+                "hls_url": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.PostCommentRes": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/streaming.Comment"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.QoEStats": {
+            "type": "object",
+            "properties": {
+                "avg_startup_ms": {
+                    "type": "number"
+                },
+                "bitrate_switches": {
+                    "type": "integer"
+                },
+                "dropped_frames": {
+                    "type": "integer"
+                },
+                "error_rate": {
+                    "description": "平均每次播放的錯誤數",
+                    "type": "number"
+                },
+                "errors": {
+                    "type": "integer"
+                },
+                "plays": {
+                    "type": "integer"
+                },
+                "rebuffer_count": {
+                    "type": "integer"
+                },
+                "rebuffer_ms": {
+                    "type": "integer"
+                },
+                "rebuffer_per_play": {
+                    "type": "number"
+                },
+                "rendition": {
+                    "description": "整體統計為空",
+                    "type": "string"
+                }
+            }
+        },
+        "streaming.QuotaLimits": {
+            "type": "object",
+            "properties": {
+                "max_storage_bytes": {
+                    "description": "0 表示不限制",
+                    "type": "integer"
+                },
+                "max_uploads_per_day": {
+                    "type": "integer"
+                },
+                "max_videos": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ReactToVideoRes": {
+            "type": "object",
+            "properties": {
+                "dislike_count": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "my_reaction": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.RemovePlaylistItemRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ReorderPlaylistRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ReportQoERes": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "accepted": {
+                    "type": "integer"
                 },
-                "password": {
+                "error": {
                     "type": "string"
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "member.RegisterRes": {
+        "streaming.ReportRes": {
             "type": "object",
             "properties": {
-                "message": {
+                "error": {
                     "type": "string"
                 },
+                "report": {
+                    "$ref": "#/definitions/streaming.VideoReport"
+                },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "streaming.GetRecommendationsRes": {
+        "streaming.ReportVideoRes": {
             "type": "object",
             "properties": {
                 "error": {
                     "type": "string"
                 },
+                "report_id": {
+                    "type": "integer"
+                },
                 "success": {
                     "type": "boolean"
-                },
-                "video": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/streaming.SearchFeedBack"
-                    }
                 }
             }
         },
-        "streaming.GetVideoRes": {
+        "streaming.RequestDownloadRes": {
             "type": "object",
             "properties": {
                 "error": {
                     "type": "string"
                 },
-                "hls_url": {
+                "expires_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "quota_exceeded": {
+                    "description": "已達下載次數上限",
+                    "type": "boolean"
+                },
+                "quota_remaining": {
+                    "type": "integer"
+                },
+                "retry_after_seconds": {
+                    "type": "integer"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "\"ready\"：url 可下載；\"preparing\"：檔案產生中，retry_after_seconds 後再請求",
                     "type": "string"
                 },
                 "success": {
                     "type": "boolean"
                 },
-                "title": {
+                "url": {
                     "type": "string"
-                },
-                "video_id": {
-                    "type": "integer"
                 }
             }
         },
         "streaming.SearchFeedBack": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "content_rating": {
+                    "description": "\"all\", \"13+\", \"18+\"",
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -600,6 +4173,17 @@ const docTemplate = `{
                     "description": "存於 MinIO 上的 object key",
                     "type": "string"
                 },
+                "like_count": {
+                    "type": "integer"
+                },
+                "preview_url": {
+                    "description": "滑鼠移到縮圖上時播放的無聲預覽短片（MP4），尚未產生時為空值",
+                    "type": "string"
+                },
+                "source_video_id": {
+                    "description": "剪輯來源影片，非剪輯的影片為 0",
+                    "type": "integer"
+                },
                 "status": {
                     "description": "\"uploaded\", \"processing\", \"ready\"",
                     "type": "string"
@@ -637,12 +4221,213 @@ const docTemplate = `{
                 }
             }
         },
+        "streaming.SetChaptersRes": {
+            "type": "object",
+            "properties": {
+                "chapters": {
+                    "description": "目前生效的章節",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.Chapter"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.SetContentRatingRes": {
+            "type": "object",
+            "properties": {
+                "content_rating": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "rating_locked": {
+                    "type": "boolean"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.SetDownloadEnabledRes": {
+            "type": "object",
+            "properties": {
+                "download_enabled": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.SetMemberQuotaRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "limits": {
+                    "$ref": "#/definitions/streaming.QuotaLimits"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ShareVideoRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.ShortsItem": {
+            "type": "object",
+            "properties": {
+                "first_segment_url": {
+                    "description": "第一個 TS 分段，供客戶端預先載入",
+                    "type": "string"
+                },
+                "hls_url": {
+                    "type": "string"
+                },
+                "poster_url": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "\"followed\", \"trending\", \"fresh\"",
+                    "type": "string"
+                },
+                "video": {
+                    "$ref": "#/definitions/streaming.SearchFeedBack"
+                }
+            }
+        },
+        "streaming.Thumbnail": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/streaming.ThumbnailImage"
+                    }
+                },
+                "score": {
+                    "description": "自動縮圖的分數（0~1），自訂縮圖為 0",
+                    "type": "number"
+                },
+                "source": {
+                    "description": "\"auto\", \"custom\"",
+                    "type": "string"
+                },
+                "time_ms": {
+                    "description": "自動縮圖在影片中的時間（毫秒）",
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ThumbnailImage": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "\"large\", \"medium\", \"small\"",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.ThumbnailRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "thumbnail": {
+                    "$ref": "#/definitions/streaming.Thumbnail"
+                }
+            }
+        },
+        "streaming.UnshareVideoRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.UpdatePlaylistRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "playlist": {
+                    "$ref": "#/definitions/streaming.Playlist"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "streaming.UpdateVisibilityRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "streaming.UploadVideoRes": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
                 },
+                "quota_exceeded": {
+                    "description": "超過的配額 \"storage\", \"videos\", \"daily_uploads\"，未超過為空值",
+                    "type": "string"
+                },
                 "success": {
                     "type": "boolean"
                 },
@@ -650,6 +4435,89 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "streaming.VideoReport": {
+            "type": "object",
+            "properties": {
+                "claimed_at": {
+                    "description": "unix 秒，未認領為 0",
+                    "type": "integer"
+                },
+                "claimed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reporter_id": {
+                    "type": "string"
+                },
+                "resolution": {
+                    "description": "處理動作",
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "description": "unix 秒，未處理為 0",
+                    "type": "integer"
+                },
+                "resolved_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "\"open\", \"claimed\", \"resolved\"",
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.Watermark": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "opacity": {
+                    "type": "number"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                }
+            }
+        },
+        "streaming.WatermarkRes": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "watermark": {
+                    "description": "未設定時為空",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/streaming.Watermark"
+                        }
+                    ]
+                }
+            }
         }
     }
 }`
@@ -68,6 +68,15 @@ func main() {
 	// 4. 初始化 UseCases
 	roomUC := app.NewRoomUseCase(inviteRepo, roomRepo)
 	sendMessageUC := app.NewSendMessageUseCase(roomRepo, msgRepo, pub)
+
+	// 消費 streaming_service 的影片狀態與會員通知事件，推播給會員（轉碼完成或失敗、新留言）
+	jobQueue, closeJobQueue, err := database.NewJobQueue(cfg.JobQueue, cfg.RabbitMQ, cfg.Kafka, 5*time.Second)
+	if err != nil {
+		log.Fatalf("建立工作佇列失敗: %v", err)
+	}
+	defer closeJobQueue()
+	go app.NewVideoStatusNotifier(jobQueue, pub).Start(ctx)
+	go app.NewMemberNotifier(jobQueue, pub).Start(ctx)
 	// memberHub := app.NewEphemeralHub()
 
 	// 5. 啟動 Fiber
@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"streaming_video_service/internal/streaming/app"
@@ -46,66 +50,230 @@ func main() {
 	if err := videoRepo.AutoMigrate(); err != nil {
 		log.Fatalf("資料表遷移失敗: %v", err)
 	}
+	playlistRepo := repository.NewPlaylistRepo(db)
+	if err := playlistRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	reactionRepo := repository.NewReactionRepo(db)
+	if err := reactionRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	followRepo := repository.NewFollowRepo(db)
+	if err := followRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	watermarkRepo := repository.NewWatermarkRepo(db)
+	if err := watermarkRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	thumbnailRepo := repository.NewThumbnailRepo(db)
+	if err := thumbnailRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	downloadRepo := repository.NewDownloadRepo(db)
+	if err := downloadRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	quotaRepo := repository.NewQuotaRepo(db)
+	if err := quotaRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	qoeRepo := repository.NewQoERepo(db)
+	if err := qoeRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	moderationRepo := repository.NewModerationRepo(db)
+	if err := moderationRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
+	outboxRepo := repository.NewOutboxRepo(db)
+	if err := outboxRepo.AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
 
-	// 2. 初始化 MinIO 客戶端
-	minioClient, err := database.NewMinIOConnection(database.MinIOConnection{
-		Endpoint:   fmt.Sprintf("%s:%d", cfg.MinIO.Host, cfg.MinIO.Port),
-		User:       cfg.MinIO.User,
-		Password:   cfg.MinIO.Password,
-		BucketName: cfg.MinIO.BucketName,
-		UseSSL:     cfg.MinIO.UseSSL,
+	// 建立 Redis 連線（按讚數快取）
+	masterName, sentinel := config.GetRedisSetting()
+	redisClient, err := database.NewRedisClient(masterName, "unUse", sentinel, cfg.Redis.RedisDB)
+	if err != nil {
+		logger.Log.Fatal(fmt.Sprintf("connect redis err : %v", err))
+	}
 
-		RetryCount:    cfg.MinIO.RetryCount,
-		RetryInterval: cfg.MinIO.RetryInterval,
-	})
+	// 建立 Mongo 連線（影片留言）
+	mongoURI := fmt.Sprintf("mongodb://%s:%s@%s:%d", cfg.MongoSQL.User, cfg.MongoSQL.Password, cfg.MongoSQL.Host, cfg.MongoSQL.Port)
+	mongo, err := database.NewMongoDB(context.Background(),
+		database.Connection{
+			ConnectStr:    mongoURI,
+			RetryCount:    cfg.MongoSQL.RetryCount,
+			RetryInterval: time.Duration(cfg.MongoSQL.RetryInterval),
+		},
+		cfg.MongoSQL.Database)
 	if err != nil {
 		logger.Log.Fatal(
-			"Unable to connect to minio after retries",
-			zap.String("address", fmt.Sprintf("[%s]", dsn)),
+			"Unable to connect to mongoDB database after retries",
+			zap.String("address", fmt.Sprintf("[%s]", mongoURI)),
 			zap.Error(err),
 		)
 	}
+	defer mongo.Close(context.Background())
 
-	rabbitURL := fmt.Sprintf("amqp://%s:%s@%s:%s/", cfg.RabbitMQ.User, cfg.RabbitMQ.Password, cfg.RabbitMQ.IP, cfg.RabbitMQ.Port)
-	conn, err := database.ConnectRabbitMQWithRetry(database.Connection{
-		ConnectStr:    rabbitURL,
-		RetryCount:    cfg.RabbitMQ.RetryCount,
-		RetryInterval: time.Duration(cfg.RabbitMQ.RetryInterval),
-	})
-	if err != nil {
-		log.Fatalf("RabbitMQ 連線失敗: %v", err)
+	commentRepo := repository.NewMongoCommentRepository(mongo.Database)
+	if err := commentRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("建立留言索引失敗: %v", err)
 	}
-	defer conn.Close()
 
-	rabbitChannel, err := database.GetRabbitMQChannelWithRetry(conn, cfg.RabbitMQ.RetryCount, cfg.RabbitMQ.RetryInterval)
+	// 2. 初始化物件儲存：依 storage.backend 使用 MinIO 或本機檔案系統
+	minioClient, storageHandler, err := database.NewObjectStorage(cfg.Storage, cfg.MinIO)
 	if err != nil {
-		log.Fatalf("取得 RabbitMQ Channel 失敗: %v", err)
+		logger.Log.Fatal(
+			"Unable to initialize object storage",
+			zap.String("backend", cfg.Storage.Backend),
+			zap.Error(err),
+		)
+	}
+	if storageHandler != nil {
+		// filesystem backend：在 base_url 的路徑提供 presign URL 的下載服務
+		baseURL, err := url.Parse(cfg.Storage.BaseURL)
+		if err != nil {
+			log.Fatalf("storage.base_url 格式錯誤: %v", err)
+		}
+		mountPath := strings.TrimRight(baseURL.Path, "/")
+		mux := http.NewServeMux()
+		mux.Handle(mountPath+"/", http.StripPrefix(mountPath, storageHandler))
+		go func() {
+			if err := http.ListenAndServe(cfg.Storage.Listen, mux); err != nil {
+				logger.Log.Fatal("storage file server stopped", zap.Error(err))
+			}
+		}()
 	}
-	defer rabbitChannel.Close()
 
-	//先初始化一個queue name = transcode
-	if _, err := rabbitChannel.QueueDeclare(
-		domain.QueueName, // queue name
-		true,             // durable
-		false,            // autoDelete
-		false,            // exclusive
-		false,            // noWait
-		nil,              // arguments
-	); err != nil {
-		log.Fatalf("Queue Declare failed: %v", err)
+	// 轉碼工作佇列：依 job_queue.backend 使用 RabbitMQ、Kafka 或 in-memory
+	jobQueue, closeJobQueue, err := database.NewJobQueue(cfg.JobQueue, cfg.RabbitMQ, cfg.Kafka, domain.OutboxConfirmTimeout)
+	if err != nil {
+		log.Fatalf("建立工作佇列失敗: %v", err)
 	}
+	defer closeJobQueue()
 
-	rabbitRepo := database.NewRabbitRepository(rabbitChannel)
+	// 播放網址：gateway、公開 bucket 與 CDN，客戶端依序嘗試
+	cdns := make([]domain.CDNHost, len(cfg.Playback.CDN))
+	for index, cdn := range cfg.Playback.CDN {
+		cdns[index] = domain.CDNHost{URL: cdn.URL, Weight: cdn.Weight, Regions: cdn.Regions}
+	}
+	playbackURLs, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{
+		GatewayURL: cfg.Playback.GatewayURL,
+		StorageURL: cfg.Playback.StorageURL,
+		CDNs:       cdns,
+	})
+	if err != nil {
+		log.Fatalf("playback 設定錯誤: %v", err)
+	}
 
-	// 假設已初始化 rabbitChannel, minioClient, videoRepo
-	consumer := app.NewConsumer(rabbitRepo, minioClient, videoRepo, domain.QueueName)
+	consumer := app.NewConsumer(jobQueue, minioClient, videoRepo, watermarkRepo, thumbnailRepo, domain.QueueName, playbackURLs)
 	// 使用 context 控制 Consumer 的生命週期
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	// 啟動 Consumer（通常以 goroutine 執行）
 	go consumer.StartConsumer(ctx)
 
-	usecase := app.NewStreamingUseCase(minioClient, videoRepo, rabbitRepo)
+	// in-memory 佇列沒有 chat_service 消費影片狀態事件，在同一個 process 內消費並記錄
+	if cfg.JobQueue.Backend == database.JobQueueMemory {
+		go app.NewVideoStatusLogger(jobQueue).Start(ctx)
+	}
+
+	// 啟動 QoE 彙整：將播放器回報的 beacon 累加到每部影片、每個 rendition 的每日統計
+	go app.NewQoEAggregator(jobQueue, qoeRepo, videoRepo).Start(ctx)
+
+	// 啟動下載檔案產生：依下載請求由 HLS 轉出指定畫質的 faststart MP4 並快取在 MinIO
+	go app.NewDownloadWorker(jobQueue, minioClient, videoRepo, downloadRepo).Start(ctx)
+
+	// 啟動排程公開：publish_at 到期的影片改為 public
+	if cfg.PublishScheduler.Enable {
+		go app.NewPublishScheduler(videoRepo, minioClient, cfg.PublishScheduler.Interval*time.Second).Start(ctx)
+	}
+
+	leaderLock := repository.NewLeaderLock(redisClient)
+
+	// 啟動熱門排行榜重建：依最近的瀏覽、按讚、留言計算分數並寫入 Redis，以 leader lock 確保只有一個 replica 執行
+	trendingCache := repository.NewTrendingCache(redisClient)
+	if cfg.Trending.Enable {
+		go app.NewTrendingJob(videoRepo, reactionRepo, commentRepo, trendingCache, leaderLock, cfg.Trending.Interval*time.Second).Start(ctx)
+	}
+
+	// 啟動 outbox relay：將與影片記錄一起寫入的領域事件發布到工作佇列
+	go app.NewOutboxRelay(outboxRepo, jobQueue, leaderLock,
+		cfg.Outbox.Interval*time.Second, cfg.Outbox.Retention*time.Second).Start(ctx)
+
+	// 啟動卡住的轉碼工作檢查：以 leader lock 確保只有一個 replica 執行
+	if cfg.StuckJobs.Enable {
+		go app.NewStuckJobReconciler(videoRepo, minioClient, leaderLock,
+			cfg.StuckJobs.Interval*time.Second, cfg.StuckJobs.Timeout*time.Second, playbackURLs).Start(ctx)
+	}
+
+	// 啟動儲存生命週期：處理 ready 後的原始檔、將長期沒有瀏覽的影片移到冷儲存
+	if cfg.Lifecycle.Enable {
+		action := domain.OriginalAction(cfg.Lifecycle.OriginalAction)
+		if !action.IsValid() {
+			log.Fatalf("不支援的 lifecycle.original_action: %s", cfg.Lifecycle.OriginalAction)
+		}
+		go app.NewStorageLifecycleJob(videoRepo, minioClient, leaderLock, cfg.Lifecycle.Interval*time.Second, domain.LifecycleRules{
+			OriginalAction: action,
+			OriginalAfter:  time.Duration(cfg.Lifecycle.OriginalAfterDays) * 24 * time.Hour,
+			ColdAfter:      time.Duration(cfg.Lifecycle.ColdAfterDays) * 24 * time.Hour,
+		}).Start(ctx)
+	}
+
+	// 啟動孤兒物件回收：刪除找不到影片記錄的物件，dry_run 時只列出
+	if cfg.OrphanGC.Enable {
+		go app.NewOrphanGC(videoRepo, minioClient, leaderLock,
+			cfg.OrphanGC.Interval*time.Second, cfg.OrphanGC.GracePeriod*time.Second, cfg.OrphanGC.DryRun).Start(ctx)
+	}
+
+	// 熱門播放清單與分段快取，命中次數由 expvar 的 hls_cache 提供
+	var objectCache *app.HotObjectCache
+	if cfg.HLSCache.Enable {
+		var shared repository.HLSObjectCache
+		if cfg.HLSCache.Redis {
+			shared = repository.NewHLSObjectCache(redisClient)
+		}
+		objectCache = app.NewHotObjectCache(domain.HotObjectCacheOptions{
+			MaxBytes:       cfg.HLSCache.MaxBytes,
+			MaxObjectBytes: cfg.HLSCache.MaxObjectBytes,
+			PlaylistTTL:    cfg.HLSCache.PlaylistTTL * time.Second,
+			SegmentTTL:     cfg.HLSCache.SegmentTTL * time.Second,
+		}, shared)
+		expvar.Publish("hls_cache", expvar.Func(func() interface{} { return objectCache.Stats() }))
+	}
+	if cfg.Metrics.Listen != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			if err := http.ListenAndServe(cfg.Metrics.Listen, mux); err != nil {
+				logger.Log.Error("metrics server stopped", zap.Error(err))
+			}
+		}()
+	}
+
+	usecase := app.NewStreamingUseCase(minioClient, videoRepo, objectCache, playbackURLs)
+	playlistUsecase := app.NewPlaylistUseCase(playlistRepo, videoRepo, playbackURLs)
+	reactionUsecase := app.NewReactionUseCase(reactionRepo, repository.NewReactionCache(redisClient), videoRepo)
+	commentUsecase := app.NewCommentUseCase(commentRepo, videoRepo, outboxRepo)
+	trendingUsecase := app.NewTrendingUseCase(trendingCache, videoRepo)
+	shortsUsecase := app.NewShortsUseCase(videoRepo, followRepo, trendingCache, repository.NewShortsSeenCache(redisClient), playbackURLs)
+	followUsecase := app.NewFollowUseCase(followRepo)
+	watermarkUsecase := app.NewWatermarkUseCase(minioClient, watermarkRepo)
+	qoeUsecase := app.NewQoEUseCase(outboxRepo, qoeRepo, videoRepo)
+	moderationUsecase := app.NewModerationUseCase(moderationRepo, videoRepo, minioClient)
+	thumbnailUsecase := app.NewThumbnailUseCase(minioClient, thumbnailRepo, videoRepo, playbackURLs)
+	downloadUsecase := app.NewDownloadUseCase(minioClient, videoRepo, downloadRepo)
+
+	// 上傳配額：依角色設定，管理員可為會員個別設定
+	quotaPolicy := domain.QuotaPolicy{
+		Default: domain.QuotaLimits(cfg.Quota.Default),
+		Roles:   make(map[string]domain.QuotaLimits, len(cfg.Quota.Roles)),
+	}
+	for role, limits := range cfg.Quota.Roles {
+		quotaPolicy.Roles[role] = domain.QuotaLimits(limits)
+	}
+	quotaUsecase := app.NewQuotaUseCase(quotaRepo, quotaPolicy)
 
 	lis, err := net.Listen("tcp", cfg.IP+":"+cfg.Port)
 	if err != nil {
@@ -115,7 +283,21 @@ func main() {
 	// 建立 gRPC 伺服器
 	grpcServer := grpc.NewServer()
 
-	streaming_pb.RegisterStreamingServiceServer(grpcServer, &app.StreamingGRPCServer{Usecase: usecase})
+	streaming_pb.RegisterStreamingServiceServer(grpcServer, &app.StreamingGRPCServer{
+		Usecase:           usecase,
+		PlaylistUsecase:   playlistUsecase,
+		ReactionUsecase:   reactionUsecase,
+		CommentUsecase:    commentUsecase,
+		TrendingUsecase:   trendingUsecase,
+		ShortsUsecase:     shortsUsecase,
+		FollowUsecase:     followUsecase,
+		WatermarkUsecase:  watermarkUsecase,
+		QoEUsecase:        qoeUsecase,
+		ModerationUsecase: moderationUsecase,
+		ThumbnailUsecase:  thumbnailUsecase,
+		DownloadUsecase:   downloadUsecase,
+		QuotaUsecase:      quotaUsecase,
+	})
 	logger.Log.Info(fmt.Sprintf("MemberService gRPC server listening on : %s", cfg.Port))
 
 	if err := grpcServer.Serve(lis); err != nil {
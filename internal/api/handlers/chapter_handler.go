@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ChapterBody chapter of a video
+type ChapterBody struct {
+	StartMs int64  `json:"start_ms"` // 距離影片開頭的毫秒數
+	Title   string `json:"title"`
+}
+
+// SetChaptersBody set chapters request body
+type SetChaptersBody struct {
+	Chapters []ChapterBody `json:"chapters"` // 空值代表清除自訂章節，改回由說明欄解析
+}
+
+// SetChapters godoc
+// @Summary Set video chapters
+// @Description Replaces the chapters of a video. The first chapter must start at 0 and start times must increase. An empty list falls back to chapters parsed from "00:00 Title" lines of the description. Only the uploader may change it.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body SetChaptersBody true "Chapters"
+// @Success 200 {object} streaming_pb.SetChaptersRes "Set chapters response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/chapters [put]
+func (s *StreamingHandler) SetChapters(c *fiber.Ctx) error {
+	var body SetChaptersBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	chapters := make([]*streaming_pb.Chapter, len(body.Chapters))
+	for index, chapter := range body.Chapters {
+		chapters[index] = &streaming_pb.Chapter{
+			StartMs: chapter.StartMs,
+			Title:   chapter.Title,
+		}
+	}
+	req := &streaming_pb.SetChaptersReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Chapters: chapters,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetChapters(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// GetChaptersVTT godoc
+// @Summary Get WebVTT chapters track
+// @Description Retrieves the chapters of a video as a WebVTT track for <track kind="chapters">.
+// @Tags Streaming
+// @Accept json
+// @Produce text/vtt
+// @Param video_id path string true "Video ID"
+// @Success 200 {string} string "WebVTT content"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/chapters.vtt [get]
+func (s *StreamingHandler) GetChaptersVTT(c *fiber.Ctx) error {
+	req := &streaming_pb.GetChaptersVTTReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetChaptersVTT(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	c.Set("Content-Type", "text/vtt; charset=utf-8")
+	return c.Send(res.Content)
+}
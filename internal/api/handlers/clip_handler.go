@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateClipBody create clip request body
+type CreateClipBody struct {
+	StartMs     int64  `json:"start_ms"` // 片段在來源影片中的開始時間（毫秒）
+	EndMs       int64  `json:"end_ms"`   // 片段在來源影片中的結束時間（毫秒），長度需介於 15 到 60 秒
+	Title       string `json:"title"`    // 空值時使用來源影片標題
+	Description string `json:"description"`
+}
+
+// CreateClip godoc
+// @Summary Create a clip
+// @Description Cuts a 15-60 second clip out of a ready video. The clip becomes a new short video linked to its source, keeps the source's visibility and content rating, goes through transcoding like a normal upload and is listed under the clipper's uploads.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Source video ID"
+// @Param body body CreateClipBody true "Clip range"
+// @Success 200 {object} streaming_pb.CreateClipRes "Create clip response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/clips [post]
+func (s *StreamingHandler) CreateClip(c *fiber.Ctx) error {
+	var body CreateClipBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.CreateClipReq{
+		VideoId:     c.Params("video_id"),
+		MemberId:    tokenMemberID(c),
+		ViewerAge:   tokenViewerAge(c),
+		StartMs:     body.StartMs,
+		EndMs:       body.EndMs,
+		Title:       body.Title,
+		Description: body.Description,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.CreateClip(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ListUploads godoc
+// @Summary List my uploads
+// @Description Lists videos uploaded by the current member, including clips and videos still being transcoded, newest first.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param page query int false "Page (from 1)"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} streaming_pb.ListUploadsRes "List uploads response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/uploads [get]
+func (s *StreamingHandler) ListUploads(c *fiber.Ctx) error {
+	req := &streaming_pb.ListUploadsReq{
+		MemberId: tokenMemberID(c),
+		Page:     int32(c.QueryInt("page", 1)),
+		PageSize: int32(c.QueryInt("page_size")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListUploads(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CommentBody post / edit comment request body
+type CommentBody struct {
+	Content  string `json:"content"`
+	ParentID string `json:"parent_id"` // 回覆的留言 ID，空值為頂層留言（僅新增時使用）
+}
+
+// PinCommentBody pin comment request body
+type PinCommentBody struct {
+	Pinned bool `json:"pinned"`
+}
+
+// ListComments godoc
+// @Summary List video comments
+// @Description Lists top-level comments of a video with cursor pagination. The pinned comment is returned separately on the first page.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param video_id path int true "Video ID"
+// @Param sort query string false "top (default) or newest"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size"
+// @Success 200 {object} streaming_pb.ListCommentsRes "List comments response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/comments [get]
+func (s *StreamingHandler) ListComments(c *fiber.Ctx) error {
+	videoID, err := c.ParamsInt("video_id")
+	if err != nil || videoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid video_id"})
+	}
+	req := &streaming_pb.ListCommentsReq{
+		VideoId:  int64(videoID),
+		MemberId: tokenMemberID(c),
+		Sort:     c.Query("sort"),
+		Cursor:   c.Query("cursor"),
+		Limit:    int32(c.QueryInt("limit")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListComments(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// PostComment godoc
+// @Summary Post a comment
+// @Description Posts a comment on a video, or a reply when parent_id is set. The video owner is notified.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param video_id path int true "Video ID"
+// @Param body body CommentBody true "Comment"
+// @Success 200 {object} streaming_pb.PostCommentRes "Post comment response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/comments [post]
+func (s *StreamingHandler) PostComment(c *fiber.Ctx) error {
+	videoID, err := c.ParamsInt("video_id")
+	if err != nil || videoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid video_id"})
+	}
+	var body CommentBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.PostCommentReq{
+		VideoId:  int64(videoID),
+		MemberId: tokenMemberID(c),
+		ParentId: body.ParentID,
+		Content:  body.Content,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.PostComment(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ListCommentReplies godoc
+// @Summary List comment replies
+// @Description Lists replies of a top-level comment in chronological order with cursor pagination.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "Comment ID"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size"
+// @Success 200 {object} streaming_pb.ListCommentsRes "List replies response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/comments/{comment_id}/replies [get]
+func (s *StreamingHandler) ListCommentReplies(c *fiber.Ctx) error {
+	req := &streaming_pb.ListCommentRepliesReq{
+		CommentId: c.Params("comment_id"),
+		MemberId:  tokenMemberID(c),
+		Cursor:    c.Query("cursor"),
+		Limit:     int32(c.QueryInt("limit")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListCommentReplies(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// EditComment godoc
+// @Summary Edit my comment
+// @Description Edits the content of a comment posted by the current member.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "Comment ID"
+// @Param body body CommentBody true "Comment"
+// @Success 200 {object} streaming_pb.EditCommentRes "Edit comment response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/comments/{comment_id} [patch]
+func (s *StreamingHandler) EditComment(c *fiber.Ctx) error {
+	var body CommentBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.EditCommentReq{
+		CommentId: c.Params("comment_id"),
+		MemberId:  tokenMemberID(c),
+		Content:   body.Content,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.EditComment(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// DeleteComment godoc
+// @Summary Delete my comment
+// @Description Deletes a comment posted by the current member. A top-level comment with replies keeps its thread and only its content is removed.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "Comment ID"
+// @Success 200 {object} streaming_pb.DeleteCommentRes "Delete comment response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/comments/{comment_id} [delete]
+func (s *StreamingHandler) DeleteComment(c *fiber.Ctx) error {
+	req := &streaming_pb.DeleteCommentReq{
+		CommentId: c.Params("comment_id"),
+		MemberId:  tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.DeleteComment(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// PinComment godoc
+// @Summary Pin / unpin a comment
+// @Description Pins a top-level comment on the current member's video, replacing any previously pinned comment. Set pinned to false to unpin.
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param comment_id path string true "Comment ID"
+// @Param body body PinCommentBody true "Pin"
+// @Success 200 {object} streaming_pb.PinCommentRes "Pin comment response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/comments/{comment_id}/pin [post]
+func (s *StreamingHandler) PinComment(c *fiber.Ctx) error {
+	var body PinCommentBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.PinCommentReq{
+		CommentId: c.Params("comment_id"),
+		MemberId:  tokenMemberID(c),
+		Pinned:    body.Pinned,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.PinComment(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
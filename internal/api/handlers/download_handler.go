@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestDownloadBody request download request body
+type RequestDownloadBody struct {
+	Quality string `json:"quality"` // 1080p, 720p, 480p, 360p（預設 720p）
+}
+
+// SetDownloadEnabledBody set download enabled request body
+type SetDownloadEnabledBody struct {
+	Enabled bool `json:"enabled"`
+}
+
+// RequestDownload godoc
+// @Summary Request an offline download
+// @Description Returns a short-lived signed link to a faststart MP4 of the video at the requested quality. The file is produced on demand and cached; while it is being produced the response is 202 with status "preparing" and a Retry-After header, and the client should request again. Each member can obtain 10 download links per 24 hours. Uploaders can disable downloads of their videos.
+// @Tags Download
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body RequestDownloadBody false "Quality"
+// @Success 200 {object} streaming_pb.RequestDownloadRes "Download link"
+// @Success 202 {object} streaming_pb.RequestDownloadRes "Download is being prepared"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 429 {object} streaming_pb.RequestDownloadRes "Download quota exceeded"
+// @Router /streaming/video/{video_id}/download [post]
+func (s *StreamingHandler) RequestDownload(c *fiber.Ctx) error {
+	var body RequestDownloadBody
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&body); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.RequestDownload(ctx, &streaming_pb.RequestDownloadReq{
+		VideoId:   c.Params("video_id"),
+		MemberId:  tokenMemberID(c),
+		Quality:   body.Quality,
+		ViewerAge: tokenViewerAge(c),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if res.QuotaExceeded {
+		return c.Status(http.StatusTooManyRequests).JSON(res)
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	if res.Status == "preparing" {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(res.RetryAfterSeconds)))
+		return c.Status(http.StatusAccepted).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// SetDownloadEnabled godoc
+// @Summary Enable or disable downloads of a video
+// @Description Uploader only. Disabling downloads stops new download links from being issued to other members; links already issued stay valid until they expire.
+// @Tags Download
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body SetDownloadEnabledBody true "Download setting"
+// @Success 200 {object} streaming_pb.SetDownloadEnabledRes "Set download enabled response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/download [put]
+func (s *StreamingHandler) SetDownloadEnabled(c *fiber.Ctx) error {
+	var body SetDownloadEnabledBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetDownloadEnabled(ctx, &streaming_pb.SetDownloadEnabledReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Enabled:  body.Enabled,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
@@ -7,6 +7,7 @@ import (
 	"streaming_video_service/pkg/logger"
 	"streaming_video_service/pkg/middlewares"
 	memberpb "streaming_video_service/pkg/proto/member"
+	"streaming_video_service/pkg/token"
 
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
@@ -174,3 +175,74 @@ func (h *MemberHandler) FindByEmail(c *fiber.Ctx) error {
 		},
 	})
 }
+
+// SetBirthdate 填寫生日
+// @Summary 填寫生日
+// @Description 会员填写生日（YYYY-MM-DD），填写后不可自行修改。生日用于分级内容的观看限制，下次登录后生效
+// @Tags Members
+// @Accept json
+// @Produce json
+// @Param request body memberpb.SetBirthdateReq true "生日，member_id 由 token 取得"
+// @Success 200 {object} memberpb.SetBirthdateRes "填写成功"
+// @Failure 400 {object} string "请求错误"
+// @Router /member/birthdate [put]
+func (h *MemberHandler) SetBirthdate(c *fiber.Ctx) error {
+	type request struct {
+		Birthdate string `json:"birthdate"`
+	}
+
+	var req request
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	memberID, _ := c.Locals(middlewares.TokenMemberID).(string)
+	resp, err := h.MemberClient.SetBirthdate(context.Background(), &memberpb.SetBirthdateReq{
+		MemberId:  memberID,
+		Birthdate: req.Birthdate,
+	})
+
+	if err != nil || !resp.Success {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": resp.GetMessage()})
+	}
+
+	return c.JSON(resp)
+}
+
+// SetAgeVerified 驗證會員成年
+// @Summary 驗證會員成年
+// @Description 仅限管理员，设置会员是否已验证成年，会员下次登录后生效
+// @Tags Members
+// @Accept json
+// @Produce json
+// @Param member_id path string true "Member ID"
+// @Param request body memberpb.SetAgeVerifiedReq true "是否已验证成年，member_id 由路径取得"
+// @Success 200 {object} memberpb.SetAgeVerifiedRes "设置成功"
+// @Failure 400 {object} string "请求错误"
+// @Failure 403 {object} string "Forbidden"
+// @Router /member/{member_id}/age-verification [post]
+func (h *MemberHandler) SetAgeVerified(c *fiber.Ctx) error {
+	if role, _ := c.Locals(middlewares.TokenRole).(string); role != string(token.RoleAdmin) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+
+	type request struct {
+		Verified bool `json:"verified"`
+	}
+
+	var req request
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	resp, err := h.MemberClient.SetAgeVerified(context.Background(), &memberpb.SetAgeVerifiedReq{
+		MemberId: c.Params("member_id"),
+		Verified: req.Verified,
+	})
+
+	if err != nil || !resp.Success {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": resp.GetMessage()})
+	}
+
+	return c.JSON(resp)
+}
@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"streaming_video_service/pkg/token"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReportVideoBody report video request body
+type ReportVideoBody struct {
+	Reason string `json:"reason"` // spam, harassment, hate, violence, sexual, child_safety, copyright, misinformation, other
+	Detail string `json:"detail"` // reason 為 other 時必填，1000 字以內
+}
+
+// ResolveReportBody resolve report request body
+type ResolveReportBody struct {
+	Action string `json:"action"` // dismiss, age_restrict, hide, remove
+	Note   string `json:"note"`   // 寫入稽核紀錄，1000 字以內
+}
+
+// SetContentRatingBody set content rating request body
+type SetContentRatingBody struct {
+	ContentRating string `json:"content_rating"` // all, 13+, 18+
+	Note          string `json:"note"`           // 管理員覆寫時寫入稽核紀錄，1000 字以內
+}
+
+// ReportVideo godoc
+// @Summary Report a video
+// @Description Reports a video for moderation with a reason code. The reason "other" requires a detail. A member cannot report their own video or report the same video again while a previous report is pending.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body ReportVideoBody true "Report"
+// @Success 201 {object} streaming_pb.ReportVideoRes "Report video response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/report [post]
+func (s *StreamingHandler) ReportVideo(c *fiber.Ctx) error {
+	var body ReportVideoBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ReportVideo(ctx, &streaming_pb.ReportVideoReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Reason:   body.Reason,
+		Detail:   body.Detail,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// ListReports godoc
+// @Summary List moderation queue
+// @Description Admin only. Lists reports with the given status, oldest first.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param status query string false "open, claimed or resolved (default open)"
+// @Param video_id query int false "Only reports of this video"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} streaming_pb.ListReportsRes "List reports response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/reports [get]
+func (s *StreamingHandler) ListReports(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListReports(ctx, &streaming_pb.ListReportsReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+		Status:   c.Query("status"),
+		VideoId:  uint64(c.QueryInt("video_id")),
+		Page:     int32(c.QueryInt("page", 1)),
+		PageSize: int32(c.QueryInt("page_size")),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ClaimReport godoc
+// @Summary Claim a report
+// @Description Admin only. Claims a report so that other admins cannot claim it for 30 minutes. The claim is recorded in the audit trail.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param report_id path int true "Report ID"
+// @Success 200 {object} streaming_pb.ReportRes "Report response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/reports/{report_id}/claim [post]
+func (s *StreamingHandler) ClaimReport(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	reportID, err := strconv.ParseUint(c.Params("report_id"), 10, 64)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid report_id"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ClaimReport(ctx, &streaming_pb.ClaimReportReq{
+		ReportId: reportID,
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+	})
+	return reportResponse(c, res, err)
+}
+
+// ResolveReport godoc
+// @Summary Resolve a report
+// @Description Admin only. Resolves a report claimed by the caller. age_restrict rates the video 18+ and locks the rating, hide removes the video from every list and only lets the uploader play it, remove blocks the video so nobody can play it. Other pending reports of the same video are resolved with the same action. Every resolution is recorded in the audit trail.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param report_id path int true "Report ID"
+// @Param body body ResolveReportBody true "Resolution"
+// @Success 200 {object} streaming_pb.ReportRes "Report response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/reports/{report_id}/resolve [post]
+func (s *StreamingHandler) ResolveReport(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	reportID, err := strconv.ParseUint(c.Params("report_id"), 10, 64)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid report_id"})
+	}
+	var body ResolveReportBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ResolveReport(ctx, &streaming_pb.ResolveReportReq{
+		ReportId: reportID,
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+		Action:   body.Action,
+		Note:     body.Note,
+	})
+	return reportResponse(c, res, err)
+}
+
+// ListModerationAudit godoc
+// @Summary List moderation audit trail
+// @Description Admin only. Lists claims and resolutions of a video or a report, newest first. At least one of video_id and report_id is required.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param video_id query int false "Video ID"
+// @Param report_id query int false "Report ID"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} streaming_pb.ListModerationAuditRes "List moderation audit response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/moderation/audit [get]
+func (s *StreamingHandler) ListModerationAudit(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListModerationAudit(ctx, &streaming_pb.ListModerationAuditReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+		VideoId:  uint64(c.QueryInt("video_id")),
+		ReportId: uint64(c.QueryInt("report_id")),
+		Page:     int32(c.QueryInt("page", 1)),
+		PageSize: int32(c.QueryInt("page_size")),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// SetContentRating godoc
+// @Summary Set the content rating of a video
+// @Description The uploader can change the rating (all, 13+ or 18+) unless an admin has locked it. An admin can override the rating of any video, which locks it and is recorded in the audit trail.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body SetContentRatingBody true "Rating"
+// @Success 200 {object} streaming_pb.SetContentRatingRes "Set content rating response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/rating [put]
+func (s *StreamingHandler) SetContentRating(c *fiber.Ctx) error {
+	var body SetContentRatingBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetContentRating(ctx, &streaming_pb.SetContentRatingReq{
+		VideoId:       c.Params("video_id"),
+		MemberId:      tokenMemberID(c),
+		Role:          tokenRole(c),
+		ContentRating: body.ContentRating,
+		Note:          body.Note,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+func reportResponse(c *fiber.Ctx, res *streaming_pb.ReportRes, err error) error {
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
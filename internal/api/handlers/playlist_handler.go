@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PlaylistBody create / update playlist request body
+type PlaylistBody struct {
+	Title      string `json:"title"`
+	Visibility string `json:"visibility"` // "public", "private"
+}
+
+// PlaylistItemBody add playlist item request body
+type PlaylistItemBody struct {
+	VideoID int64 `json:"video_id"`
+}
+
+// ReorderPlaylistBody reorder playlist request body
+type ReorderPlaylistBody struct {
+	VideoIDs []int64 `json:"video_ids"`
+}
+
+// ListPlaylists godoc
+// @Summary List my playlists
+// @Description Lists playlists of the current member. The built-in "Watch later" list is always first.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Success 200 {object} streaming_pb.ListPlaylistsRes "List playlists response"
+// @Failure 500 {object} string "Internal Server Error"
+// @Router /streaming/playlists [get]
+func (s *StreamingHandler) ListPlaylists(c *fiber.Ctx) error {
+	req := &streaming_pb.ListPlaylistsReq{
+		MemberId: tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListPlaylists(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusInternalServerError).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// CreatePlaylist godoc
+// @Summary Create playlist
+// @Description Creates a playlist owned by the current member. Visibility defaults to private.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param body body PlaylistBody true "Playlist"
+// @Success 200 {object} streaming_pb.CreatePlaylistRes "Create playlist response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists [post]
+func (s *StreamingHandler) CreatePlaylist(c *fiber.Ctx) error {
+	var body PlaylistBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.CreatePlaylistReq{
+		MemberId:   tokenMemberID(c),
+		Title:      body.Title,
+		Visibility: body.Visibility,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.CreatePlaylist(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// GetPlaylist godoc
+// @Summary Get playlist
+// @Description Retrieves a playlist with its items in play order, including playback info. Use "watch_later" as playlist_id for the current member's watch later list.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID or watch_later"
+// @Success 200 {object} streaming_pb.GetPlaylistRes "Get playlist response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id} [get]
+func (s *StreamingHandler) GetPlaylist(c *fiber.Ctx) error {
+	req := &streaming_pb.GetPlaylistReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetPlaylist(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// UpdatePlaylist godoc
+// @Summary Update playlist
+// @Description Renames a playlist or changes its visibility. Empty fields are left unchanged.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID"
+// @Param body body PlaylistBody true "Playlist"
+// @Success 200 {object} streaming_pb.UpdatePlaylistRes "Update playlist response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id} [patch]
+func (s *StreamingHandler) UpdatePlaylist(c *fiber.Ctx) error {
+	var body PlaylistBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.UpdatePlaylistReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+		Title:      body.Title,
+		Visibility: body.Visibility,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.UpdatePlaylist(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// DeletePlaylist godoc
+// @Summary Delete playlist
+// @Description Deletes a playlist. The built-in "Watch later" list cannot be deleted.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID"
+// @Success 200 {object} streaming_pb.DeletePlaylistRes "Delete playlist response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id} [delete]
+func (s *StreamingHandler) DeletePlaylist(c *fiber.Ctx) error {
+	req := &streaming_pb.DeletePlaylistReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.DeletePlaylist(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// AddPlaylistItem godoc
+// @Summary Add video to playlist
+// @Description Appends a video to the end of a playlist. Use "watch_later" as playlist_id for the watch later list.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID or watch_later"
+// @Param body body PlaylistItemBody true "Video"
+// @Success 200 {object} streaming_pb.AddPlaylistItemRes "Add playlist item response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id}/items [post]
+func (s *StreamingHandler) AddPlaylistItem(c *fiber.Ctx) error {
+	var body PlaylistItemBody
+	if err := c.BodyParser(&body); err != nil || body.VideoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.AddPlaylistItemReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+		VideoId:    body.VideoID,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.AddPlaylistItem(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// RemovePlaylistItem godoc
+// @Summary Remove video from playlist
+// @Description Removes a video from a playlist.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID or watch_later"
+// @Param video_id path int true "Video ID"
+// @Success 200 {object} streaming_pb.RemovePlaylistItemRes "Remove playlist item response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id}/items/{video_id} [delete]
+func (s *StreamingHandler) RemovePlaylistItem(c *fiber.Ctx) error {
+	videoID, err := c.ParamsInt("video_id")
+	if err != nil || videoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid video_id"})
+	}
+	req := &streaming_pb.RemovePlaylistItemReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+		VideoId:    int64(videoID),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.RemovePlaylistItem(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ReorderPlaylist godoc
+// @Summary Reorder playlist
+// @Description Sets the play order of a playlist. video_ids must contain every video of the playlist exactly once.
+// @Tags Playlist
+// @Accept json
+// @Produce json
+// @Param playlist_id path string true "Playlist ID or watch_later"
+// @Param body body ReorderPlaylistBody true "New order"
+// @Success 200 {object} streaming_pb.ReorderPlaylistRes "Reorder playlist response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/playlists/{playlist_id}/items [put]
+func (s *StreamingHandler) ReorderPlaylist(c *fiber.Ctx) error {
+	var body ReorderPlaylistBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.ReorderPlaylistReq{
+		PlaylistId: c.Params("playlist_id"),
+		MemberId:   tokenMemberID(c),
+		VideoIds:   body.VideoIDs,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ReorderPlaylist(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// QoEBeaconBody player QoE beacon
+type QoEBeaconBody struct {
+	VideoID   uint64 `json:"video_id"`
+	Rendition string `json:"rendition"`            // 144p ~ 2160p，空值為 default
+	Event     string `json:"event"`                // startup, rebuffer, bitrate_switch, error, dropped_frames
+	Value     int64  `json:"value"`                // startup / rebuffer 為毫秒，dropped_frames 為影格數
+	ErrorCode string `json:"error_code,omitempty"` // error 事件的錯誤代碼
+	AtMs      int64  `json:"at_ms"`                // 事件發生時間（unix 毫秒），0 為收到的時間，不可早於 24 小時前
+}
+
+// ReportQoEBody report QoE request body
+type ReportQoEBody struct {
+	Beacons []QoEBeaconBody `json:"beacons"`
+}
+
+// ReportQoE godoc
+// @Summary Report playback QoE beacons
+// @Description Accepts a batch of up to 200 player beacons (startup time, rebuffer, bitrate switch, error, dropped frames). Beacons are queued and aggregated asynchronously; invalid beacons are skipped and counted as rejected. A beacon is invalid if its video does not exist, its rendition is not default or one of 144p, 240p, 360p, 480p, 720p, 1080p, 1440p, 2160p, or its event time is more than 24 hours old. Each startup beacon counts as one view of the video.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param body body ReportQoEBody true "Beacons"
+// @Success 202 {object} streaming_pb.ReportQoERes "Report QoE response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/qoe [post]
+func (s *StreamingHandler) ReportQoE(c *fiber.Ctx) error {
+	var body ReportQoEBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	beacons := make([]*streaming_pb.QoEBeacon, len(body.Beacons))
+	for index, beacon := range body.Beacons {
+		beacons[index] = &streaming_pb.QoEBeacon{
+			VideoId:   beacon.VideoID,
+			Rendition: beacon.Rendition,
+			Event:     beacon.Event,
+			Value:     beacon.Value,
+			ErrorCode: beacon.ErrorCode,
+			AtMs:      beacon.AtMs,
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ReportQoE(ctx, &streaming_pb.ReportQoEReq{
+		MemberId: tokenMemberID(c),
+		Beacons:  beacons,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.Status(http.StatusAccepted).JSON(res)
+}
+
+// GetQoESummary godoc
+// @Summary Get playback QoE summary
+// @Description Returns startup time, rebuffering, bitrate switches, errors and dropped frames of a video over the last days, overall and per rendition. Only the uploader or an admin may view it.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param days query int false "Number of days including today (default 7, max 90)"
+// @Success 200 {object} streaming_pb.GetQoESummaryRes "QoE summary response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/qoe [get]
+func (s *StreamingHandler) GetQoESummary(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetQoESummary(ctx, &streaming_pb.GetQoESummaryReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+		Days:     int32(c.QueryInt("days")),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
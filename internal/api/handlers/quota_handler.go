@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"streaming_video_service/pkg/token"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetMemberQuotaBody set member quota request body，0 表示不限制
+type SetMemberQuotaBody struct {
+	MaxStorageBytes  int64 `json:"max_storage_bytes"`
+	MaxVideos        int64 `json:"max_videos"`
+	MaxUploadsPerDay int64 `json:"max_uploads_per_day"`
+}
+
+// GetQuotaUsage godoc
+// @Summary Get upload quota and usage
+// @Description Returns the caller's upload limits (total stored bytes, number of videos and uploads per 24 hours, 0 meaning unlimited) and current usage. Limits come from the caller's role unless an admin has set a per-member quota.
+// @Tags Quota
+// @Accept json
+// @Produce json
+// @Success 200 {object} streaming_pb.GetQuotaUsageRes "Quota usage"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/quota [get]
+func (s *StreamingHandler) GetQuotaUsage(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetQuotaUsage(ctx, &streaming_pb.GetQuotaUsageReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// SetMemberQuota godoc
+// @Summary Set a member's upload quota
+// @Description Admin only. Replaces the member's role-based limits with the given limits; 0 means unlimited.
+// @Tags Quota
+// @Accept json
+// @Produce json
+// @Param member_id path string true "Member ID"
+// @Param body body SetMemberQuotaBody true "Limits"
+// @Success 200 {object} streaming_pb.SetMemberQuotaRes "Set member quota response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/quota/{member_id} [put]
+func (s *StreamingHandler) SetMemberQuota(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	var body SetMemberQuotaBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetMemberQuota(ctx, &streaming_pb.SetMemberQuotaReq{
+		MemberId: c.Params("member_id"),
+		Role:     tokenRole(c),
+		Limits: &streaming_pb.QuotaLimits{
+			MaxStorageBytes:  body.MaxStorageBytes,
+			MaxVideos:        body.MaxVideos,
+			MaxUploadsPerDay: body.MaxUploadsPerDay,
+		},
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
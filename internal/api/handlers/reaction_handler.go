@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReactionBody react to video request body
+type ReactionBody struct {
+	Reaction string `json:"reaction"` // "like", "dislike"，空值代表清除
+}
+
+// ReactToVideo godoc
+// @Summary Like / dislike a video
+// @Description Sets the current member's reaction to a video. An empty reaction clears it. Sending the same reaction again does not count twice.
+// @Tags Reaction
+// @Accept json
+// @Produce json
+// @Param video_id path int true "Video ID"
+// @Param body body ReactionBody true "Reaction"
+// @Success 200 {object} streaming_pb.ReactToVideoRes "React to video response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/reaction [post]
+func (s *StreamingHandler) ReactToVideo(c *fiber.Ctx) error {
+	videoID, err := c.ParamsInt("video_id")
+	if err != nil || videoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid video_id"})
+	}
+	var body ReactionBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.ReactToVideoReq{
+		VideoId:  int64(videoID),
+		MemberId: tokenMemberID(c),
+		Reaction: body.Reaction,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ReactToVideo(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// GetVideoReactions godoc
+// @Summary Get video reactions
+// @Description Retrieves like / dislike counts of a video and the current member's reaction.
+// @Tags Reaction
+// @Accept json
+// @Produce json
+// @Param video_id path int true "Video ID"
+// @Success 200 {object} streaming_pb.GetVideoReactionsRes "Get video reactions response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/reactions [get]
+func (s *StreamingHandler) GetVideoReactions(c *fiber.Ctx) error {
+	videoID, err := c.ParamsInt("video_id")
+	if err != nil || videoID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid video_id"})
+	}
+	req := &streaming_pb.GetVideoReactionsReq{
+		VideoId:  int64(videoID),
+		MemberId: tokenMemberID(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetVideoReactions(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ListLikedVideos godoc
+// @Summary List liked videos
+// @Description Lists videos liked by the current member with pagination, most recently liked first.
+// @Tags Reaction
+// @Accept json
+// @Produce json
+// @Param page query int false "Page (from 1)"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} streaming_pb.ListLikedVideosRes "List liked videos response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/liked [get]
+func (s *StreamingHandler) ListLikedVideos(c *fiber.Ctx) error {
+	req := &streaming_pb.ListLikedVideosReq{
+		MemberId: tokenMemberID(c),
+		Page:     int32(c.QueryInt("page", 1)),
+		PageSize: int32(c.QueryInt("page_size")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListLikedVideos(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
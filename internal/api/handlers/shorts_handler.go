@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetShortsFeed godoc
+// @Summary Get shorts feed
+// @Description Returns an endless, deduplicated page of short videos mixing followed channels, trending and fresh shorts. Pass next_cursor of the previous page to continue; each item carries poster and first-segment URLs for prefetching. Only ratings the caller may watch are listed; guests see general-audience shorts only.
+// @Tags Shorts
+// @Accept json
+// @Produce json
+// @Param cursor query string false "Cursor from the previous page"
+// @Param size query int false "Number of shorts"
+// @Success 200 {object} streaming_pb.GetShortsFeedRes "Shorts feed response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/shorts [get]
+func (s *StreamingHandler) GetShortsFeed(c *fiber.Ctx) error {
+	req := &streaming_pb.GetShortsFeedReq{
+		MemberId:  tokenMemberID(c),
+		Cursor:    c.Query("cursor"),
+		Size:      int32(c.QueryInt("size")),
+		ViewerAge: tokenViewerAge(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetShortsFeed(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// FollowChannel godoc
+// @Summary Follow a channel
+// @Description Follows the channel of an uploader; new shorts of followed channels are mixed into the shorts feed.
+// @Tags Shorts
+// @Accept json
+// @Produce json
+// @Param channel_id path string true "Channel (uploader member) ID"
+// @Success 200 {object} streaming_pb.FollowChannelRes "Follow channel response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/channels/{channel_id}/follow [post]
+func (s *StreamingHandler) FollowChannel(c *fiber.Ctx) error {
+	req := &streaming_pb.FollowChannelReq{
+		MemberId:  tokenMemberID(c),
+		ChannelId: c.Params("channel_id"),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.FollowChannel(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// UnfollowChannel godoc
+// @Summary Unfollow a channel
+// @Description Stops following the channel of an uploader.
+// @Tags Shorts
+// @Accept json
+// @Produce json
+// @Param channel_id path string true "Channel (uploader member) ID"
+// @Success 200 {object} streaming_pb.FollowChannelRes "Unfollow channel response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/channels/{channel_id}/follow [delete]
+func (s *StreamingHandler) UnfollowChannel(c *fiber.Ctx) error {
+	req := &streaming_pb.FollowChannelReq{
+		MemberId:  tokenMemberID(c),
+		ChannelId: c.Params("channel_id"),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.UnfollowChannel(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
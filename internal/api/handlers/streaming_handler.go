@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"strconv"
 	"streaming_video_service/pkg/logger"
+	"streaming_video_service/pkg/middlewares"
 	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -26,7 +28,7 @@ func NewStreamingHandler(streamingClient streaming_pb.StreamingServiceClient) *S
 
 // UploadVideo godoc
 // @Summary Upload Video via gRPC streaming
-// @Description Uploads a video file by first sending video metadata then streaming video chunks
+// @Description Uploads a video file by first sending video metadata then streaming video chunks. Upload quotas (total stored bytes, number of videos and uploads per 24 hours) are checked before the file is accepted; see GET /streaming/quota.
 // @Tags Streaming
 // @Accept multipart/form-data
 // @Produce json
@@ -34,15 +36,32 @@ func NewStreamingHandler(streamingClient streaming_pb.StreamingServiceClient) *S
 // @Param description formData string true "Video Description"
 // @Param type formData string true "Video Type (short or long)"
 // @Param file formData file true "Video File"
+// @Param visibility formData string false "Visibility (public, unlisted or private)"
+// @Param publish_at formData int false "Scheduled publish time (unix seconds)"
+// @Param tags formData string false "Comma separated tags"
+// @Param category_id formData int false "Category ID"
+// @Param watermark formData bool false "Burn the channel watermark into the video"
+// @Param content_rating formData string false "Content rating (all, 13+ or 18+, defaults to all)"
 // @Success 200 {object} streaming_pb.UploadVideoRes "Upload success response"
 // @Failure 400 {object} string "Bad Request"
+// @Failure 429 {object} streaming_pb.UploadVideoRes "Video count or daily upload quota exceeded"
 // @Failure 500 {object} string "Internal Server Error"
+// @Failure 507 {object} streaming_pb.UploadVideoRes "Storage quota exceeded"
 // @Router /streaming/upload [post]
 func (s *StreamingHandler) UploadVideo(c *fiber.Ctx) error {
 	// 1. 解析表單資料
 	title := c.FormValue("title")
 	description := c.FormValue("description")
 	videoType := c.FormValue("type")
+	visibility := c.FormValue("visibility")
+	publishAt, err := parseInt64Form(c.FormValue("publish_at"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid publish_at"})
+	}
+	categoryID, err := parseInt64Form(c.FormValue("category_id"))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid category_id"})
+	}
 
 	// 取得上傳的檔案
 	fileHeader, err := c.FormFile("file")
@@ -68,17 +87,27 @@ func (s *StreamingHandler) UploadVideo(c *fiber.Ctx) error {
 
 	// 3. 發送影片元資料（第一次消息）
 	metadata := &streaming_pb.VideoMetadata{
-		Title:       title,
-		Description: description,
-		Type:        videoType,
-		FileName:    fileHeader.Filename,
+		Title:         title,
+		Description:   description,
+		Type:          videoType,
+		FileName:      fileHeader.Filename,
+		MemberId:      tokenMemberID(c),
+		Visibility:    visibility,
+		PublishAt:     publishAt,
+		Tags:          splitCSV(c.FormValue("tags")),
+		CategoryId:    categoryID,
+		Watermark:     c.FormValue("watermark") == "true",
+		Role:          tokenRole(c),
+		ContentRating: c.FormValue("content_rating"),
+		SizeBytes:     fileHeader.Size,
 	}
 	req := &streaming_pb.UploadVideoReq{
 		Data: &streaming_pb.UploadVideoReq_Metadata{
 			Metadata: metadata,
 		},
 	}
-	if err := stream.Send(req); err != nil {
+	// io.EOF 表示服務端已回應（例如超過上傳配額），由 CloseAndRecv 取得回應
+	if err := stream.Send(req); err != nil && err != io.EOF {
 		logger.Log.Errorf("Send metadata failed", err)
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to send metadata"})
 	}
@@ -102,6 +131,9 @@ func (s *StreamingHandler) UploadVideo(c *fiber.Ctx) error {
 			},
 		}
 		if err := stream.Send(chunkReq); err != nil {
+			if err == io.EOF {
+				break
+			}
 			logger.Log.Errorf("Send chunk failed", err)
 			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "Error sending file chunk"})
 		}
@@ -114,17 +146,25 @@ func (s *StreamingHandler) UploadVideo(c *fiber.Ctx) error {
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to complete upload"})
 	}
 
-	// 6. 返回上傳結果
+	// 6. 返回上傳結果，超過儲存配額回傳 507，超過影片數或每日上傳次數回傳 429
+	switch res.QuotaExceeded {
+	case "":
+	case "storage":
+		return c.Status(http.StatusInsufficientStorage).JSON(res)
+	default:
+		return c.Status(http.StatusTooManyRequests).JSON(res)
+	}
 	return c.JSON(res)
 }
 
 // GetVideo godoc
 // @Summary Get video streaming info
-// @Description Retrieves video streaming info including the HLS URL for playback.
+// @Description Retrieves video streaming info including the HLS URL for playback and a list of candidate playback URLs (CDN, public bucket, gateway) to fall back through in order. Videos rated 13+ or 18+ are refused to guests and to members under that age; the age comes from the birthdate or verified-age flag in the token.
 // @Tags Streaming
 // @Accept json
 // @Produce json
 // @Param video_id path string true "Video ID"
+// @Param region query string false "Client region for region-specific CDN hosts (defaults to the X-Region header)"
 // @Success 200 {object} streaming_pb.GetVideoRes "Get video response"
 // @Failure 400 {object} string "Bad Request"
 // @Failure 404 {object} string "Video not found"
@@ -132,7 +172,10 @@ func (s *StreamingHandler) UploadVideo(c *fiber.Ctx) error {
 func (s *StreamingHandler) GetVideo(c *fiber.Ctx) error {
 	videoID := c.Params("video_id")
 	req := &streaming_pb.GetVideoReq{
-		VideoId: videoID,
+		VideoId:   videoID,
+		MemberId:  tokenMemberID(c),
+		Region:    c.Query("region", c.Get("X-Region")),
+		ViewerAge: tokenViewerAge(c),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -145,18 +188,24 @@ func (s *StreamingHandler) GetVideo(c *fiber.Ctx) error {
 
 // Search godoc
 // @Summary Search videos
-// @Description Searches for videos by keyword.
+// @Description Searches for videos by keyword. Only ratings the caller is old enough to watch are listed; guests only see videos rated for all ages.
 // @Tags Streaming
 // @Accept json
 // @Produce json
 // @Param key_word query string true "Search keyword"
+// @Param tag query string false "Filter by tag"
+// @Param category_id query int false "Filter by category ID"
 // @Success 200 {object} streaming_pb.SearchRes "Search response"
 // @Failure 400 {object} string "Bad Request"
 // @Router /streaming/search [get]
 func (s *StreamingHandler) Search(c *fiber.Ctx) error {
 	keyWord := c.Query("key_word")
 	req := &streaming_pb.SearchReq{
-		KeyWord: keyWord,
+		KeyWord:    keyWord,
+		Tag:        c.Query("tag"),
+		CategoryId: int64(c.QueryInt("category_id")),
+		MemberId:   tokenMemberID(c),
+		ViewerAge:  tokenViewerAge(c),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -169,11 +218,12 @@ func (s *StreamingHandler) Search(c *fiber.Ctx) error {
 
 // GetRecommendations godoc
 // @Summary Get recommended videos
-// @Description Retrieves recommended videos based on view counts.
+// @Description Retrieves recommended videos based on view counts. Only ratings the caller is old enough to watch are recommended; guests only get videos rated for all ages.
 // @Tags Streaming
 // @Accept json
 // @Produce json
 // @Param limit query int true "Number of recommendations"
+// @Param tags query string false "Comma separated preferred tags"
 // @Success 200 {object} streaming_pb.GetRecommendationsRes "Recommendations response"
 // @Failure 400 {object} string "Bad Request"
 // @Router /streaming/recommendations [get]
@@ -184,7 +234,10 @@ func (s *StreamingHandler) GetRecommendations(c *fiber.Ctx) error {
 		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid limit"})
 	}
 	req := &streaming_pb.GetRecommendationsReq{
-		Limit: int64(limit),
+		Limit:     int64(limit),
+		Tags:      splitCSV(c.Query("tags")),
+		MemberId:  tokenMemberID(c),
+		ViewerAge: tokenViewerAge(c),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -197,7 +250,7 @@ func (s *StreamingHandler) GetRecommendations(c *fiber.Ctx) error {
 
 // GetIndexM3U8 godoc
 // @Summary Get HLS index (m3u8) playlist
-// @Description Retrieves the m3u8 playlist file content.
+// @Description Retrieves the m3u8 playlist file content. Videos rated 13+ or 18+ are refused to guests and to members under that age.
 // @Tags Streaming
 // @Accept json
 // @Produce application/vnd.apple.mpegurl
@@ -208,7 +261,9 @@ func (s *StreamingHandler) GetRecommendations(c *fiber.Ctx) error {
 func (s *StreamingHandler) GetIndexM3U8(c *fiber.Ctx) error {
 	videoID := c.Params("video_id")
 	req := &streaming_pb.GetIndexM3U8Req{
-		VideoId: videoID,
+		VideoId:   videoID,
+		MemberId:  tokenMemberID(c),
+		ViewerAge: tokenViewerAge(c),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -216,13 +271,16 @@ func (s *StreamingHandler) GetIndexM3U8(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
 	c.Set("Content-Type", "application/vnd.apple.mpegurl")
 	return c.Send(res.Content)
 }
 
 // GetHlsSegment godoc
 // @Summary Get HLS segment (TS file)
-// @Description Retrieves a TS segment file content for video streaming.
+// @Description Retrieves a TS segment file content for video streaming. The poster image (poster.jpg), thumbnails (thumb_{name}_{size}.jpg) and the hover preview (preview.mp4) are served from the same path. Videos rated 13+ or 18+ are refused to guests and to members under that age.
 // @Tags Streaming
 // @Accept json
 // @Produce video/mp2t
@@ -235,8 +293,10 @@ func (s *StreamingHandler) GetHlsSegment(c *fiber.Ctx) error {
 	videoID := c.Params("video_id")
 	segment := c.Params("segment")
 	req := &streaming_pb.GetHlsSegmentReq{
-		VideoId: videoID,
-		Segment: segment,
+		VideoId:   videoID,
+		Segment:   segment,
+		MemberId:  tokenMemberID(c),
+		ViewerAge: tokenViewerAge(c),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -244,6 +304,287 @@ func (s *StreamingHandler) GetHlsSegment(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
-	c.Set("Content-Type", "video/mp2t")
+	c.Set("Content-Type", segmentContentType(segment))
 	return c.Send(res.Content)
 }
+
+// segmentContentType processed 目錄下除了 TS 分段，也提供封面圖與動畫預覽
+func segmentContentType(segment string) string {
+	if strings.HasSuffix(segment, ".jpg") {
+		return "image/jpeg"
+	}
+	if strings.HasSuffix(segment, ".mp4") {
+		return "video/mp4"
+	}
+	return "video/mp2t"
+}
+
+// UpdateVisibilityBody update visibility request body
+type UpdateVisibilityBody struct {
+	Visibility string `json:"visibility"` // "public", "unlisted", "private"
+	PublishAt  int64  `json:"publish_at"` // 排程公開時間（unix 秒），0 表示不排程
+}
+
+// UpdateVisibility godoc
+// @Summary Update video visibility
+// @Description Sets visibility (public, unlisted, private) and optional scheduled publish time. Only the uploader may change it.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body UpdateVisibilityBody true "Visibility setting"
+// @Success 200 {object} streaming_pb.UpdateVisibilityRes "Update visibility response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/visibility [post]
+func (s *StreamingHandler) UpdateVisibility(c *fiber.Ctx) error {
+	var body UpdateVisibilityBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.UpdateVisibilityReq{
+		VideoId:    c.Params("video_id"),
+		MemberId:   tokenMemberID(c),
+		Visibility: body.Visibility,
+		PublishAt:  body.PublishAt,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.UpdateVisibility(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// DeleteVideo godoc
+// @Summary Delete a video
+// @Description Uploader or admin only. Deletes the video together with its tags, shares, chapters, playlist entries, reactions, thumbnails, downloads and view statistics, and releases its stored bytes and video count from the uploader's quota; uploads already counted in the last 24 hours are not released. The public copy is removed immediately; other stored objects are reclaimed by the orphan object collector.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Success 200 {object} streaming_pb.DeleteVideoRes "Delete video response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id} [delete]
+func (s *StreamingHandler) DeleteVideo(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.DeleteVideo(ctx, &streaming_pb.DeleteVideoReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ShareVideoBody share video request body
+type ShareVideoBody struct {
+	MemberIDs []string `json:"member_ids"`
+}
+
+// ShareVideo godoc
+// @Summary Share a private video
+// @Description Adds members to the share list of a private video. Only the uploader may change it.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body ShareVideoBody true "Members to share with"
+// @Success 200 {object} streaming_pb.ShareVideoRes "Share video response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/share [post]
+func (s *StreamingHandler) ShareVideo(c *fiber.Ctx) error {
+	var body ShareVideoBody
+	if err := c.BodyParser(&body); err != nil || len(body.MemberIDs) == 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.ShareVideoReq{
+		VideoId:         c.Params("video_id"),
+		MemberId:        tokenMemberID(c),
+		TargetMemberIds: body.MemberIDs,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ShareVideo(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// UnshareVideo godoc
+// @Summary Unshare a private video
+// @Description Removes members from the share list of a private video. Only the uploader may change it.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body ShareVideoBody true "Members to remove"
+// @Success 200 {object} streaming_pb.UnshareVideoRes "Unshare video response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/share [delete]
+func (s *StreamingHandler) UnshareVideo(c *fiber.Ctx) error {
+	var body ShareVideoBody
+	if err := c.BodyParser(&body); err != nil || len(body.MemberIDs) == 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	req := &streaming_pb.UnshareVideoReq{
+		VideoId:         c.Params("video_id"),
+		MemberId:        tokenMemberID(c),
+		TargetMemberIds: body.MemberIDs,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.UnshareVideo(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// ListCategories godoc
+// @Summary List categories
+// @Description Lists video categories with pagination.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param page query int false "Page (from 1)"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} streaming_pb.ListCategoriesRes "List categories response"
+// @Failure 500 {object} string "Internal Server Error"
+// @Router /streaming/categories [get]
+func (s *StreamingHandler) ListCategories(c *fiber.Ctx) error {
+	req := &streaming_pb.ListCategoriesReq{
+		Page:     int32(c.QueryInt("page", 1)),
+		PageSize: int32(c.QueryInt("page_size")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListCategories(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(res)
+}
+
+// BrowseCategory godoc
+// @Summary Browse videos of a category
+// @Description Lists public videos of a category with pagination, ordered by view count. Only ratings the caller may watch are listed; guests see general-audience videos only.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param category_id path int true "Category ID"
+// @Param page query int false "Page (from 1)"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} streaming_pb.BrowseCategoryRes "Browse category response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/categories/{category_id} [get]
+func (s *StreamingHandler) BrowseCategory(c *fiber.Ctx) error {
+	categoryID, err := c.ParamsInt("category_id")
+	if err != nil || categoryID <= 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid category_id"})
+	}
+	req := &streaming_pb.BrowseCategoryReq{
+		CategoryId: int64(categoryID),
+		Page:       int32(c.QueryInt("page", 1)),
+		PageSize:   int32(c.QueryInt("page_size")),
+		MemberId:   tokenMemberID(c),
+		ViewerAge:  tokenViewerAge(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.BrowseCategory(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// GetRelatedVideos godoc
+// @Summary Get related videos
+// @Description Retrieves videos sharing the most tags with the given video, filled up with popular videos of the same category. Only ratings the caller may watch are listed; guests see general-audience videos only.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param limit query int false "Number of related videos"
+// @Success 200 {object} streaming_pb.GetRelatedVideosRes "Related videos response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/related [get]
+func (s *StreamingHandler) GetRelatedVideos(c *fiber.Ctx) error {
+	req := &streaming_pb.GetRelatedVideosReq{
+		VideoId:   c.Params("video_id"),
+		MemberId:  tokenMemberID(c),
+		Limit:     int64(c.QueryInt("limit")),
+		ViewerAge: tokenViewerAge(c),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetRelatedVideos(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// splitCSV 將逗號分隔字串拆成 slice，忽略空白項目
+func splitCSV(v string) []string {
+	var res []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// tokenMemberID 取得 JWTMiddleware 寫入的 member_id，未登入時回傳空字串
+func tokenMemberID(c *fiber.Ctx) string {
+	memberID, _ := c.Locals(middlewares.TokenMemberID).(string)
+	return memberID
+}
+
+// tokenRole 取得 JWT 中的角色
+func tokenRole(c *fiber.Ctx) string {
+	role, _ := c.Locals(middlewares.TokenRole).(string)
+	return role
+}
+
+// tokenViewerAge 取得 JWT 中的生日與成年驗證，用於分級的觀看限制
+func tokenViewerAge(c *fiber.Ctx) *streaming_pb.ViewerAge {
+	birthdate, _ := c.Locals(middlewares.TokenBirthdate).(string)
+	ageVerified, _ := c.Locals(middlewares.TokenAgeVerified).(bool)
+	return &streaming_pb.ViewerAge{
+		Birthdate:   birthdate,
+		AgeVerified: ageVerified,
+	}
+}
+
+// parseInt64Form 解析整數表單欄位，空值回傳 0
+func parseInt64Form(v string) (int64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
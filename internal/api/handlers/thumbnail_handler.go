@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetActiveThumbnailBody set active thumbnail request body
+type SetActiveThumbnailBody struct {
+	ThumbnailID uint64 `json:"thumbnail_id"`
+}
+
+// ListThumbnails godoc
+// @Summary List thumbnails of a video
+// @Description Uploader only. Lists the candidate thumbnails picked during transcoding (scored by scene change, brightness and contrast, best first) followed by uploaded custom thumbnails. Each thumbnail has large (1280x720), medium (640x360) and small (320x180) images.
+// @Tags Thumbnail
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Success 200 {object} streaming_pb.ListThumbnailsRes "List thumbnails response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/thumbnails [get]
+func (s *StreamingHandler) ListThumbnails(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.ListThumbnails(ctx, &streaming_pb.ListThumbnailsReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
+
+// UploadThumbnail godoc
+// @Summary Upload a custom thumbnail
+// @Description Uploader only. Uploads a PNG or JPEG image (up to 2MB, at least 640x360), which is resized into every thumbnail size. Use the thumbnail endpoint to make it active. A video keeps at most 5 custom thumbnails.
+// @Tags Thumbnail
+// @Accept multipart/form-data
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param image formData file true "Thumbnail image"
+// @Success 201 {object} streaming_pb.ThumbnailRes "Thumbnail response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/thumbnails [post]
+func (s *StreamingHandler) UploadThumbnail(c *fiber.Ctx) error {
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Image is required"})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Failed to open image"})
+	}
+	defer file.Close()
+	image, err := io.ReadAll(file)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Failed to read image"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.UploadThumbnail(ctx, &streaming_pb.UploadThumbnailReq{
+		VideoId:  c.Params("video_id"),
+		MemberId: tokenMemberID(c),
+		Image:    image,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.Status(http.StatusCreated).JSON(res)
+}
+
+// SetActiveThumbnail godoc
+// @Summary Choose the active thumbnail
+// @Description Uploader only. Makes a candidate or custom thumbnail the poster of the video. A custom thumbnail stays active when the video is transcoded again.
+// @Tags Thumbnail
+// @Accept json
+// @Produce json
+// @Param video_id path string true "Video ID"
+// @Param body body SetActiveThumbnailBody true "Thumbnail"
+// @Success 200 {object} streaming_pb.ThumbnailRes "Thumbnail response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/video/{video_id}/thumbnail [put]
+func (s *StreamingHandler) SetActiveThumbnail(c *fiber.Ctx) error {
+	var body SetActiveThumbnailBody
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetActiveThumbnail(ctx, &streaming_pb.SetActiveThumbnailReq{
+		VideoId:     c.Params("video_id"),
+		MemberId:    tokenMemberID(c),
+		ThumbnailId: body.ThumbnailID,
+	})
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTrending godoc
+// @Summary Get trending videos
+// @Description Lists trending videos ranked by time-decayed views, likes and comments of the last 48 hours, optionally filtered by category and type.
+// @Tags Streaming
+// @Accept json
+// @Produce json
+// @Param category_id query int false "Category ID"
+// @Param type query string false "Video type (short, long)"
+// @Param page query int false "Page (from 1)"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} streaming_pb.GetTrendingRes "Trending videos response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/trending [get]
+func (s *StreamingHandler) GetTrending(c *fiber.Ctx) error {
+	categoryID := c.QueryInt("category_id")
+	if categoryID < 0 {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "Invalid category_id"})
+	}
+	req := &streaming_pb.GetTrendingReq{
+		CategoryId: int64(categoryID),
+		Type:       c.Query("type"),
+		Page:       int32(c.QueryInt("page", 1)),
+		PageSize:   int32(c.QueryInt("page_size")),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetTrending(ctx, req)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+	"streaming_video_service/pkg/token"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetChannelWatermark godoc
+// @Summary Set channel watermark
+// @Description Uploads the channel watermark image (PNG or JPEG, up to 1MB) and sets its position and opacity. The image may be omitted to only change settings. Uploads choose whether to burn it in with the watermark form field.
+// @Tags Watermark
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file false "Watermark image, required the first time"
+// @Param position formData string false "top-left, top-right, bottom-left or bottom-right; omitted keeps the current setting (default bottom-right the first time)"
+// @Param opacity formData number false "Opacity between 0 and 1; omitted keeps the current setting (default 0.6 the first time)"
+// @Param enabled formData bool false "Whether the watermark is enabled; omitted keeps the current setting (default true the first time)"
+// @Success 200 {object} streaming_pb.WatermarkRes "Watermark response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/channel/watermark [put]
+func (s *StreamingHandler) SetChannelWatermark(c *fiber.Ctx) error {
+	req, err := parseWatermarkForm(c)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetChannelWatermark(ctx, req)
+	return watermarkResponse(c, res, err)
+}
+
+// GetChannelWatermark godoc
+// @Summary Get channel watermark
+// @Description Retrieves the watermark settings of the current member's channel.
+// @Tags Watermark
+// @Accept json
+// @Produce json
+// @Success 200 {object} streaming_pb.WatermarkRes "Watermark response"
+// @Failure 400 {object} string "Bad Request"
+// @Router /streaming/channel/watermark [get]
+func (s *StreamingHandler) GetChannelWatermark(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetChannelWatermark(ctx, &streaming_pb.GetWatermarkReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+	})
+	return watermarkResponse(c, res, err)
+}
+
+// SetPlatformWatermark godoc
+// @Summary Set platform watermark
+// @Description Admin only. Sets the platform-wide watermark burned into videos uploaded by free-tier members.
+// @Tags Watermark
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file false "Watermark image, required the first time"
+// @Param position formData string false "top-left, top-right, bottom-left or bottom-right; omitted keeps the current setting (default bottom-right the first time)"
+// @Param opacity formData number false "Opacity between 0 and 1; omitted keeps the current setting (default 0.6 the first time)"
+// @Param enabled formData bool false "Whether the watermark is enabled; omitted keeps the current setting (default true the first time)"
+// @Success 200 {object} streaming_pb.WatermarkRes "Watermark response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/watermark [put]
+func (s *StreamingHandler) SetPlatformWatermark(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	req, err := parseWatermarkForm(c)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.SetPlatformWatermark(ctx, req)
+	return watermarkResponse(c, res, err)
+}
+
+// GetPlatformWatermark godoc
+// @Summary Get platform watermark
+// @Description Admin only. Retrieves the platform-wide watermark settings for free-tier content.
+// @Tags Watermark
+// @Accept json
+// @Produce json
+// @Success 200 {object} streaming_pb.WatermarkRes "Watermark response"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 403 {object} string "Forbidden"
+// @Router /streaming/admin/watermark [get]
+func (s *StreamingHandler) GetPlatformWatermark(c *fiber.Ctx) error {
+	if tokenRole(c) != string(token.RoleAdmin) {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "Admin only"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := s.StreamingClient.GetPlatformWatermark(ctx, &streaming_pb.GetWatermarkReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+	})
+	return watermarkResponse(c, res, err)
+}
+
+// parseWatermarkForm 解析浮水印設定表單，圖片為選填
+func parseWatermarkForm(c *fiber.Ctx) (*streaming_pb.SetWatermarkReq, error) {
+	req := &streaming_pb.SetWatermarkReq{
+		MemberId: tokenMemberID(c),
+		Role:     tokenRole(c),
+		Position: c.FormValue("position"),
+	}
+	// 未帶 enabled 時不送出，沿用目前的開關設定
+	if enabled := c.FormValue("enabled"); enabled != "" {
+		value, err := strconv.ParseBool(enabled)
+		if err != nil {
+			return nil, fiber.NewError(http.StatusBadRequest, "Invalid enabled")
+		}
+		req.Enabled = &value
+	}
+	if opacity := c.FormValue("opacity"); opacity != "" {
+		value, err := strconv.ParseFloat(opacity, 64)
+		if err != nil {
+			return nil, fiber.NewError(http.StatusBadRequest, "Invalid opacity")
+		}
+		req.Opacity = value
+	}
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		// 未附圖片時只更新設定
+		return req, nil
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "Failed to open image")
+	}
+	defer file.Close()
+	if req.Image, err = io.ReadAll(file); err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "Failed to read image")
+	}
+	return req, nil
+}
+
+func watermarkResponse(c *fiber.Ctx, res *streaming_pb.WatermarkRes, err error) error {
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !res.Success {
+		return c.Status(http.StatusBadRequest).JSON(res)
+	}
+	return c.JSON(res)
+}
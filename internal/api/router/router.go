@@ -26,6 +26,8 @@ func RegisterRoutes(app *fiber.App, memberHandler *handlers.MemberHandler, strea
 
 	memberRoutes.Use(middlewares.JWTMiddleware())
 	memberRoutes.Post("/logout", memberHandler.Logout)
+	memberRoutes.Put("/birthdate", memberHandler.SetBirthdate)
+	memberRoutes.Post("/:member_id/age-verification", memberHandler.SetAgeVerified)
 
 	// Chat 路由：加上 JWT Middleware，並建立 WebSocket 連線
 	// chatRoutes := app.Group("/chat")
@@ -38,9 +40,78 @@ func RegisterRoutes(app *fiber.App, memberHandler *handlers.MemberHandler, strea
 	streamingRoutes := app.Group("/streaming")
 	streamingRoutes.Use(middlewares.JWTMiddleware())
 	streamingRoutes.Post("/upload", streamingHandler.UploadVideo)
+	streamingRoutes.Get("/uploads", streamingHandler.ListUploads)
 	streamingRoutes.Get("/video/:video_id", streamingHandler.GetVideo)
+	streamingRoutes.Post("/video/:video_id/visibility", streamingHandler.UpdateVisibility)
+	streamingRoutes.Post("/video/:video_id/share", streamingHandler.ShareVideo)
+	streamingRoutes.Delete("/video/:video_id/share", streamingHandler.UnshareVideo)
+	streamingRoutes.Get("/video/:video_id/related", streamingHandler.GetRelatedVideos)
+	streamingRoutes.Put("/video/:video_id/chapters", streamingHandler.SetChapters)
+	streamingRoutes.Get("/video/:video_id/chapters.vtt", streamingHandler.GetChaptersVTT)
+	streamingRoutes.Post("/video/:video_id/clips", streamingHandler.CreateClip)
+	streamingRoutes.Post("/video/:video_id/reaction", streamingHandler.ReactToVideo)
+	streamingRoutes.Get("/video/:video_id/reactions", streamingHandler.GetVideoReactions)
+	streamingRoutes.Get("/video/:video_id/comments", streamingHandler.ListComments)
+	streamingRoutes.Post("/video/:video_id/comments", streamingHandler.PostComment)
 	streamingRoutes.Get("/video/hls/:video_id/index", streamingHandler.GetIndexM3U8)
 	streamingRoutes.Get("/video/hls/:video_id/:segment", streamingHandler.GetHlsSegment)
 	streamingRoutes.Get("/search", streamingHandler.Search)
 	streamingRoutes.Get("/recommend", streamingHandler.GetRecommendations)
+	streamingRoutes.Get("/trending", streamingHandler.GetTrending)
+	streamingRoutes.Get("/categories", streamingHandler.ListCategories)
+	streamingRoutes.Get("/categories/:category_id", streamingHandler.BrowseCategory)
+	streamingRoutes.Get("/liked", streamingHandler.ListLikedVideos)
+
+	// 播放 QoE：播放器批次回報 beacon，上傳者與管理員查詢統計
+	streamingRoutes.Post("/qoe", streamingHandler.ReportQoE)
+	streamingRoutes.Get("/video/:video_id/qoe", streamingHandler.GetQoESummary)
+
+	// 浮水印：頻道浮水印與平台浮水印（管理員）
+	streamingRoutes.Get("/channel/watermark", streamingHandler.GetChannelWatermark)
+	streamingRoutes.Put("/channel/watermark", streamingHandler.SetChannelWatermark)
+	streamingRoutes.Get("/admin/watermark", streamingHandler.GetPlatformWatermark)
+	streamingRoutes.Put("/admin/watermark", streamingHandler.SetPlatformWatermark)
+
+	// 檢舉與審核佇列（管理員）、影片分級
+	streamingRoutes.Post("/video/:video_id/report", streamingHandler.ReportVideo)
+	streamingRoutes.Get("/admin/reports", streamingHandler.ListReports)
+	streamingRoutes.Post("/admin/reports/:report_id/claim", streamingHandler.ClaimReport)
+	streamingRoutes.Post("/admin/reports/:report_id/resolve", streamingHandler.ResolveReport)
+	streamingRoutes.Get("/admin/moderation/audit", streamingHandler.ListModerationAudit)
+	streamingRoutes.Put("/video/:video_id/rating", streamingHandler.SetContentRating)
+
+	// 縮圖：轉碼時挑選的候選縮圖與自訂縮圖
+	streamingRoutes.Get("/video/:video_id/thumbnails", streamingHandler.ListThumbnails)
+	streamingRoutes.Post("/video/:video_id/thumbnails", streamingHandler.UploadThumbnail)
+	streamingRoutes.Put("/video/:video_id/thumbnail", streamingHandler.SetActiveThumbnail)
+
+	// 離線下載：依畫質產生 MP4 並回傳短效連結，上傳者可停用下載
+	streamingRoutes.Post("/video/:video_id/download", streamingHandler.RequestDownload)
+	streamingRoutes.Put("/video/:video_id/download", streamingHandler.SetDownloadEnabled)
+
+	// 上傳配額：查詢自己的配額與用量、管理員個別設定；刪除影片時釋放用量
+	streamingRoutes.Get("/quota", streamingHandler.GetQuotaUsage)
+	streamingRoutes.Put("/admin/quota/:member_id", streamingHandler.SetMemberQuota)
+	streamingRoutes.Delete("/video/:video_id", streamingHandler.DeleteVideo)
+
+	// 短影音動態與追蹤頻道
+	streamingRoutes.Get("/shorts", streamingHandler.GetShortsFeed)
+	streamingRoutes.Post("/channels/:channel_id/follow", streamingHandler.FollowChannel)
+	streamingRoutes.Delete("/channels/:channel_id/follow", streamingHandler.UnfollowChannel)
+
+	// 留言
+	streamingRoutes.Get("/comments/:comment_id/replies", streamingHandler.ListCommentReplies)
+	streamingRoutes.Patch("/comments/:comment_id", streamingHandler.EditComment)
+	streamingRoutes.Delete("/comments/:comment_id", streamingHandler.DeleteComment)
+	streamingRoutes.Post("/comments/:comment_id/pin", streamingHandler.PinComment)
+
+	// 播放清單，:playlist_id 可帶 "watch_later"
+	streamingRoutes.Get("/playlists", streamingHandler.ListPlaylists)
+	streamingRoutes.Post("/playlists", streamingHandler.CreatePlaylist)
+	streamingRoutes.Get("/playlists/:playlist_id", streamingHandler.GetPlaylist)
+	streamingRoutes.Patch("/playlists/:playlist_id", streamingHandler.UpdatePlaylist)
+	streamingRoutes.Delete("/playlists/:playlist_id", streamingHandler.DeletePlaylist)
+	streamingRoutes.Post("/playlists/:playlist_id/items", streamingHandler.AddPlaylistItem)
+	streamingRoutes.Put("/playlists/:playlist_id/items", streamingHandler.ReorderPlaylist)
+	streamingRoutes.Delete("/playlists/:playlist_id/items/:video_id", streamingHandler.RemovePlaylistItem)
 }
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"streaming_video_service/internal/chat/domain"
+	"streaming_video_service/internal/chat/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// MemberNotifier 消費 streaming_service 的會員通知事件（例如 notify_comment），推播給事件指定的會員
+// 推播到 chat:user:{memberID}，由會員連線所在的 ChatWebsocketHandler 轉送到 websocket
+type MemberNotifier struct {
+	queue        database.JobQueue
+	memberPubSub repository.PubSubRepository
+}
+
+// NewMemberNotifier init member notifier
+func NewMemberNotifier(queue database.JobQueue, pub repository.PubSubRepository) *MemberNotifier {
+	return &MemberNotifier{
+		queue:        queue,
+		memberPubSub: pub,
+	}
+}
+
+// Start 開始消費會員通知事件，直到 ctx 結束
+func (n *MemberNotifier) Start(ctx context.Context) {
+	logger.Log.Info("MemberNotifier 已啟動，等待會員通知事件")
+	if err := n.queue.Consume(ctx, domain.NotificationTopic, n.Handle); err != nil && ctx.Err() == nil {
+		logger.Log.Error("MemberNotifier 消費會員通知事件失敗", zap.Error(err))
+	}
+}
+
+// Handle 推播一則會員通知，推播失敗時回傳錯誤讓事件重新投遞
+// 無法解析或沒有 action 的事件重新投遞也不會成功，記錄後略過
+func (n *MemberNotifier) Handle(ctx context.Context, msg database.QueueMessage) error {
+	var event domain.NotificationEvent
+	if err := json.Unmarshal(msg.Body, &event); err != nil {
+		logger.Log.Error("解析會員通知事件失敗", zap.String("messageID", msg.ID), zap.Error(err))
+		return nil
+	}
+	if event.MemberID == "" || event.Action == "" {
+		return nil
+	}
+
+	resp := domain.WSResponse{
+		Action:  event.Action,
+		Success: true,
+		Payload: event.Payload,
+	}
+	if err := n.memberPubSub.Publish("chat:user:"+event.MemberID, resp); err != nil {
+		return fmt.Errorf("memberID[%s] action[%s] 推播會員通知失敗: %w", event.MemberID, event.Action, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/chat/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 測試 MemberNotifier
+func TestMemberNotifier(t *testing.T) {
+	logger.SetNewNop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	commentEvent := []byte(`{"member_id":"uploader","action":"notify_comment","payload":{"video_id":7,"comment_id":"c1","content":"nice"}}`)
+
+	// **情境 1: 由工作佇列收到新留言通知，原樣推播給事件指定的會員**
+	t.Run("推播會員通知", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+		queue := database.NewMemoryJobQueue(database.DefaultRetryPolicy)
+		defer queue.Close()
+
+		pushed := make(chan domain.WSResponse, 1)
+		mockPubSub.On("Publish", "chat:user:uploader", mock.Anything).Run(func(args mock.Arguments) {
+			pushed <- args.Get(1).(domain.WSResponse)
+		}).Return(nil).Once()
+
+		go NewMemberNotifier(queue, mockPubSub).Start(ctx)
+		assert.NoError(t, queue.Publish(ctx, domain.NotificationTopic, database.QueueMessage{ID: "1", Type: "comment.created", Body: commentEvent}))
+
+		select {
+		case resp := <-pushed:
+			assert.Equal(t, "notify_comment", resp.Action)
+			assert.True(t, resp.Success)
+			assert.Equal(t, map[string]interface{}{
+				"video_id":   float64(7),
+				"comment_id": "c1",
+				"content":    "nice",
+			}, resp.Payload)
+		case <-time.After(2 * time.Second):
+			t.Fatal("未推播會員通知")
+		}
+	})
+
+	// **情境 2: 推播失敗時回傳錯誤，讓事件重新投遞**
+	t.Run("推播失敗", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+		mockPubSub.On("Publish", "chat:user:uploader", mock.Anything).Return(errors.New("redis down")).Once()
+
+		err := NewMemberNotifier(nil, mockPubSub).Handle(ctx, database.QueueMessage{Body: commentEvent})
+
+		assert.Error(t, err)
+	})
+
+	// **情境 3: 無法解析或沒有指定會員的事件直接略過**
+	t.Run("無法推播的事件", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+
+		assert.NoError(t, NewMemberNotifier(nil, mockPubSub).Handle(ctx, database.QueueMessage{Body: []byte("not json")}))
+		assert.NoError(t, NewMemberNotifier(nil, mockPubSub).Handle(ctx, database.QueueMessage{Body: []byte(`{"action":"notify_comment"}`)}))
+		mockPubSub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
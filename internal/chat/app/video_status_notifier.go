@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"streaming_video_service/internal/chat/domain"
+	"streaming_video_service/internal/chat/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// VideoStatusNotifier 消費 streaming_service 的影片狀態事件，推播 notify_video_status 給上傳者
+// 推播到 chat:user:{memberID}，由上傳者連線所在的 ChatWebsocketHandler 轉送到 websocket
+type VideoStatusNotifier struct {
+	queue        database.JobQueue
+	memberPubSub repository.PubSubRepository
+}
+
+// NewVideoStatusNotifier init video status notifier
+func NewVideoStatusNotifier(queue database.JobQueue, pub repository.PubSubRepository) *VideoStatusNotifier {
+	return &VideoStatusNotifier{
+		queue:        queue,
+		memberPubSub: pub,
+	}
+}
+
+// Start 開始消費影片狀態事件，直到 ctx 結束
+func (n *VideoStatusNotifier) Start(ctx context.Context) {
+	logger.Log.Info("VideoStatusNotifier 已啟動，等待影片狀態事件")
+	if err := n.queue.Consume(ctx, domain.VideoStatusTopic, n.Handle); err != nil && ctx.Err() == nil {
+		logger.Log.Error("VideoStatusNotifier 消費影片狀態事件失敗", zap.Error(err))
+	}
+}
+
+// Handle 推播一則影片狀態事件，推播失敗時回傳錯誤讓事件重新投遞
+// 無法解析的事件重新投遞也不會成功，記錄後略過
+func (n *VideoStatusNotifier) Handle(ctx context.Context, msg database.QueueMessage) error {
+	var event domain.VideoStatusEvent
+	if err := json.Unmarshal(msg.Body, &event); err != nil {
+		logger.Log.Error("解析影片狀態事件失敗", zap.String("messageID", msg.ID), zap.Error(err))
+		return nil
+	}
+	if event.MemberID == "" {
+		return nil
+	}
+
+	resp := domain.WSResponse{
+		Action:  string(domain.NotifyVideoStatus),
+		Success: true,
+		Payload: map[string]interface{}{
+			"video_id":      event.VideoID,
+			"title":         event.Title,
+			"status":        event.Status,
+			"thumbnail_url": event.ThumbnailURL,
+		},
+	}
+	if err := n.memberPubSub.Publish("chat:user:"+event.MemberID, resp); err != nil {
+		return fmt.Errorf("memberID[%s] videoID[%d] 推播影片狀態失敗: %w", event.MemberID, event.VideoID, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/chat/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 測試 VideoStatusNotifier
+func TestVideoStatusNotifier(t *testing.T) {
+	logger.SetNewNop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	readyEvent := []byte(`{"video_id":7,"member_id":"uploader","title":"My Video","status":"ready","thumbnail_url":"http://cdn/7/poster.jpg"}`)
+
+	// **情境 1: 由工作佇列收到 video.ready，推播給上傳者**
+	t.Run("推播影片狀態", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+		queue := database.NewMemoryJobQueue(database.DefaultRetryPolicy)
+		defer queue.Close()
+
+		pushed := make(chan domain.WSResponse, 1)
+		mockPubSub.On("Publish", "chat:user:uploader", mock.Anything).Run(func(args mock.Arguments) {
+			pushed <- args.Get(1).(domain.WSResponse)
+		}).Return(nil).Once()
+
+		go NewVideoStatusNotifier(queue, mockPubSub).Start(ctx)
+		assert.NoError(t, queue.Publish(ctx, domain.VideoStatusTopic, database.QueueMessage{ID: "1", Type: "video.ready", Body: readyEvent}))
+
+		select {
+		case resp := <-pushed:
+			assert.Equal(t, string(domain.NotifyVideoStatus), resp.Action)
+			assert.True(t, resp.Success)
+			assert.Equal(t, map[string]interface{}{
+				"video_id":      uint(7),
+				"title":         "My Video",
+				"status":        "ready",
+				"thumbnail_url": "http://cdn/7/poster.jpg",
+			}, resp.Payload)
+		case <-time.After(2 * time.Second):
+			t.Fatal("未推播影片狀態")
+		}
+	})
+
+	// **情境 2: 推播失敗時回傳錯誤，讓事件重新投遞**
+	t.Run("推播失敗", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+		mockPubSub.On("Publish", "chat:user:uploader", mock.Anything).Return(errors.New("redis down")).Once()
+
+		err := NewVideoStatusNotifier(nil, mockPubSub).Handle(ctx, database.QueueMessage{Body: readyEvent})
+
+		assert.Error(t, err)
+	})
+
+	// **情境 3: 無法解析的事件直接略過**
+	t.Run("無法解析的事件", func(t *testing.T) {
+		mockPubSub := new(MockRedisPubSub)
+
+		err := NewVideoStatusNotifier(nil, mockPubSub).Handle(ctx, database.QueueMessage{Body: []byte("not json")})
+
+		assert.NoError(t, err)
+		mockPubSub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
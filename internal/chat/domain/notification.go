@@ -0,0 +1,11 @@
+package domain
+
+// NotificationTopic streaming_service 發布會員通知事件（例如新留言）的 topic
+const NotificationTopic = "member.notification"
+
+// NotificationEvent streaming_service 的會員通知事件，Action 與 Payload 原樣轉送到會員的 websocket
+type NotificationEvent struct {
+	MemberID string                 `json:"member_id"`
+	Action   string                 `json:"action"`
+	Payload  map[string]interface{} `json:"payload,omitempty"`
+}
@@ -0,0 +1,13 @@
+package domain
+
+// VideoStatusTopic streaming_service 發布影片狀態事件（video.ready / video.failed）的 topic
+const VideoStatusTopic = "video.status"
+
+// VideoStatusEvent streaming_service 的影片狀態事件
+type VideoStatusEvent struct {
+	VideoID      uint   `json:"video_id"`
+	MemberID     string `json:"member_id"` // 上傳者
+	Title        string `json:"title"`
+	Status       string `json:"status"` // ready 或 failed
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
@@ -44,6 +44,8 @@ const (
 
 	// NotifyMessage websocket action notify_message
 	NotifyMessage Action = "notify_message"
+	// NotifyVideoStatus websocket action notify_video_status，上傳的影片轉碼完成或失敗
+	NotifyVideoStatus Action = "notify_video_status"
 )
 
 // WSRequest websocket Request
@@ -55,6 +55,13 @@ func (r *redisPubSub) Subscribe(ctx context.Context, channel string, handler fun
 					return
 				}
 
+				// 其他服務發布的通知（例如新留言）已是 WSResponse 格式，直接轉送
+				var notification domain.WSResponse
+				if err := json.Unmarshal([]byte(m.Payload), &notification); err == nil && notification.Action != "" {
+					handler(notification)
+					continue
+				}
+
 				var result domain.ChatMessage
 				if err := json.Unmarshal([]byte(m.Payload), &result); err != nil {
 					logger.Log.Error("Logout err :", zap.String("err", fmt.Sprintf("failed to unmarshal session data: %v", err)))
@@ -62,9 +62,11 @@ func (s *MemberGRPCServer) FindMember(ctx context.Context, req *memberpb.FindByM
 	return &memberpb.FindByMemberRes{
 		Success: true,
 		Info: &memberpb.MemberInfo{
-			Id:       member.MemberID,
-			Email:    member.Email,
-			Password: member.Password,
+			Id:          member.MemberID,
+			Email:       member.Email,
+			Password:    member.Password,
+			Birthdate:   member.BirthdateString(),
+			AgeVerified: member.AgeVerified,
 		},
 		Message: "create success",
 	}, nil
@@ -154,3 +156,35 @@ func (s *MemberGRPCServer) ReconnectSession(ctx context.Context, req *memberpb.R
 		Message: "logout success",
 	}, nil
 }
+
+// SetBirthdate 實作 SetBirthdate
+func (s *MemberGRPCServer) SetBirthdate(ctx context.Context, req *memberpb.SetBirthdateReq) (*memberpb.SetBirthdateRes, error) {
+	logger.Log.Info("SetBirthdate", zap.String("member_id", req.GetMemberId()), zap.String("birthdate", req.GetBirthdate()))
+	err := s.Usecase.SetBirthdate(ctx, req.GetMemberId(), req.GetBirthdate(), time.Now())
+	if err != nil {
+		return &memberpb.SetBirthdateRes{
+			Success: false,
+			Message: err.Error(),
+		}, err
+	}
+	return &memberpb.SetBirthdateRes{
+		Success: true,
+		Message: "set birthdate success",
+	}, nil
+}
+
+// SetAgeVerified 實作 SetAgeVerified
+func (s *MemberGRPCServer) SetAgeVerified(ctx context.Context, req *memberpb.SetAgeVerifiedReq) (*memberpb.SetAgeVerifiedRes, error) {
+	logger.Log.Info("SetAgeVerified", zap.String("member_id", req.GetMemberId()), zap.Bool("verified", req.GetVerified()))
+	err := s.Usecase.SetAgeVerified(ctx, req.GetMemberId(), req.GetVerified())
+	if err != nil {
+		return &memberpb.SetAgeVerifiedRes{
+			Success: false,
+			Message: err.Error(),
+		}, err
+	}
+	return &memberpb.SetAgeVerifiedRes{
+		Success: true,
+		Message: "set age verified success",
+	}, nil
+}
@@ -27,6 +27,8 @@ type MemberUseCase interface {
 	ForceLogout(ctx context.Context, memberID string) error
 	CheckSessionTimeout(ctx context.Context, token string) (bool, error)
 	ReconnectSession(ctx context.Context, token string) error
+	SetBirthdate(ctx context.Context, memberID, birthdate string, now time.Time) error
+	SetAgeVerified(ctx context.Context, memberID string, verified bool) error
 }
 
 type memberUseCase struct {
@@ -101,7 +103,10 @@ func (m *memberUseCase) Login(ctx context.Context, email, password string, now t
 
 	member.Status = domain.MemberStatusOnLine
 
-	token, err := token.GenerateJWTWrapper(member.MemberID, string(token.RoleMember))
+	token, err := token.GenerateJWTWrapper(member.MemberID, string(member.TokenRole()), token.AgeClaims{
+		Birthdate:   member.BirthdateString(),
+		AgeVerified: member.AgeVerified,
+	})
 	if err != nil {
 		errMsg := fmt.Sprintf("email[%s] can't GenerateJWT!!!", email)
 		return "", errprocess.Set(errMsg)
@@ -215,3 +220,46 @@ func (m *memberUseCase) ReconnectSession(ctx context.Context, t string) error {
 	}
 	return nil
 }
+
+// SetBirthdate 會員填寫生日，填寫後不可自行修改，需由管理員驗證年齡
+// 生日寫入 JWT，下次登入後才會套用到分級內容的觀看限制
+func (m *memberUseCase) SetBirthdate(ctx context.Context, memberID, birthdate string, now time.Time) error {
+	parsed, err := domain.ParseBirthdate(birthdate, now)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] %v", memberID, err)
+		return errprocess.Set(errMsg)
+	}
+
+	member, err := m.memberRepo.FindByMember(ctx, &domain.MemberQuery{MemberID: &memberID})
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] can't find!!!", memberID)
+		return errprocess.Set(errMsg)
+	}
+	if member.Birthdate != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 已填寫生日，無法修改", memberID)
+		return errprocess.Set(errMsg)
+	}
+
+	member.Birthdate = &parsed
+	if err := m.memberRepo.UpdateAgeProfile(ctx, member); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] can't UpdateAgeProfile error :%v !!!", memberID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// SetAgeVerified 管理員設定會員是否已驗證成年，下次登入後生效
+func (m *memberUseCase) SetAgeVerified(ctx context.Context, memberID string, verified bool) error {
+	member, err := m.memberRepo.FindByMember(ctx, &domain.MemberQuery{MemberID: &memberID})
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] can't find!!!", memberID)
+		return errprocess.Set(errMsg)
+	}
+
+	member.AgeVerified = verified
+	if err := m.memberRepo.UpdateAgeProfile(ctx, member); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] can't UpdateAgeProfile error :%v !!!", memberID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
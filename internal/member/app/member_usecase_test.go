@@ -45,6 +45,10 @@ func (m *MockMemberRepo) FindByMember(ctx context.Context, memberQuery *domain.M
 	}
 	return nil, args.Error(1)
 }
+func (m *MockMemberRepo) UpdateAgeProfile(ctx context.Context, user *domain.Member) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
 
 // MockRedisRepo 針對 MemberSession 的 Mock
 type MockRedisRepo struct {
@@ -340,7 +344,7 @@ func TestMemberUseCase_Login(t *testing.T) {
 
 		// **2️.Mock `GenerateJWTFunc` 並補上 `issuer` 參數**
 		errMsg := fmt.Sprintf("email[%s] can't GenerateJWT!!!", email)
-		token.GenerateJWTFunc = func(existingUser, role, issuer string) (string, error) {
+		token.GenerateJWTFunc = func(existingUser, role, issuer string, age token.AgeClaims) (string, error) {
 			return "", errors.New(errMsg)
 		}
 
@@ -394,7 +398,7 @@ func TestMemberUseCase_Login(t *testing.T) {
 		defer func() { token.GenerateJWTFunc = originalGenerateJWT }() // **確保測試結束後恢復**
 
 		// **2️.Mock `GenerateJWTFunc` 並補上 `issuer` 參數**
-		token.GenerateJWTFunc = func(existingUser, role, issuer string) (string, error) {
+		token.GenerateJWTFunc = func(existingUser, role, issuer string, age token.AgeClaims) (string, error) {
 			return "token", nil
 		}
 
@@ -745,3 +749,87 @@ func TestMemberUseCase_ReconnectSession(t *testing.T) {
 		mockRedis.AssertExpectations(t)
 	})
 }
+
+func TestMemberUseCase_SetBirthdate(t *testing.T) {
+	ctx := context.Background()
+	memberID := "AAA"
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	logger.SetNewNop() // 停用 Logger 避免測試時輸出
+
+	// **1️.第一次填寫生日成功**
+	t.Run("填寫生日", func(t *testing.T) {
+		mockRepo := new(MockMemberRepo)
+		mockRedis := new(MockRedisRepo)
+		mockRepo.On("FindByMember", ctx, &domain.MemberQuery{MemberID: &memberID}).
+			Return(&domain.Member{MemberID: memberID}, nil).Once()
+		mockRepo.On("UpdateAgeProfile", ctx, mock.MatchedBy(func(member *domain.Member) bool {
+			return member.BirthdateString() == "2008-04-30" && !member.AgeVerified
+		})).Return(nil).Once()
+
+		uc := NewMemberUseCase(mockRepo, time.Hour, mockRedis, encrypt.HashPassword)
+		err := uc.SetBirthdate(ctx, memberID, "2008-04-30", now)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **2️.格式錯誤、未來的日期、已填寫過皆失敗**
+	t.Run("填寫失敗", func(t *testing.T) {
+		mockRepo := new(MockMemberRepo)
+		mockRedis := new(MockRedisRepo)
+		birthdate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("FindByMember", ctx, &domain.MemberQuery{MemberID: &memberID}).
+			Return(&domain.Member{MemberID: memberID, Birthdate: &birthdate}, nil).Once()
+
+		uc := NewMemberUseCase(mockRepo, time.Hour, mockRedis, encrypt.HashPassword)
+		assert.Error(t, uc.SetBirthdate(ctx, memberID, "2008/04/30", now))
+		assert.Error(t, uc.SetBirthdate(ctx, memberID, "2030-01-01", now))
+		assert.Error(t, uc.SetBirthdate(ctx, memberID, "2008-04-30", now))
+
+		mockRepo.AssertNotCalled(t, "UpdateAgeProfile", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMemberUseCase_SetAgeVerified(t *testing.T) {
+	ctx := context.Background()
+	memberID := "AAA"
+
+	logger.SetNewNop() // 停用 Logger 避免測試時輸出
+
+	// **1️.設定已驗證成年**
+	t.Run("驗證成年", func(t *testing.T) {
+		mockRepo := new(MockMemberRepo)
+		mockRedis := new(MockRedisRepo)
+		mockRepo.On("FindByMember", ctx, &domain.MemberQuery{MemberID: &memberID}).
+			Return(&domain.Member{MemberID: memberID}, nil).Once()
+		mockRepo.On("UpdateAgeProfile", ctx, &domain.Member{MemberID: memberID, AgeVerified: true}).
+			Return(nil).Once()
+
+		uc := NewMemberUseCase(mockRepo, time.Hour, mockRedis, encrypt.HashPassword)
+		assert.NoError(t, uc.SetAgeVerified(ctx, memberID, true))
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **2️.找不到會員**
+	t.Run("找不到會員", func(t *testing.T) {
+		mockRepo := new(MockMemberRepo)
+		mockRedis := new(MockRedisRepo)
+		mockRepo.On("FindByMember", ctx, &domain.MemberQuery{MemberID: &memberID}).
+			Return(nil, errors.New("no member found with given criteria")).Once()
+
+		uc := NewMemberUseCase(mockRepo, time.Hour, mockRedis, encrypt.HashPassword)
+		assert.Error(t, uc.SetAgeVerified(ctx, memberID, true))
+	})
+}
+
+func TestMember_TokenRole(t *testing.T) {
+	// **1️.依會員資料的角色簽發 token，未設定或不支援的角色為免費方案**
+	t.Run("會員角色", func(t *testing.T) {
+		assert.Equal(t, token.RolePremium, (&domain.Member{Role: "premium"}).TokenRole())
+		assert.Equal(t, token.RoleAdmin, (&domain.Member{Role: "admin"}).TokenRole())
+		assert.Equal(t, token.RoleMember, (&domain.Member{}).TokenRole())
+		assert.Equal(t, token.RoleMember, (&domain.Member{Role: "guest"}).TokenRole())
+	})
+}
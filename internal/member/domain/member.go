@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"fmt"
 	"streaming_video_service/pkg/encrypt"
+	"streaming_video_service/pkg/token"
 	"time"
 )
 
@@ -20,13 +22,22 @@ const (
 	MemberStatusDelete
 )
 
+// BirthdateLayout 生日的日期格式
+const BirthdateLayout = "2006-01-02"
+
+// maxMemberAge 生日合理範圍的上限（歲）
+const maxMemberAge = 130
+
 // Member 用來表示使用者
 type Member struct {
-	ID       int64
-	MemberID string
-	Email    string
-	Password string
-	Status   MemberStatus
+	ID          int64
+	MemberID    string
+	Email       string
+	Password    string
+	Status      MemberStatus
+	Birthdate   *time.Time // 會員自行填寫的生日，nil 表示未填寫
+	AgeVerified bool       // 已由管理員驗證成年
+	Role        string     // 登入時寫入 token 的角色：member（免費方案）、premium（付費方案）或 admin
 	// ... 其他欄位(如 Nickname, CreatedAt 等)
 }
 
@@ -40,12 +51,41 @@ type MemberSession struct {
 	// 其他屬性: 是否強制登出, 是否已斷線重連 等
 }
 
+// TokenRole 登入 token 的角色，未設定或不支援的角色視為免費方案的 member
+func (m *Member) TokenRole() token.RoleType {
+	switch role := token.RoleType(m.Role); role {
+	case token.RolePremium, token.RoleAdmin:
+		return role
+	}
+	return token.RoleMember
+}
+
 // IsPasswordMatch 密碼驗證 內還可以有一些方法
 func (m *Member) IsPasswordMatch(inputPwd string) error {
 	err := encrypt.CheckPassword(m.Password, inputPwd)
 	return err
 }
 
+// BirthdateString 生日的 YYYY-MM-DD 字串，未填寫為空值
+func (m *Member) BirthdateString() string {
+	if m.Birthdate == nil {
+		return ""
+	}
+	return m.Birthdate.Format(BirthdateLayout)
+}
+
+// ParseBirthdate 解析 YYYY-MM-DD 的生日，不可晚於 now 或早於合理範圍
+func ParseBirthdate(value string, now time.Time) (time.Time, error) {
+	birthdate, err := time.Parse(BirthdateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("生日格式需為 YYYY-MM-DD: %s", value)
+	}
+	if birthdate.After(now) || birthdate.Before(now.AddDate(-maxMemberAge, 0, 0)) {
+		return time.Time{}, fmt.Errorf("生日不在合理範圍: %s", value)
+	}
+	return birthdate, nil
+}
+
 // IsExpired 檢查 Session 是否已過期
 func (s *MemberSession) IsExpired() bool {
 	return time.Now().After(s.ExpiredAt)
@@ -16,6 +16,7 @@ type MemberRepository interface {
 	CreateUser(ctx context.Context, user *domain.Member) error
 	UpdateMemberStatus(ctx context.Context, user *domain.Member) error
 	FindByMember(ctx context.Context, memberQuery *domain.MemberQuery) (*domain.Member, error)
+	UpdateAgeProfile(ctx context.Context, member *domain.Member) error
 	// 其他 CRUD ...
 }
 
@@ -38,8 +39,14 @@ func (r *memberRepository) UpdateMemberStatus(ctx context.Context, member *domai
 	return err
 }
 
+// UpdateAgeProfile 更新會員的生日與年齡驗證狀態
+func (r *memberRepository) UpdateAgeProfile(ctx context.Context, member *domain.Member) error {
+	_, err := r.db.Exec(ctx, "UPDATE member SET birthdate = $1, age_verified = $2 WHERE member_id = $3", member.Birthdate, member.AgeVerified, member.MemberID)
+	return err
+}
+
 func (r *memberRepository) FindByMember(ctx context.Context, memberQuery *domain.MemberQuery) (*domain.Member, error) {
-	queryStr := "SELECT id, member_id, email, password FROM member WHERE 1=1"
+	queryStr := "SELECT id, member_id, email, password, birthdate, age_verified, role FROM member WHERE 1=1"
 	params := []interface{}{}
 	paramCount := 1
 
@@ -61,7 +68,7 @@ func (r *memberRepository) FindByMember(ctx context.Context, memberQuery *domain
 
 	row := r.db.QueryRow(ctx, queryStr, params...)
 	var member domain.Member
-	err := row.Scan(&member.ID, &member.MemberID, &member.Email, &member.Password)
+	err := row.Scan(&member.ID, &member.MemberID, &member.Email, &member.Password, &member.Birthdate, &member.AgeVerified, &member.Role)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, errors.New("no member found with given criteria")
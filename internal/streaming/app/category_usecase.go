@@ -0,0 +1,78 @@
+package app
+
+import (
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// ListCategories 分頁列出所有分類
+func (s *streamingUseCase) ListCategories(page domain.Pagination) ([]domain.Category, int64, error) {
+	page = page.Normalize()
+	categories, total, err := s.VideoRepo.ListCategories(page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("page[%d] 取得分類失敗 : %v", page.Page, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return categories, total, nil
+}
+
+// BrowseCategory 分頁列出分類下觀看者可觀看分級的 public 影片
+func (s *streamingUseCase) BrowseCategory(categoryID uint, maxRating domain.ContentRating, page domain.Pagination) ([]domain.Video, int64, error) {
+	if _, err := s.VideoRepo.GetCategory(categoryID); err != nil {
+		errMsg := fmt.Sprintf("categoryID[%d] 找不到分類 : %v", categoryID, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+
+	page = page.Normalize()
+	videos, total, err := s.VideoRepo.ListByCategory(categoryID, maxRating, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("categoryID[%d] page[%d] 取得分類影片失敗 : %v", categoryID, page.Page, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return videos, total, nil
+}
+
+// GetRelatedVideos 相關影片：優先取共用最多標籤的影片，不足 limit 時以同分類熱門影片補足，只列出觀看者可觀看的分級
+func (s *streamingUseCase) GetRelatedVideos(videoID, memberID string, maxRating domain.ContentRating, limit int) ([]domain.Video, error) {
+	video, err := s.getAccessibleVideo(videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 || limit > domain.MaxPageSize {
+		limit = domain.DefaultPageSize
+	}
+
+	related, err := s.VideoRepo.RelatedVideos(video.ID, maxRating, limit)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得相關影片失敗 : %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if len(related) >= limit || video.CategoryID == nil {
+		return related, nil
+	}
+
+	// 以同分類影片補足，排除自己與已列出的影片
+	sameCategory, _, err := s.VideoRepo.ListByCategory(*video.CategoryID, maxRating, 0, limit+len(related)+1)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得同分類影片失敗 : %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	seen := make(map[uint]struct{}, len(related)+1)
+	seen[video.ID] = struct{}{}
+	for _, v := range related {
+		seen[v.ID] = struct{}{}
+	}
+	for _, v := range sameCategory {
+		if len(related) >= limit {
+			break
+		}
+		if _, ok := seen[v.ID]; ok {
+			continue
+		}
+		seen[v.ID] = struct{}{}
+		related = append(related, v)
+	}
+	return related, nil
+}
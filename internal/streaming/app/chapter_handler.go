@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// SetChapters 實作 設定影片章節
+func (s *StreamingGRPCServer) SetChapters(ctx context.Context, req *streaming_pb.SetChaptersReq) (*streaming_pb.SetChaptersRes, error) {
+	chapters := make([]domain.Chapter, len(req.Chapters))
+	for index, chapter := range req.Chapters {
+		chapters[index] = domain.Chapter{
+			Start: time.Duration(chapter.StartMs) * time.Millisecond,
+			Title: chapter.Title,
+		}
+	}
+	chapters, err := s.Usecase.SetChapters(ctx, req.VideoId, req.MemberId, chapters)
+	if err != nil {
+		return &streaming_pb.SetChaptersRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.SetChaptersRes{
+		Success:  true,
+		Chapters: toChapterPb(chapters),
+	}, nil
+}
+
+// GetChaptersVTT 實作 取得 WebVTT chapters track
+func (s *StreamingGRPCServer) GetChaptersVTT(ctx context.Context, req *streaming_pb.GetChaptersVTTReq) (*streaming_pb.GetChaptersVTTRes, error) {
+	vtt, err := s.Usecase.GetChaptersVTT(ctx, req.VideoId, req.MemberId)
+	if err != nil {
+		return &streaming_pb.GetChaptersVTTRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.GetChaptersVTTRes{
+		Success: true,
+		Content: vtt,
+	}, nil
+}
+
+// toChapterPb 將章節轉為 proto 回應格式
+func toChapterPb(chapters []domain.Chapter) []*streaming_pb.Chapter {
+	chapterRes := make([]*streaming_pb.Chapter, len(chapters))
+	for index, chapter := range chapters {
+		chapterRes[index] = &streaming_pb.Chapter{
+			StartMs: chapter.Start.Milliseconds(),
+			Title:   chapter.Title,
+		}
+	}
+	return chapterRes
+}
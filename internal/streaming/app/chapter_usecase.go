@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"streaming_video_service/internal/streaming/domain"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// SetChapters 由上傳者設定章節並回傳目前生效的章節
+// 傳入空值代表清除自訂章節，改回由說明欄解析
+func (s *streamingUseCase) SetChapters(ctx context.Context, videoID, memberID string, chapters []domain.Chapter) ([]domain.Chapter, error) {
+	video, err := s.getOwnedVideo(videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range chapters {
+		chapters[index].Title = strings.TrimSpace(chapters[index].Title)
+	}
+	if err := domain.ValidateChapters(chapters); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 章節格式錯誤: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := s.VideoRepo.SetChapters(video.ID, chapters); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 設定章節失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if len(chapters) == 0 {
+		return domain.ParseChapters(video.Description), nil
+	}
+	return chapters, nil
+}
+
+// GetChaptersVTT 產生影片的 WebVTT chapters track，最後一個章節以播放清單的總長度結束
+func (s *streamingUseCase) GetChaptersVTT(ctx context.Context, videoID, memberID string) ([]byte, error) {
+	video, err := s.getAccessibleVideo(videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Status != string(domain.VideoReady) {
+		errMsg := fmt.Sprintf("videoID[%s] 影片尚未處理完成", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	chapters, err := s.videoChapters(video)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得章節失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	playlist, err := s.readPlaylist(ctx, video)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ChaptersVTT(chapters, domain.PlaylistDuration(playlist)), nil
+}
+
+// videoChapters 優先使用上傳者設定的章節，未設定時由說明欄解析
+func (s *streamingUseCase) videoChapters(video *domain.Video) ([]domain.Chapter, error) {
+	chapters, err := s.VideoRepo.GetChapters(video.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(chapters) > 0 {
+		return chapters, nil
+	}
+	return domain.ParseChapters(video.Description), nil
+}
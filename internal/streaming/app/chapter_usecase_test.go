@@ -0,0 +1,196 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const chapterPlaylist = "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXTINF:4.000000,\nindex0.ts\n#EXTINF:4.000000,\nindex1.ts\n#EXTINF:2.500000,\nindex2.ts\n#EXT-X-ENDLIST\n"
+
+func TestParseChapters(t *testing.T) {
+	// **情境 1: 解析說明欄中的時間標記**
+	t.Run("解析時間標記", func(t *testing.T) {
+		chapters := domain.ParseChapters("影片說明\n00:00 開場\n1:30 - 重點整理\n1:02:03 結尾\n謝謝收看")
+
+		assert.Equal(t, []domain.Chapter{
+			{Start: 0, Title: "開場"},
+			{Start: 90 * time.Second, Title: "重點整理"},
+			{Start: time.Hour + 2*time.Minute + 3*time.Second, Title: "結尾"},
+		}, chapters)
+	})
+
+	// **情境 2: 未從 00:00 開始不視為章節**
+	t.Run("未從 00:00 開始", func(t *testing.T) {
+		assert.Nil(t, domain.ParseChapters("00:10 開場\n01:00 結尾"))
+	})
+
+	// **情境 3: 只有一行時間標記不視為章節**
+	t.Run("只有一行時間標記", func(t *testing.T) {
+		assert.Nil(t, domain.ParseChapters("精彩片段從 00:00 開始\n00:00 開場"))
+	})
+
+	// **情境 4: CRLF 換行的說明欄，行尾的 \r 不算在標題內**
+	t.Run("CRLF 換行", func(t *testing.T) {
+		chapters := domain.ParseChapters("00:00 開場\r\n01:00 結尾\r\n")
+
+		assert.Equal(t, []domain.Chapter{{Start: 0, Title: "開場"}, {Start: time.Minute, Title: "結尾"}}, chapters)
+	})
+}
+
+func TestSetChapters(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	video := func() *domain.Video {
+		return &domain.Video{ID: 1, MemberID: "owner", Status: string(domain.VideoReady),
+			Description: "00:00 開場\n00:30 正片"}
+	}
+
+	// **情境 1: 上傳者設定章節**
+	t.Run("上傳者設定章節", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+		expected := []domain.Chapter{{Start: 0, Title: "Intro"}, {Start: time.Minute, Title: "Demo"}}
+
+		mockRepo.On("GetByID", uint(1)).Return(video(), nil).Once()
+		mockRepo.On("SetChapters", uint(1), expected).Return(nil).Once()
+
+		chapters, err := usecase.SetChapters(ctx, "1", "owner", []domain.Chapter{
+			{Start: 0, Title: " Intro "}, {Start: time.Minute, Title: "Demo"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, chapters)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 清除自訂章節後改由說明欄解析**
+	t.Run("清除自訂章節", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(video(), nil).Once()
+		mockRepo.On("SetChapters", uint(1), []domain.Chapter{}).Return(nil).Once()
+
+		chapters, err := usecase.SetChapters(ctx, "1", "owner", []domain.Chapter{})
+
+		assert.NoError(t, err)
+		assert.Len(t, chapters, 2)
+		assert.Equal(t, "正片", chapters[1].Title)
+	})
+
+	// **情境 3: 章節時間未遞增**
+	t.Run("章節時間未遞增", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(video(), nil).Once()
+
+		_, err := usecase.SetChapters(ctx, "1", "owner", []domain.Chapter{
+			{Start: 0, Title: "A"}, {Start: 0, Title: "B"},
+		})
+
+		assert.EqualError(t, err, "videoID[1] 章節格式錯誤: 第 2 個章節的開始時間需晚於前一個章節")
+		mockRepo.AssertNotCalled(t, "SetChapters", mock.Anything, mock.Anything)
+	})
+
+	// **情境 4: 標題包含換行或 --> 時拒絕，避免注入 m3u8 標籤或破壞 WebVTT cue**
+	t.Run("標題包含控制字元", func(t *testing.T) {
+		for _, title := range []string{"Intro\n#EXT-X-KEY:METHOD=NONE", "Intro\r\nOutro", "A\tB", "Intro --> 00:01:00.000"} {
+			mockRepo := new(MockVideoRepo)
+			usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+
+			mockRepo.On("GetByID", uint(1)).Return(video(), nil).Once()
+
+			_, err := usecase.SetChapters(ctx, "1", "owner", []domain.Chapter{
+				{Start: 0, Title: "Start"}, {Start: time.Minute, Title: title},
+			})
+
+			assert.EqualError(t, err, "videoID[1] 章節格式錯誤: 第 2 個章節標題不可包含控制字元或 -->", title)
+			mockRepo.AssertNotCalled(t, "SetChapters", mock.Anything, mock.Anything)
+		}
+	})
+
+	// **情境 5: 非上傳者無法設定**
+	t.Run("非上傳者無法設定", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(video(), nil).Once()
+
+		_, err := usecase.SetChapters(ctx, "1", "stranger", []domain.Chapter{{Title: "A"}})
+
+		assert.EqualError(t, err, "videoID[1] memberID[stranger] 非影片擁有者")
+	})
+}
+
+func TestChapterPlayback(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	video := &domain.Video{ID: 1, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+	chapters := []domain.Chapter{{Start: 0, Title: "Intro"}, {Start: 4 * time.Second, Title: "Main"}}
+	originalReadFile := readFile
+	defer func() { readFile = originalReadFile }()
+	readFile = io.ReadAll
+
+	// **情境 1: WebVTT 最後一個章節以影片長度結束**
+	t.Run("產生 WebVTT", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockRepo.On("GetChapters", uint(1)).Return(chapters, nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader([]byte(chapterPlaylist)), nil).Once()
+
+		vtt, err := usecase.GetChaptersVTT(ctx, "1", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "WEBVTT\n\n1\n00:00:00.000 --> 00:00:04.000\nIntro\n\n2\n00:00:04.000 --> 00:00:10.500\nMain\n", string(vtt))
+	})
+
+	// **情境 2: 播放清單在第一個分段前加入 EXT-X-DATERANGE**
+	t.Run("播放清單加入章節", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockRepo.On("GetChapters", uint(1)).Return(chapters, nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader([]byte(chapterPlaylist)), nil).Once()
+
+		playlist, err := usecase.GetIndexM3U8(ctx, "1", domain.Viewer{})
+
+		assert.NoError(t, err)
+		lines := strings.Split(string(playlist), "\n")
+		assert.Equal(t, "#EXT-X-TARGETDURATION:4", lines[2])
+		assert.Equal(t, "#EXT-X-PROGRAM-DATE-TIME:1970-01-01T00:00:00.000Z", lines[3])
+		assert.Equal(t, `#EXT-X-DATERANGE:ID="chapter-1",CLASS="chapter",START-DATE="1970-01-01T00:00:00.000Z",DURATION=4.000,X-TITLE="Intro"`, lines[4])
+		assert.Equal(t, `#EXT-X-DATERANGE:ID="chapter-2",CLASS="chapter",START-DATE="1970-01-01T00:00:04.000Z",DURATION=6.500,X-TITLE="Main"`, lines[5])
+		assert.Equal(t, "#EXTINF:4.000000,", lines[6])
+	})
+
+	// **情境 3: 檢查前存下的標題含換行或 --> 時，輸出仍維持一行一個標籤 / cue**
+	t.Run("既有標題含控制字元", func(t *testing.T) {
+		legacy := []domain.Chapter{{Start: 0, Title: "Intro\n#EXT-X-ENDLIST"}, {Start: 4 * time.Second, Title: "A --> B\n\nC"}}
+
+		vtt := domain.ChaptersVTT(legacy, 10500*time.Millisecond)
+		assert.Equal(t, "WEBVTT\n\n1\n00:00:00.000 --> 00:00:04.000\nIntro #EXT-X-ENDLIST\n\n2\n00:00:04.000 --> 00:00:10.500\nA -> B  C\n", string(vtt))
+
+		lines := strings.Split(string(domain.AddChapterDateRanges([]byte(chapterPlaylist), legacy)), "\n")
+		assert.Equal(t, `#EXT-X-DATERANGE:ID="chapter-1",CLASS="chapter",START-DATE="1970-01-01T00:00:00.000Z",DURATION=4.000,X-TITLE="Intro #EXT-X-ENDLIST"`, lines[4])
+		assert.Equal(t, `#EXT-X-DATERANGE:ID="chapter-2",CLASS="chapter",START-DATE="1970-01-01T00:00:04.000Z",DURATION=6.500,X-TITLE="A --> B  C"`, lines[5])
+		assert.Equal(t, "#EXTINF:4.000000,", lines[6])
+	})
+}
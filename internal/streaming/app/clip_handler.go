@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// CreateClip 實作 剪輯影片片段
+func (s *StreamingGRPCServer) CreateClip(ctx context.Context, req *streaming_pb.CreateClipReq) (*streaming_pb.CreateClipRes, error) {
+	res, err := s.Usecase.CreateClip(ctx, domain.CreateClipReq{
+		SourceVideoID: req.VideoId,
+		Viewer:        toViewer(req.MemberId, req.ViewerAge),
+		Title:         req.Title,
+		Description:   req.Description,
+		Start:         time.Duration(req.StartMs) * time.Millisecond,
+		End:           time.Duration(req.EndMs) * time.Millisecond,
+	})
+	if err != nil {
+		return &streaming_pb.CreateClipRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.CreateClipRes{
+		Success: true,
+		VideoId: int64(res.VideoID),
+		Message: res.Message,
+	}, nil
+}
+
+// ListUploads 實作 列出上傳的影片
+func (s *StreamingGRPCServer) ListUploads(ctx context.Context, req *streaming_pb.ListUploadsReq) (*streaming_pb.ListUploadsRes, error) {
+	videos, total, err := s.Usecase.ListUploads(ctx, req.MemberId, domain.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return &streaming_pb.ListUploadsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ListUploadsRes{
+		Success: true,
+		Video:   toSearchFeedBack(videos),
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+)
+
+// cutClip 由來源影片轉碼後的 HLS 剪出片段，輸出到 outputDir，回傳暫存的來源分段目錄供呼叫端清理；失敗時自行清除
+//  1. 下載來源 m3u8，只下載與片段重疊的分段
+//  2. 以這些分段組出本地 m3u8 作為 FFmpeg 輸入
+//  3. 剪輯點落在分段邊界（分段皆以關鍵影格開頭）時 stream copy，否則重新編碼
+func cutClip(ctx context.Context, job domain.TranscodingJob, mClient database.MinIOClientRepo, outputDir string) (_ string, err error) {
+	clip := job.Clip
+	sourceDir := fmt.Sprintf("./tmp/%d_clip_source", job.VideoID)
+	defer func() {
+		if err != nil {
+			os.RemoveAll(sourceDir)
+		}
+	}()
+	if err := os.MkdirAll(sourceDir, 0755); err != nil {
+		return sourceDir, fmt.Errorf("建立剪輯暫存目錄失敗: %w", err)
+	}
+
+	log.Printf("下載來源播放清單，VideoID: %d, ObjectKey: %s", job.VideoID, job.FileName)
+	sourcePlaylist := filepath.Join(sourceDir, "source.m3u8")
+	if err := mClient.DownloadFile(ctx, job.FileName, sourcePlaylist); err != nil {
+		return sourceDir, fmt.Errorf("下載來源播放清單失敗: %w", err)
+	}
+	content, err := os.ReadFile(sourcePlaylist)
+	if err != nil {
+		return sourceDir, fmt.Errorf("讀取來源播放清單失敗: %w", err)
+	}
+
+	start := time.Duration(clip.StartMs) * time.Millisecond
+	end := time.Duration(clip.EndMs) * time.Millisecond
+	plan := domain.PlanClip(domain.ParsePlaylistSegments(content), start, end)
+	if len(plan.Segments) == 0 {
+		return sourceDir, fmt.Errorf("來源影片 %d 沒有 %s - %s 的分段", clip.SourceVideoID, start, end)
+	}
+
+	// 分段與 m3u8 放在同一個 object 目錄下，URI 為相對路徑
+	prefix := path.Dir(job.FileName)
+	for _, segment := range plan.Segments {
+		objectName := path.Join(prefix, segment.URI)
+		if err := mClient.DownloadFile(ctx, objectName, filepath.Join(sourceDir, path.Base(segment.URI))); err != nil {
+			return sourceDir, fmt.Errorf("下載來源分段 %s 失敗: %w", objectName, err)
+		}
+	}
+	local := make([]domain.PlaylistSegment, len(plan.Segments))
+	for index, segment := range plan.Segments {
+		local[index] = segment
+		local[index].URI = path.Base(segment.URI)
+	}
+	inputPath := filepath.Join(sourceDir, "index.m3u8")
+	if err := os.WriteFile(inputPath, domain.BuildPlaylist(local), 0644); err != nil {
+		return sourceDir, fmt.Errorf("寫入剪輯播放清單失敗: %w", err)
+	}
+
+	log.Printf("開始剪輯影片 VideoID: %d（來源 %d, %s - %s, stream copy: %t）",
+		job.VideoID, clip.SourceVideoID, start, end, plan.Aligned)
+	if err := CutHLS(inputPath, outputDir, plan.Offset, plan.Duration, plan.Aligned); err != nil {
+		return sourceDir, fmt.Errorf("FFmpeg 剪輯失敗: %w", err)
+	}
+	return sourceDir, nil
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"streaming_video_service/internal/streaming/domain"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// CreateClip 由已轉碼完成的影片剪出片段，建立一部連結到來源影片的新短影音
+// 片段與一般上傳相同，由轉碼工作處理完成後才會變成 ready，並列在剪輯者的上傳影片中
+func (s *streamingUseCase) CreateClip(ctx context.Context, req domain.CreateClipReq) (*domain.UploadVideoRes, error) {
+	if req.Viewer.MemberID == "" {
+		return nil, errprocess.Set("需登入才能剪輯影片")
+	}
+	// 剪輯者需可播放來源影片（含分級的年齡限制），不能藉由剪輯取得無法觀看的內容
+	source, err := s.getPlayableVideo(req.SourceVideoID, req.Viewer)
+	if err != nil {
+		return nil, err
+	}
+	if source.Status != string(domain.VideoReady) {
+		errMsg := fmt.Sprintf("videoID[%s] 影片尚未處理完成", req.SourceVideoID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	playlist, err := s.readPlaylist(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateClipRange(req.Start, req.End, domain.PlaylistDuration(playlist)); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 片段範圍錯誤: %v", req.SourceVideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = source.Title
+	}
+	// 片段沿用來源影片的可見度與分級，避免私人、不公開或限制級的影片被剪輯後公開列出
+	sourceID := source.ID
+	clip := domain.Video{
+		MemberID:      req.Viewer.MemberID,
+		Title:         title,
+		Description:   req.Description,
+		FileName:      source.ProcessedKey(domain.PlaylistFileName),
+		Type:          domain.VideoTypeShort,
+		Status:        string(domain.VideoUpload),
+		Visibility:    source.Visibility,
+		CategoryID:    source.CategoryID,
+		ContentRating: source.ContentRating,
+		RatingLocked:  source.RatingLocked,
+		SourceVideoID: &sourceID,
+		ClipStartMs:   req.Start.Milliseconds(),
+		ClipEndMs:     req.End.Milliseconds(),
+	}
+	if err := s.VideoRepo.SaveWithEvents(&clip, domain.TranscodingJobEvents); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 資料庫建立片段失敗: %v", req.SourceVideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	return &domain.UploadVideoRes{
+		Message: "剪輯成功，等待轉碼",
+		VideoID: int(clip.ID),
+	}, nil
+}
+
+// ListUploads 列出會員上傳（含剪輯）的影片，包含尚未轉碼完成的影片，最新的排在最前面
+func (s *streamingUseCase) ListUploads(ctx context.Context, memberID string, page domain.Pagination) ([]domain.Video, int64, error) {
+	if memberID == "" {
+		return nil, 0, errprocess.Set("需登入才能查看上傳的影片")
+	}
+	page = page.Normalize()
+	videos, total, err := s.VideoRepo.ListByMember(memberID, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得上傳影片失敗: %v", memberID, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return videos, total, nil
+}
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// clipPlaylist 共 60 秒，每個分段 4 秒
+func clipPlaylist() []byte {
+	segments := make([]domain.PlaylistSegment, 15)
+	for index := range segments {
+		segments[index] = domain.PlaylistSegment{URI: fmt.Sprintf("index%d.ts", index), Duration: 4 * time.Second}
+	}
+	return domain.BuildPlaylist(segments)
+}
+
+func TestPlanClip(t *testing.T) {
+	segments := domain.ParsePlaylistSegments(clipPlaylist())
+
+	// **情境 1: 剪輯點落在分段邊界，可直接 stream copy**
+	t.Run("對齊分段邊界", func(t *testing.T) {
+		plan := domain.PlanClip(segments, 8*time.Second, 24*time.Second)
+
+		assert.True(t, plan.Aligned)
+		assert.Len(t, plan.Segments, 4)
+		assert.Equal(t, 8*time.Second, plan.Segments[0].Start)
+		assert.Equal(t, time.Duration(0), plan.Offset)
+		assert.Equal(t, 16*time.Second, plan.Duration)
+	})
+
+	// **情境 2: 剪輯點在分段中間，需重新編碼**
+	t.Run("未對齊分段邊界", func(t *testing.T) {
+		plan := domain.PlanClip(segments, 9*time.Second, 25*time.Second)
+
+		assert.False(t, plan.Aligned)
+		assert.Len(t, plan.Segments, 5)
+		assert.Equal(t, time.Second, plan.Offset)
+		assert.Equal(t, 16*time.Second, plan.Duration)
+	})
+}
+
+func TestCreateClip(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	categoryID := uint(3)
+	source := func() *domain.Video {
+		return &domain.Video{ID: 1, MemberID: "owner", Title: "原始影片", Status: string(domain.VideoReady),
+			Visibility: string(domain.VisibilityPublic), CategoryID: &categoryID}
+	}
+	originalReadFile := readFile
+	defer func() { readFile = originalReadFile }()
+	readFile = io.ReadAll
+
+	// **情境 1: 建立片段並發布剪輯工作**
+	t.Run("建立片段", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(source(), nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader(clipPlaylist()), nil).Once()
+		var events []domain.OutboxEvent
+		mockRepo.On("SaveWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.MemberID == "clipper" && v.Title == "原始影片" && v.Type == domain.VideoTypeShort &&
+				v.Status == string(domain.VideoUpload) && v.Visibility == string(domain.VisibilityPublic) && v.ClipSource() == 1 &&
+				v.ClipStartMs == 10000 && v.ClipEndMs == 40000 && *v.CategoryID == categoryID
+		})).Run(func(args mock.Arguments) {
+			video := args.Get(0).(*domain.Video)
+			video.ID = 9
+			events, _ = domain.TranscodingJobEvents(video)
+		}).Return(nil).Once()
+
+		res, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "clipper"}, Start: 10 * time.Second, End: 40 * time.Second,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 9, res.VideoID)
+		mockRepo.AssertExpectations(t)
+		// 剪輯工作事件與片段在同一個交易內寫入 outbox
+		assert.Len(t, events, 1)
+		assert.Equal(t, domain.QueueName, events[0].Topic)
+		var job domain.TranscodingJob
+		assert.NoError(t, json.Unmarshal([]byte(events[0].Payload), &job))
+		assert.Equal(t, domain.TranscodingJob{VideoID: 9, FileName: "processed/1/index.m3u8", Type: domain.VideoTypeShort,
+			Clip: &domain.ClipJob{SourceVideoID: 1, StartMs: 10000, EndMs: 40000}}, job)
+	})
+
+	// **情境 2: 片段沿用來源影片的可見度**
+	t.Run("沿用來源可見度", func(t *testing.T) {
+		for _, visibility := range []domain.VideoVisibility{domain.VisibilityUnlisted, domain.VisibilityPrivate} {
+			mockRepo := new(MockVideoRepo)
+			mockMinIO := new(MockMinIOClient)
+			usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+			video := source()
+			video.Visibility = string(visibility)
+
+			mockRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+			mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+				Return(bytes.NewReader(clipPlaylist()), nil).Once()
+			mockRepo.On("SaveWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+				return v.Visibility == string(visibility)
+			})).Return(nil).Once()
+
+			_, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+				SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "owner"}, Start: 10 * time.Second, End: 40 * time.Second,
+			})
+
+			assert.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		}
+	})
+
+	// **情境 3: 片段超過影片長度**
+	t.Run("超過影片長度", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(source(), nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader(clipPlaylist()), nil).Once()
+
+		_, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "clipper"}, Start: 50 * time.Second, End: 70 * time.Second,
+		})
+
+		assert.EqualError(t, err, "videoID[1] 片段範圍錯誤: 片段結束時間超過影片長度 1m0s")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	// **情境 4: 片段長度不足**
+	t.Run("片段太短", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(source(), nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader(clipPlaylist()), nil).Once()
+
+		_, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "clipper"}, Start: 0, End: 5 * time.Second,
+		})
+
+		assert.EqualError(t, err, "videoID[1] 片段範圍錯誤: 片段長度需介於 15s 到 1m0s")
+	})
+
+	// **情境 5: 來源影片尚未轉碼完成**
+	t.Run("來源影片未完成", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+		video := source()
+		video.Status = string(domain.VideoUpload)
+
+		mockRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+
+		_, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "clipper"}, Start: 0, End: 20 * time.Second,
+		})
+
+		assert.EqualError(t, err, "videoID[1] 影片尚未處理完成")
+	})
+
+	// **情境 6: 限制級影片只有達觀看年齡的會員可剪輯，片段沿用來源分級**
+	t.Run("沿用來源分級", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+		adult := func() *domain.Video {
+			video := source()
+			video.ContentRating = string(domain.RatingAdult)
+			video.RatingLocked = true
+			return video
+		}
+		minorBirthdate := time.Now().AddDate(-15, 0, 0).Format(domain.BirthdateLayout)
+
+		mockRepo.On("GetByID", uint(1)).Return(adult(), nil).Once()
+		_, err := usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "minor", Birthdate: minorBirthdate},
+			Start: 10 * time.Second, End: 40 * time.Second,
+		})
+		assert.EqualError(t, err, "videoID[1] memberID[minor] 未達 18+ 分級的觀看年齡")
+		mockRepo.AssertNotCalled(t, "SaveWithEvents", mock.Anything, mock.Anything)
+
+		mockRepo.On("GetByID", uint(1)).Return(adult(), nil).Once()
+		mockMinIO.On("GetObject", ctx, "processed/1/index.m3u8", mock.Anything).
+			Return(bytes.NewReader(clipPlaylist()), nil).Once()
+		mockRepo.On("SaveWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Rating() == domain.RatingAdult && v.RatingLocked
+		})).Return(nil).Once()
+		_, err = usecase.CreateClip(ctx, domain.CreateClipReq{
+			SourceVideoID: "1", Viewer: domain.Viewer{MemberID: "adult", AgeVerified: true},
+			Start: 10 * time.Second, End: 40 * time.Second,
+		})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCutClipCleanup(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	defer os.RemoveAll("./tmp")
+
+	// **情境 1: 下載來源分段失敗時清除暫存的來源目錄**
+	t.Run("下載失敗", func(t *testing.T) {
+		mockMinIO := new(MockMinIOClient)
+		job := domain.TranscodingJob{VideoID: 9, FileName: "processed/1/index.m3u8",
+			Clip: &domain.ClipJob{SourceVideoID: 1, StartMs: 10000, EndMs: 40000}}
+
+		mockMinIO.On("DownloadFile", ctx, "processed/1/index.m3u8", mock.Anything).Return(errors.New("minio down")).Once()
+
+		_, err := cutClip(ctx, job, mockMinIO, "./tmp/9_processed")
+
+		assert.EqualError(t, err, "下載來源播放清單失敗: minio down")
+		assert.NoDirExists(t, "./tmp/9_clip_source")
+	})
+}
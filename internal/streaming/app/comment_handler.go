@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// PostComment 實作 留言 / 回覆
+func (s *StreamingGRPCServer) PostComment(ctx context.Context, req *streaming_pb.PostCommentReq) (*streaming_pb.PostCommentRes, error) {
+	comment, err := s.CommentUsecase.PostComment(ctx, uint(req.VideoId), req.MemberId, req.ParentId, req.Content)
+	if err != nil {
+		return &streaming_pb.PostCommentRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.PostCommentRes{
+		Success: true,
+		Comment: toCommentPb(comment),
+	}, nil
+}
+
+// EditComment 實作 修改留言
+func (s *StreamingGRPCServer) EditComment(ctx context.Context, req *streaming_pb.EditCommentReq) (*streaming_pb.EditCommentRes, error) {
+	comment, err := s.CommentUsecase.EditComment(ctx, req.CommentId, req.MemberId, req.Content)
+	if err != nil {
+		return &streaming_pb.EditCommentRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.EditCommentRes{
+		Success: true,
+		Comment: toCommentPb(comment),
+	}, nil
+}
+
+// DeleteComment 實作 刪除留言
+func (s *StreamingGRPCServer) DeleteComment(ctx context.Context, req *streaming_pb.DeleteCommentReq) (*streaming_pb.DeleteCommentRes, error) {
+	if err := s.CommentUsecase.DeleteComment(ctx, req.CommentId, req.MemberId); err != nil {
+		return &streaming_pb.DeleteCommentRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.DeleteCommentRes{Success: true}, nil
+}
+
+// PinComment 實作 置頂 / 取消置頂留言
+func (s *StreamingGRPCServer) PinComment(ctx context.Context, req *streaming_pb.PinCommentReq) (*streaming_pb.PinCommentRes, error) {
+	if err := s.CommentUsecase.PinComment(ctx, req.CommentId, req.MemberId, req.Pinned); err != nil {
+		return &streaming_pb.PinCommentRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.PinCommentRes{Success: true}, nil
+}
+
+// ListComments 實作 列出影片留言
+func (s *StreamingGRPCServer) ListComments(ctx context.Context, req *streaming_pb.ListCommentsReq) (*streaming_pb.ListCommentsRes, error) {
+	page, err := s.CommentUsecase.ListComments(ctx, uint(req.VideoId), req.MemberId, domain.CommentSort(req.Sort), req.Cursor, int(req.Limit))
+	if err != nil {
+		return &streaming_pb.ListCommentsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return toListCommentsRes(page), nil
+}
+
+// ListCommentReplies 實作 列出留言的回覆
+func (s *StreamingGRPCServer) ListCommentReplies(ctx context.Context, req *streaming_pb.ListCommentRepliesReq) (*streaming_pb.ListCommentsRes, error) {
+	page, err := s.CommentUsecase.ListReplies(ctx, req.CommentId, req.MemberId, req.Cursor, int(req.Limit))
+	if err != nil {
+		return &streaming_pb.ListCommentsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return toListCommentsRes(page), nil
+}
+
+// toListCommentsRes 將一頁留言轉為 proto 格式
+func toListCommentsRes(page *domain.CommentPage) *streaming_pb.ListCommentsRes {
+	comments := make([]*streaming_pb.Comment, len(page.Comments))
+	for index := range page.Comments {
+		comments[index] = toCommentPb(&page.Comments[index])
+	}
+	res := &streaming_pb.ListCommentsRes{
+		Success:    true,
+		Comments:   comments,
+		NextCursor: page.NextCursor,
+	}
+	if page.Pinned != nil {
+		res.Pinned = toCommentPb(page.Pinned)
+	}
+	return res
+}
+
+// toCommentPb 將留言轉為 proto 格式
+func toCommentPb(comment *domain.Comment) *streaming_pb.Comment {
+	var editedAt int64
+	if comment.EditedAt != nil {
+		editedAt = comment.EditedAt.Unix()
+	}
+	return &streaming_pb.Comment{
+		CommentId:  comment.ID,
+		VideoId:    int64(comment.VideoID),
+		MemberId:   comment.MemberID,
+		ParentId:   comment.ParentID,
+		Content:    comment.Content,
+		ReplyCount: comment.ReplyCount,
+		Pinned:     comment.Pinned,
+		Deleted:    comment.Deleted,
+		CreatedAt:  comment.CreatedAt.Unix(),
+		EditedAt:   editedAt,
+	}
+}
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+)
+
+// CommentUseCase 影片留言與回覆
+type CommentUseCase interface {
+	PostComment(ctx context.Context, videoID uint, memberID, parentID, content string) (*domain.Comment, error)
+	EditComment(ctx context.Context, commentID, memberID, content string) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, commentID, memberID string) error
+	PinComment(ctx context.Context, commentID, memberID string, pinned bool) error
+	ListComments(ctx context.Context, videoID uint, memberID string, sort domain.CommentSort, cursor string, limit int) (*domain.CommentPage, error)
+	ListReplies(ctx context.Context, commentID, memberID, cursor string, limit int) (*domain.CommentPage, error)
+}
+
+type commentUseCase struct {
+	CommentRepo repository.CommentRepo
+	VideoRepo   repository.VideoRepo
+	OutboxRepo  repository.OutboxRepo
+}
+
+// NewCommentUseCase 建立 CommentUseCase
+func NewCommentUseCase(commentRepo repository.CommentRepo, videoRepo repository.VideoRepo, outboxRepo repository.OutboxRepo) CommentUseCase {
+	return &commentUseCase{
+		CommentRepo: commentRepo,
+		VideoRepo:   videoRepo,
+		OutboxRepo:  outboxRepo,
+	}
+}
+
+// PostComment 在可觀看的 ready 影片留言，帶入 parentID 時為回覆
+// 回覆只有一層，回覆別人的回覆時會掛在同一則頂層留言下；留言成功後通知影片上傳者
+func (c *commentUseCase) PostComment(ctx context.Context, videoID uint, memberID, parentID, content string) (*domain.Comment, error) {
+	if memberID == "" {
+		errMsg := fmt.Sprintf("videoID[%d] 需登入才能留言", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+	content, err := commentContent(content)
+	if err != nil {
+		return nil, err
+	}
+	video, err := c.getAccessibleVideo(videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Status != string(domain.VideoReady) {
+		errMsg := fmt.Sprintf("videoID[%d] 影片尚未就緒，無法留言", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	rootID := ""
+	if parentID != "" {
+		parent, err := c.CommentRepo.GetByID(ctx, parentID)
+		if err != nil || parent.VideoID != videoID {
+			errMsg := fmt.Sprintf("videoID[%d] commentID[%s] 找不到要回覆的留言", videoID, parentID)
+			return nil, errprocess.Set(errMsg)
+		}
+		if parent.Deleted {
+			errMsg := fmt.Sprintf("commentID[%s] 留言已刪除，無法回覆", parentID)
+			return nil, errprocess.Set(errMsg)
+		}
+		rootID = parent.ID
+		if parent.ParentID != "" {
+			rootID = parent.ParentID
+		}
+	}
+
+	comment := &domain.Comment{
+		VideoID:   videoID,
+		MemberID:  memberID,
+		ParentID:  rootID,
+		Content:   content,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond), // MongoDB 時間精度為毫秒，與游標一致
+	}
+	if err := c.CommentRepo.Create(ctx, comment); err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 留言失敗: %v", videoID, memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if rootID != "" {
+		if err := c.CommentRepo.IncrReplyCount(ctx, rootID, 1); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("commentID[%s] 更新回覆數失敗:", rootID), err)
+		}
+	}
+
+	if !video.IsOwner(memberID) {
+		c.notifyOwner(video, comment)
+	}
+	return comment, nil
+}
+
+// notifyOwner 寫入新留言通知事件，由 OutboxRelay 發布、chat_service 推播給上傳者
+// 留言存在 MongoDB，無法與 outbox 共用交易；寫入失敗只記錄，不影響已成立的留言
+func (c *commentUseCase) notifyOwner(video *domain.Video, comment *domain.Comment) {
+	event, err := domain.NewNotificationEvent(video.ID, domain.EventCommentCreated, video.MemberID, domain.NotifyComment, map[string]interface{}{
+		"video_id":    video.ID,
+		"video_title": video.Title,
+		"comment_id":  comment.ID,
+		"parent_id":   comment.ParentID,
+		"member_id":   comment.MemberID,
+		"content":     comment.Content,
+	})
+	if err == nil {
+		err = c.OutboxRepo.Save([]domain.OutboxEvent{event})
+	}
+	if err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 通知上傳者新留言失敗:", video.ID), err)
+	}
+}
+
+// EditComment 修改自己的留言
+func (c *commentUseCase) EditComment(ctx context.Context, commentID, memberID, content string) (*domain.Comment, error) {
+	comment, err := c.getOwnedComment(ctx, commentID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Deleted {
+		errMsg := fmt.Sprintf("commentID[%s] 留言已刪除，無法修改", commentID)
+		return nil, errprocess.Set(errMsg)
+	}
+	content, err = commentContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt := time.Now().UTC().Truncate(time.Millisecond)
+	if err := c.CommentRepo.UpdateContent(ctx, commentID, content, editedAt); err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 修改留言失敗: %v", commentID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	comment.Content = content
+	comment.EditedAt = &editedAt
+	return comment, nil
+}
+
+// DeleteComment 刪除自己的留言；頂層留言還有回覆時只清除內容，保留討論串
+func (c *commentUseCase) DeleteComment(ctx context.Context, commentID, memberID string) error {
+	comment, err := c.getOwnedComment(ctx, commentID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if comment.ParentID == "" && comment.ReplyCount > 0 {
+		err = c.CommentRepo.SoftDelete(ctx, commentID)
+	} else {
+		err = c.CommentRepo.Delete(ctx, commentID)
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 刪除留言失敗: %v", commentID, err)
+		return errprocess.Set(errMsg)
+	}
+
+	if comment.ParentID != "" {
+		if err := c.CommentRepo.IncrReplyCount(ctx, comment.ParentID, -1); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("commentID[%s] 更新回覆數失敗:", comment.ParentID), err)
+		}
+	}
+	return nil
+}
+
+// PinComment 影片上傳者置頂或取消置頂頂層留言，每部影片只會有一則置頂留言
+func (c *commentUseCase) PinComment(ctx context.Context, commentID, memberID string, pinned bool) error {
+	comment, err := c.CommentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 找不到留言: %v", commentID, err)
+		return errprocess.Set(errMsg)
+	}
+	video, err := c.VideoRepo.GetByID(comment.VideoID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 找不到影片: %v", comment.VideoID, err)
+		return errprocess.Set(errMsg)
+	}
+	if !video.IsOwner(memberID) {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 非影片擁有者，無法置頂留言", video.ID, memberID)
+		return errprocess.Set(errMsg)
+	}
+	if pinned && (comment.ParentID != "" || comment.Deleted) {
+		errMsg := fmt.Sprintf("commentID[%s] 只能置頂未刪除的頂層留言", commentID)
+		return errprocess.Set(errMsg)
+	}
+
+	if err := c.CommentRepo.SetPinned(ctx, comment.VideoID, commentID, pinned); err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 置頂留言失敗: %v", commentID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// ListComments 以游標分頁列出影片的頂層留言，第一頁另外帶回置頂留言
+func (c *commentUseCase) ListComments(ctx context.Context, videoID uint, memberID string, sort domain.CommentSort, cursor string, limit int) (*domain.CommentPage, error) {
+	if sort == "" {
+		sort = domain.CommentSortTop
+	}
+	if !sort.IsValid() {
+		errMsg := fmt.Sprintf("videoID[%d] 不支援的留言排序: %s", videoID, sort)
+		return nil, errprocess.Set(errMsg)
+	}
+	if _, err := c.getAccessibleVideo(videoID, memberID); err != nil {
+		return nil, err
+	}
+
+	page, err := c.listPage(ctx, domain.CommentQuery{VideoID: videoID, Sort: sort}, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	if cursor == "" {
+		if page.Pinned, err = c.CommentRepo.GetPinned(ctx, videoID); err != nil {
+			errMsg := fmt.Sprintf("videoID[%d] 取得置頂留言失敗: %v", videoID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+	}
+	return page, nil
+}
+
+// ListReplies 以游標分頁列出頂層留言的回覆，依時間先後排序
+func (c *commentUseCase) ListReplies(ctx context.Context, commentID, memberID, cursor string, limit int) (*domain.CommentPage, error) {
+	parent, err := c.CommentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 找不到留言: %v", commentID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if parent.ParentID != "" {
+		errMsg := fmt.Sprintf("commentID[%s] 不是頂層留言", commentID)
+		return nil, errprocess.Set(errMsg)
+	}
+	if _, err := c.getAccessibleVideo(parent.VideoID, memberID); err != nil {
+		return nil, err
+	}
+	return c.listPage(ctx, domain.CommentQuery{VideoID: parent.VideoID, ParentID: parent.ID}, cursor, limit)
+}
+
+// listPage 多取一筆判斷是否有下一頁
+func (c *commentUseCase) listPage(ctx context.Context, query domain.CommentQuery, cursor string, limit int) (*domain.CommentPage, error) {
+	var err error
+	if query.Cursor, err = domain.DecodeCommentCursor(cursor); err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 游標格式錯誤: %v", query.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	limit = domain.Pagination{PageSize: limit}.Normalize().PageSize
+	query.Limit = limit + 1
+
+	comments, err := c.CommentRepo.List(ctx, query)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 取得留言失敗: %v", query.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	page := &domain.CommentPage{Comments: comments}
+	if len(comments) > limit {
+		page.Comments = comments[:limit]
+		page.NextCursor = domain.CursorOf(comments[limit-1]).Encode()
+	}
+	return page, nil
+}
+
+// getOwnedComment 取得留言並確認 memberID 為留言者
+func (c *commentUseCase) getOwnedComment(ctx context.Context, commentID, memberID string) (*domain.Comment, error) {
+	comment, err := c.CommentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		errMsg := fmt.Sprintf("commentID[%s] 找不到留言: %v", commentID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !comment.IsOwner(memberID) {
+		errMsg := fmt.Sprintf("commentID[%s] memberID[%s] 非留言者", commentID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return comment, nil
+}
+
+// getAccessibleVideo 取得影片並依可見度檢查 memberID 是否有觀看權限
+func (c *commentUseCase) getAccessibleVideo(videoID uint, memberID string) (*domain.Video, error) {
+	video, err := c.VideoRepo.GetByID(videoID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 找不到影片: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if ok, err := canWatch(c.VideoRepo, video, memberID); err != nil || !ok {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 無權限觀看此影片", videoID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+// commentContent 去除前後空白並檢查留言長度
+func commentContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", errprocess.Set("留言內容不可為空")
+	}
+	if utf8.RuneCountInString(content) > domain.MaxCommentLength {
+		errMsg := fmt.Sprintf("留言內容超過 %d 字", domain.MaxCommentLength)
+		return "", errprocess.Set(errMsg)
+	}
+	return content, nil
+}
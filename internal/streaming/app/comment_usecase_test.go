@@ -0,0 +1,320 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCommentRepo 留言儲存庫的 Mock
+type MockCommentRepo struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepo) EnsureIndexes(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) Create(ctx context.Context, comment *domain.Comment) error {
+	args := m.Called(ctx, comment)
+	comment.ID = "new"
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) UpdateContent(ctx context.Context, id, content string, editedAt time.Time) error {
+	args := m.Called(ctx, id, content, editedAt)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) SoftDelete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) IncrReplyCount(ctx context.Context, id string, delta int64) error {
+	args := m.Called(ctx, id, delta)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) SetPinned(ctx context.Context, videoID uint, id string, pinned bool) error {
+	args := m.Called(ctx, videoID, id, pinned)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepo) GetPinned(ctx context.Context, videoID uint) (*domain.Comment, error) {
+	args := m.Called(ctx, videoID)
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) List(ctx context.Context, query domain.CommentQuery) ([]domain.Comment, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) HourlyCounts(ctx context.Context, since time.Time) ([]domain.HourlyActivity, error) {
+	args := m.Called(ctx, since)
+	return args.Get(0).([]domain.HourlyActivity), args.Error(1)
+}
+
+func TestPostComment(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	readyVideo := &domain.Video{ID: 1, MemberID: "owner", Title: "Video", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+
+	// **情境 1: 頂層留言並通知上傳者**
+	t.Run("頂層留言並通知上傳者", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		mockOutbox := new(MockOutboxRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, mockOutbox)
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockComment.On("Create", ctx, mock.MatchedBy(func(c *domain.Comment) bool {
+			return c.VideoID == 1 && c.MemberID == "member" && c.ParentID == "" && c.Content == "Nice!"
+		})).Return(nil).Once()
+		mockOutbox.On("Save", mock.MatchedBy(func(events []domain.OutboxEvent) bool {
+			var notification domain.NotificationEvent
+			return len(events) == 1 && events[0].Topic == domain.NotificationTopic && events[0].EventType == domain.EventCommentCreated &&
+				json.Unmarshal([]byte(events[0].Payload), &notification) == nil &&
+				notification.MemberID == "owner" && notification.Action == domain.NotifyComment &&
+				notification.Payload["comment_id"] == "new" && notification.Payload["member_id"] == "member" && notification.Payload["content"] == "Nice!"
+		})).Return(nil).Once()
+
+		comment, err := usecase.PostComment(ctx, 1, "member", "", "  Nice! ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new", comment.ID)
+		mockComment.AssertExpectations(t)
+		mockOutbox.AssertExpectations(t)
+	})
+
+	// **情境 2: 回覆別人的回覆時掛在頂層留言下，上傳者自己留言不通知**
+	t.Run("回覆掛在頂層留言下", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		mockOutbox := new(MockOutboxRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, mockOutbox)
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockComment.On("GetByID", ctx, "reply").Return(&domain.Comment{ID: "reply", VideoID: 1, ParentID: "root"}, nil).Once()
+		mockComment.On("Create", ctx, mock.MatchedBy(func(c *domain.Comment) bool {
+			return c.ParentID == "root"
+		})).Return(nil).Once()
+		mockComment.On("IncrReplyCount", ctx, "root", int64(1)).Return(nil).Once()
+
+		comment, err := usecase.PostComment(ctx, 1, "owner", "reply", "thanks")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "root", comment.ParentID)
+		mockComment.AssertExpectations(t)
+		mockOutbox.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	// **情境 3: 回覆其他影片的留言**
+	t.Run("回覆其他影片的留言", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockComment.On("GetByID", ctx, "other").Return(&domain.Comment{ID: "other", VideoID: 2}, nil).Once()
+
+		comment, err := usecase.PostComment(ctx, 1, "member", "other", "hi")
+
+		assert.Error(t, err)
+		assert.Nil(t, comment)
+		assert.Equal(t, "videoID[1] commentID[other] 找不到要回覆的留言", err.Error())
+	})
+
+	// **情境 4: 留言內容不可為空**
+	t.Run("留言內容不可為空", func(t *testing.T) {
+		usecase := NewCommentUseCase(new(MockCommentRepo), new(MockVideoRepo), new(MockOutboxRepo))
+
+		comment, err := usecase.PostComment(ctx, 1, "member", "", "   ")
+
+		assert.Error(t, err)
+		assert.Nil(t, comment)
+		assert.Equal(t, "留言內容不可為空", err.Error())
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 有回覆的頂層留言只清除內容**
+	t.Run("有回覆的頂層留言只清除內容", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		usecase := NewCommentUseCase(mockComment, new(MockVideoRepo), new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "root").Return(&domain.Comment{ID: "root", MemberID: "member", ReplyCount: 2}, nil).Once()
+		mockComment.On("SoftDelete", ctx, "root").Return(nil).Once()
+
+		err := usecase.DeleteComment(ctx, "root", "member")
+
+		assert.NoError(t, err)
+		mockComment.AssertExpectations(t)
+		mockComment.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	// **情境 2: 刪除回覆並扣除頂層留言的回覆數**
+	t.Run("刪除回覆", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		usecase := NewCommentUseCase(mockComment, new(MockVideoRepo), new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "reply").Return(&domain.Comment{ID: "reply", MemberID: "member", ParentID: "root"}, nil).Once()
+		mockComment.On("Delete", ctx, "reply").Return(nil).Once()
+		mockComment.On("IncrReplyCount", ctx, "root", int64(-1)).Return(nil).Once()
+
+		err := usecase.DeleteComment(ctx, "reply", "member")
+
+		assert.NoError(t, err)
+		mockComment.AssertExpectations(t)
+	})
+
+	// **情境 3: 不可刪除別人的留言**
+	t.Run("不可刪除別人的留言", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		usecase := NewCommentUseCase(mockComment, new(MockVideoRepo), new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "root").Return(&domain.Comment{ID: "root", MemberID: "someone"}, nil).Once()
+
+		err := usecase.DeleteComment(ctx, "root", "member")
+
+		assert.Error(t, err)
+		assert.Equal(t, "commentID[root] memberID[member] 非留言者", err.Error())
+	})
+}
+
+func TestPinComment(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	video := &domain.Video{ID: 1, MemberID: "owner"}
+
+	// **情境 1: 上傳者置頂留言**
+	t.Run("上傳者置頂留言", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "root").Return(&domain.Comment{ID: "root", VideoID: 1}, nil).Once()
+		mockVideo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockComment.On("SetPinned", ctx, uint(1), "root", true).Return(nil).Once()
+
+		err := usecase.PinComment(ctx, "root", "owner", true)
+
+		assert.NoError(t, err)
+		mockComment.AssertExpectations(t)
+	})
+
+	// **情境 2: 非上傳者不可置頂**
+	t.Run("非上傳者不可置頂", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "root").Return(&domain.Comment{ID: "root", VideoID: 1}, nil).Once()
+		mockVideo.On("GetByID", uint(1)).Return(video, nil).Once()
+
+		err := usecase.PinComment(ctx, "root", "member", true)
+
+		assert.Error(t, err)
+		assert.Equal(t, "videoID[1] memberID[member] 非影片擁有者，無法置頂留言", err.Error())
+	})
+
+	// **情境 3: 回覆不可置頂**
+	t.Run("回覆不可置頂", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		mockComment.On("GetByID", ctx, "reply").Return(&domain.Comment{ID: "reply", VideoID: 1, ParentID: "root"}, nil).Once()
+		mockVideo.On("GetByID", uint(1)).Return(video, nil).Once()
+
+		err := usecase.PinComment(ctx, "reply", "owner", true)
+
+		assert.Error(t, err)
+		assert.Equal(t, "commentID[reply] 只能置頂未刪除的頂層留言", err.Error())
+	})
+}
+
+func TestListComments(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	video := &domain.Video{ID: 1, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+	createdAt := time.UnixMilli(1700000000000).UTC()
+
+	// **情境 1: 第一頁帶回置頂留言與下一頁游標**
+	t.Run("第一頁帶回置頂留言與下一頁游標", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		mockVideo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockComment.On("List", ctx, domain.CommentQuery{VideoID: 1, Sort: domain.CommentSortTop, Limit: 3}).Return([]domain.Comment{
+			{ID: "a", ReplyCount: 5, CreatedAt: createdAt},
+			{ID: "b", ReplyCount: 3, CreatedAt: createdAt},
+			{ID: "c", ReplyCount: 1, CreatedAt: createdAt},
+		}, nil).Once()
+		mockComment.On("GetPinned", ctx, uint(1)).Return(&domain.Comment{ID: "pinned", Pinned: true}, nil).Once()
+
+		page, err := usecase.ListComments(ctx, 1, "", "", "", 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Comments, 2)
+		assert.Equal(t, "pinned", page.Pinned.ID)
+
+		cursor, err := domain.DecodeCommentCursor(page.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.CommentCursor{ReplyCount: 3, CreatedAt: createdAt.UnixMilli(), ID: "b"}, *cursor)
+	})
+
+	// **情境 2: 帶入游標時不再回傳置頂留言，最後一頁沒有游標**
+	t.Run("帶入游標的最後一頁", func(t *testing.T) {
+		mockComment := new(MockCommentRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewCommentUseCase(mockComment, mockVideo, new(MockOutboxRepo))
+
+		cursor := domain.CommentCursor{CreatedAt: createdAt.UnixMilli(), ID: "b"}
+		mockVideo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockComment.On("List", ctx, domain.CommentQuery{VideoID: 1, Sort: domain.CommentSortNewest, Cursor: &cursor, Limit: 3}).
+			Return([]domain.Comment{{ID: "c", CreatedAt: createdAt}}, nil).Once()
+
+		page, err := usecase.ListComments(ctx, 1, "", domain.CommentSortNewest, cursor.Encode(), 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Comments, 1)
+		assert.Nil(t, page.Pinned)
+		assert.Empty(t, page.NextCursor)
+		mockComment.AssertNotCalled(t, "GetPinned", mock.Anything, mock.Anything)
+	})
+
+	// **情境 3: 不支援的排序**
+	t.Run("不支援的排序", func(t *testing.T) {
+		usecase := NewCommentUseCase(new(MockCommentRepo), new(MockVideoRepo), new(MockOutboxRepo))
+
+		page, err := usecase.ListComments(ctx, 1, "", "oldest", "", 0)
+
+		assert.Error(t, err)
+		assert.Nil(t, page)
+		assert.Equal(t, "videoID[1] 不支援的留言排序: oldest", err.Error())
+	})
+}
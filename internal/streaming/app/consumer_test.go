@@ -0,0 +1,201 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 上傳 → outbox → in-memory 工作佇列 → Consumer，不需要任何 broker
+func TestUploadTranscodeFlow(t *testing.T) {
+	logger.SetNewNop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo := new(MockVideoRepo)
+	mockMinIO := new(MockMinIOClient)
+	mockOutbox := new(MockOutboxRepo)
+	queue := database.NewMemoryJobQueue(database.RetryPolicy{MaxAttempts: 3})
+	defer queue.Close()
+
+	// 轉碼第一次失敗、第二次成功，失敗的訊息會重新投遞
+	originalTranscode := transcode
+	defer func() { transcode = originalTranscode }()
+	jobs := make(chan domain.TranscodingJob, 2)
+	attempts := 0
+	transcode = func(ctx context.Context, job domain.TranscodingJob, mClient database.MinIOClientRepo,
+		videoRepo repository.VideoRepo, watermarkRepo repository.WatermarkRepo, thumbnailRepo repository.ThumbnailRepo,
+		playbackURLs *domain.PlaybackURLBuilder) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("ffmpeg error")
+		}
+		jobs <- job
+		return nil
+	}
+
+	// **情境 1: 上傳後轉碼工作事件寫入 outbox**
+	var outbox []domain.OutboxEvent
+	mockRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Video).ID = 7
+	}).Return(nil).Once()
+	mockMinIO.On("UploadFile", mock.Anything, "original/7/flow.mp4", mock.Anything, "video/mp4").Return(nil).Once()
+	mockRepo.On("SaveWithEvents", mock.Anything).Run(func(args mock.Arguments) {
+		events, err := domain.TranscodingJobEvents(args.Get(0).(*domain.Video))
+		assert.NoError(t, err)
+		for index := range events {
+			events[index].ID = uint(len(outbox) + index + 1)
+		}
+		outbox = append(outbox, events...)
+	}).Return(nil).Once()
+
+	res, err := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil).UploadVideo(domain.UploadVideoReq{
+		MemberID: "uploader",
+		Title:    "flow",
+		FileName: "flow.mp4",
+		Type:     domain.VideoTypeLong,
+		File:     bytes.NewReader([]byte("dummy video content")),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, res.VideoID)
+	assert.Len(t, outbox, 1)
+
+	// **情境 2: OutboxRelay 發布到工作佇列**
+	mockOutbox.On("ListPending", domain.OutboxBatchSize).Return(outbox, nil).Once()
+	mockOutbox.On("MarkSent", uint(1), now).Return(nil).Once()
+
+	sent, err := NewOutboxRelay(mockOutbox, queue, new(MockLeaderLock), time.Second, time.Hour).Relay(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	// **情境 3: Consumer 取得轉碼工作，失敗後重試**
+	consumer := NewConsumer(queue, mockMinIO, mockRepo, new(MockWatermarkRepo), new(MockThumbnailRepo), domain.QueueName, nil)
+	go consumer.StartConsumer(ctx)
+
+	select {
+	case job := <-jobs:
+		assert.Equal(t, domain.TranscodingJob{VideoID: 7, FileName: "original/7/flow.mp4", Type: domain.VideoTypeLong}, job)
+		assert.Equal(t, 2, attempts)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Consumer 未收到轉碼工作")
+	}
+	mockRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+// 無法解析或持續失敗的訊息不會無限重試，後面的訊息可以繼續處理
+func TestConsumerRetryLimit(t *testing.T) {
+	logger.SetNewNop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	queue := database.NewMemoryJobQueue(database.RetryPolicy{MaxAttempts: 2})
+	defer queue.Close()
+
+	originalTranscode := transcode
+	defer func() { transcode = originalTranscode }()
+	done := make(chan uint, 1)
+	attempts := map[uint]int{}
+	transcode = func(ctx context.Context, job domain.TranscodingJob, mClient database.MinIOClientRepo,
+		videoRepo repository.VideoRepo, watermarkRepo repository.WatermarkRepo, thumbnailRepo repository.ThumbnailRepo,
+		playbackURLs *domain.PlaybackURLBuilder) error {
+		attempts[job.VideoID]++
+		if job.VideoID == 1 {
+			return errors.New("ffmpeg error")
+		}
+		done <- job.VideoID
+		return nil
+	}
+
+	// **情境 1: 無法解析的訊息直接捨棄，持續失敗的訊息重試次數用盡後捨棄**
+	assert.NoError(t, queue.Publish(ctx, domain.QueueName, database.QueueMessage{ID: "1", Body: []byte("not json")}))
+	assert.NoError(t, queue.Publish(ctx, domain.QueueName, database.QueueMessage{ID: "2", Body: []byte(`{"video_id":1}`)}))
+	assert.NoError(t, queue.Publish(ctx, domain.QueueName, database.QueueMessage{ID: "3", Body: []byte(`{"video_id":2}`)}))
+	go NewConsumer(queue, nil, nil, nil, nil, domain.QueueName, nil).StartConsumer(ctx)
+
+	select {
+	case videoID := <-done:
+		assert.Equal(t, uint(2), videoID)
+		assert.Equal(t, map[uint]int{1: 2, 2: 1}, attempts)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Consumer 未處理後面的訊息")
+	}
+}
+
+func TestProcessTranscodingJobClaim(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	job := domain.TranscodingJob{VideoID: 8, FileName: "original/8/a.mp4"}
+
+	// **情境 1: 影片已由其他 Worker 認領或已完成時略過**
+	t.Run("已認領略過", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		mockRepo.On("GetByID", uint(8)).Return(&domain.Video{ID: 8, Status: string(domain.VideoProcessing)}, nil).Once()
+		mockRepo.On("ClaimTranscode", uint(8)).Return(false, nil).Once()
+
+		err := processTranscodingJob(ctx, job, mockMinIO, mockRepo, nil, nil, nil)
+
+		assert.NoError(t, err)
+		mockMinIO.AssertNotCalled(t, "DownloadFile", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 轉碼失敗時釋放認領，讓重新投遞的訊息可以再次認領**
+	t.Run("失敗釋放", func(t *testing.T) {
+		defer os.RemoveAll("./tmp")
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		mockRepo.On("GetByID", uint(8)).Return(&domain.Video{ID: 8, Status: string(domain.VideoUpload)}, nil).Once()
+		mockRepo.On("ClaimTranscode", uint(8)).Return(true, nil).Once()
+		mockMinIO.On("DownloadFile", ctx, "original/8/a.mp4", "./tmp/8_original.mp4").Return(errors.New("minio error")).Once()
+		mockRepo.On("ReleaseTranscode", uint(8)).Return(false, nil).Once()
+
+		err := processTranscodingJob(ctx, job, mockMinIO, mockRepo, nil, nil, nil)
+
+		assert.Error(t, err)
+		assert.NoDirExists(t, "./tmp/8_processed")
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 重試次數用盡時標記為 failed，不再重試**
+	t.Run("重試次數用盡", func(t *testing.T) {
+		defer os.RemoveAll("./tmp")
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		mockRepo.On("GetByID", uint(8)).Return(&domain.Video{ID: 8, Status: string(domain.VideoUpload)}, nil).Once()
+		mockRepo.On("ClaimTranscode", uint(8)).Return(true, nil).Once()
+		mockMinIO.On("DownloadFile", ctx, "original/8/a.mp4", "./tmp/8_original.mp4").Return(errors.New("minio error")).Once()
+		mockRepo.On("ReleaseTranscode", uint(8)).Return(true, nil).Once()
+
+		err := processTranscodingJob(ctx, job, mockMinIO, mockRepo, nil, nil, nil)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 已被下架的影片不認領、不轉碼**
+	t.Run("已下架略過", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		mockRepo.On("GetByID", uint(8)).Return(&domain.Video{ID: 8, Status: string(domain.VideoBlocked)}, nil).Once()
+
+		err := processTranscodingJob(ctx, job, mockMinIO, mockRepo, nil, nil, nil)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "ClaimTranscode", uint(8))
+		mockMinIO.AssertNotCalled(t, "DownloadFile", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// RequestDownload 實作 請求下載影片
+func (s *StreamingGRPCServer) RequestDownload(ctx context.Context, req *streaming_pb.RequestDownloadReq) (*streaming_pb.RequestDownloadRes, error) {
+	link, err := s.DownloadUsecase.RequestDownload(ctx, domain.RequestDownloadReq{
+		VideoID: req.VideoId,
+		Viewer:  toViewer(req.MemberId, req.ViewerAge),
+		Quality: domain.DownloadQuality(req.Quality),
+	})
+	if err != nil {
+		return &streaming_pb.RequestDownloadRes{
+			Success:       false,
+			Error:         err.Error(),
+			QuotaExceeded: errors.Is(err, domain.ErrQuotaExceeded),
+		}, nil
+	}
+	res := &streaming_pb.RequestDownloadRes{
+		Success:        true,
+		Status:         string(link.Status),
+		Url:            link.URL,
+		SizeBytes:      link.SizeBytes,
+		QuotaRemaining: int32(link.QuotaRemaining),
+	}
+	if link.Status == domain.DownloadReady {
+		res.ExpiresAt = link.ExpiresAt.Unix()
+	} else {
+		res.RetryAfterSeconds = int32(domain.DownloadRetryAfter.Seconds())
+	}
+	return res, nil
+}
+
+// SetDownloadEnabled 實作 停用或開放影片下載
+func (s *StreamingGRPCServer) SetDownloadEnabled(ctx context.Context, req *streaming_pb.SetDownloadEnabledReq) (*streaming_pb.SetDownloadEnabledRes, error) {
+	video, err := s.DownloadUsecase.SetDownloadEnabled(ctx, domain.SetDownloadEnabledReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Enabled:  req.Enabled,
+	})
+	if err != nil {
+		return &streaming_pb.SetDownloadEnabledRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.SetDownloadEnabledRes{
+		Success:         true,
+		DownloadEnabled: !video.DownloadsDisabled,
+	}, nil
+}
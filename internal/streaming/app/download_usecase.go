@@ -0,0 +1,160 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// DownloadUseCase 離線下載：依畫質產生 faststart MP4，快取在 MinIO 後發出短效簽章連結
+type DownloadUseCase interface {
+	RequestDownload(ctx context.Context, req domain.RequestDownloadReq) (*domain.DownloadLink, error)
+	SetDownloadEnabled(ctx context.Context, req domain.SetDownloadEnabledReq) (*domain.Video, error)
+}
+
+type downloadUseCase struct {
+	MinioClient  database.MinIOClientRepo
+	VideoRepo    repository.VideoRepo
+	DownloadRepo repository.DownloadRepo
+}
+
+// NewDownloadUseCase 建立 DownloadUseCase
+func NewDownloadUseCase(minIO database.MinIOClientRepo, videoRepo repository.VideoRepo,
+	downloadRepo repository.DownloadRepo) DownloadUseCase {
+	return &downloadUseCase{
+		MinioClient:  minIO,
+		VideoRepo:    videoRepo,
+		DownloadRepo: downloadRepo,
+	}
+}
+
+// RequestDownload 請求下載影片
+//   - 需登入、可觀看影片且上傳者未停用下載（上傳者本人不受限）
+//   - 每位會員在 DownloadQuotaWindow 內最多取得 DownloadDailyQuota 個下載連結
+//   - 檔案已快取時回傳簽章連結；尚未產生、先前失敗或影片已重新轉碼時發布工作給 DownloadWorker，回傳 preparing
+func (d *downloadUseCase) RequestDownload(ctx context.Context, req domain.RequestDownloadReq) (*domain.DownloadLink, error) {
+	memberID := req.Viewer.MemberID
+	if memberID == "" {
+		return nil, errprocess.Set("需登入才能下載影片")
+	}
+	quality := req.Quality
+	if quality == "" {
+		quality = domain.DefaultDownloadQuality
+	}
+	if !quality.IsValid() {
+		errMsg := fmt.Sprintf("videoID[%s] 不支援的下載畫質: %s", req.VideoID, quality)
+		return nil, errprocess.Set(errMsg)
+	}
+	video, err := d.getDownloadableVideo(req.VideoID, req.Viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	used, err := d.DownloadRepo.CountGrantsSince(memberID, now.Add(-domain.DownloadQuotaWindow))
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得下載次數失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if used >= domain.DownloadDailyQuota {
+		errMsg := fmt.Sprintf("memberID[%s] 24 小時內最多下載 %d 次", memberID, domain.DownloadDailyQuota)
+		return nil, fmt.Errorf("%w: %v", domain.ErrQuotaExceeded, errprocess.Set(errMsg))
+	}
+	remaining := domain.DownloadDailyQuota - int(used)
+
+	download, err := d.DownloadRepo.Get(video.ID, quality)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得下載檔案失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if download == nil || download.Status == string(domain.DownloadFailed) || download.IsStale(now) ||
+		(download.Status == string(domain.DownloadReady) && !download.BuiltFrom(video)) {
+		if err := d.requestJob(video.ID, quality); err != nil {
+			errMsg := fmt.Sprintf("videoID[%s] 發布下載工作失敗: %v", req.VideoID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		return &domain.DownloadLink{Status: domain.DownloadPreparing, QuotaRemaining: remaining}, nil
+	}
+	if download.Status != string(domain.DownloadReady) {
+		return &domain.DownloadLink{Status: domain.DownloadPreparing, QuotaRemaining: remaining}, nil
+	}
+
+	url, err := d.MinioClient.PresignGetURL(ctx, download.ObjectKey, domain.DownloadLinkTTL)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 產生下載連結失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := d.DownloadRepo.CreateGrant(&domain.DownloadGrant{
+		MemberID:  memberID,
+		VideoID:   video.ID,
+		Quality:   string(quality),
+		CreatedAt: now,
+	}); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 記錄下載次數失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return &domain.DownloadLink{
+		Status:         domain.DownloadReady,
+		URL:            url,
+		ExpiresAt:      now.Add(domain.DownloadLinkTTL),
+		SizeBytes:      download.SizeBytes,
+		QuotaRemaining: remaining - 1,
+	}, nil
+}
+
+// SetDownloadEnabled 上傳者停用或開放影片下載，已發出的連結在到期前仍可使用
+func (d *downloadUseCase) SetDownloadEnabled(ctx context.Context, req domain.SetDownloadEnabledReq) (*domain.Video, error) {
+	id, _ := strconv.Atoi(req.VideoID)
+	video, err := d.VideoRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !video.IsOwner(req.MemberID) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", req.VideoID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := d.DownloadRepo.SetDownloadsDisabled(video.ID, !req.Enabled); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 設定下載失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	video.DownloadsDisabled = !req.Enabled
+	return video, nil
+}
+
+// getDownloadableVideo 取得可下載的影片：可播放（觀看權限與分級）、已轉碼完成，且上傳者未停用下載
+func (d *downloadUseCase) getDownloadableVideo(videoID string, viewer domain.Viewer) (*domain.Video, error) {
+	video, err := getViewerPlayableVideo(d.VideoRepo, videoID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case video.Status != string(domain.VideoReady):
+		errMsg := fmt.Sprintf("videoID[%s] 影片尚未處理完成", videoID)
+		return nil, errprocess.Set(errMsg)
+	case !video.IsOwner(viewer.MemberID) && video.DownloadsDisabled:
+		errMsg := fmt.Sprintf("videoID[%s] 上傳者已停用下載", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+// requestJob 標記下載檔案產生中，並在同一個交易內寫入下載工作事件，由 OutboxRelay 發布給 DownloadWorker
+func (d *downloadUseCase) requestJob(videoID uint, quality domain.DownloadQuality) error {
+	events, err := domain.DownloadJobEvents(videoID, quality)
+	if err != nil {
+		return err
+	}
+	return d.DownloadRepo.SaveWithEvents(&domain.VideoDownload{
+		VideoID:   videoID,
+		Quality:   string(quality),
+		Status:    string(domain.DownloadPreparing),
+		ObjectKey: domain.DownloadObjectKey(videoID, quality),
+	}, events)
+}
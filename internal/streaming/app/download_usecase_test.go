@@ -0,0 +1,366 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDownloadRepo 是 DownloadRepo 的 Mock
+type MockDownloadRepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockDownloadRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Get 模擬取得下載檔案
+func (m *MockDownloadRepo) Get(videoID uint, quality domain.DownloadQuality) (*domain.VideoDownload, error) {
+	args := m.Called(videoID, quality)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VideoDownload), args.Error(1)
+}
+
+// Save 模擬保存下載檔案狀態
+func (m *MockDownloadRepo) Save(download *domain.VideoDownload) error {
+	args := m.Called(download)
+	return args.Error(0)
+}
+
+func (m *MockDownloadRepo) SaveWithEvents(download *domain.VideoDownload, events []domain.OutboxEvent) error {
+	args := m.Called(download, events)
+	return args.Error(0)
+}
+
+// CreateGrant 模擬記錄下載連結
+func (m *MockDownloadRepo) CreateGrant(grant *domain.DownloadGrant) error {
+	args := m.Called(grant)
+	return args.Error(0)
+}
+
+// CountGrantsSince 模擬計算下載次數
+func (m *MockDownloadRepo) CountGrantsSince(memberID string, since time.Time) (int64, error) {
+	args := m.Called(memberID, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// SetDownloadsDisabled 模擬停用或開放下載
+func (m *MockDownloadRepo) SetDownloadsDisabled(videoID uint, disabled bool) error {
+	args := m.Called(videoID, disabled)
+	return args.Error(0)
+}
+
+func TestRequestDownload(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	readyVideo := func() *domain.Video {
+		return &domain.Video{ID: 1, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+	}
+	viewer := domain.Viewer{MemberID: "viewer"}
+
+	// **情境 1: 檔案已快取，回傳簽章連結並記錄下載次數**
+	t.Run("已快取", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewDownloadUseCase(mockMinIO, mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(readyVideo(), nil).Once()
+		mockRepo.On("CountGrantsSince", "viewer", mock.Anything).Return(int64(3), nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download480p).Return(&domain.VideoDownload{
+			VideoID: 1, Quality: "480p", Status: string(domain.DownloadReady), ObjectKey: "downloads/1/480p.mp4", SizeBytes: 1024,
+		}, nil).Once()
+		mockMinIO.On("PresignGetURL", ctx, "downloads/1/480p.mp4", domain.DownloadLinkTTL).Return("http://minio/signed", nil).Once()
+		mockRepo.On("CreateGrant", mock.MatchedBy(func(grant *domain.DownloadGrant) bool {
+			return grant.MemberID == "viewer" && grant.VideoID == 1 && grant.Quality == "480p"
+		})).Return(nil).Once()
+
+		link, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer, Quality: domain.Download480p})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DownloadReady, link.Status)
+		assert.Equal(t, "http://minio/signed", link.URL)
+		assert.Equal(t, int64(1024), link.SizeBytes)
+		assert.Equal(t, domain.DownloadDailyQuota-4, link.QuotaRemaining)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 尚未產生時與下載工作事件一起寫入，預設畫質 720p，不計入下載次數**
+	t.Run("發布下載工作", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(readyVideo(), nil).Once()
+		mockRepo.On("CountGrantsSince", "viewer", mock.Anything).Return(int64(0), nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(nil, nil).Once()
+		mockRepo.On("SaveWithEvents", mock.MatchedBy(func(download *domain.VideoDownload) bool {
+			return download.Status == string(domain.DownloadPreparing) && download.ObjectKey == "downloads/1/720p.mp4"
+		}), mock.MatchedBy(func(events []domain.OutboxEvent) bool {
+			var job domain.DownloadJob
+			return len(events) == 1 && events[0].Topic == domain.DownloadTopic && events[0].EventType == domain.DownloadJobEvent &&
+				json.Unmarshal([]byte(events[0].Payload), &job) == nil && job == domain.DownloadJob{VideoID: 1, Quality: domain.Download720p}
+		})).Return(nil).Once()
+
+		link, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DownloadPreparing, link.Status)
+		assert.Equal(t, domain.DownloadDailyQuota, link.QuotaRemaining)
+		mockRepo.AssertNotCalled(t, "CreateGrant", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 產生中時不重複發布**
+	t.Run("產生中", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(readyVideo(), nil).Once()
+		mockRepo.On("CountGrantsSince", "viewer", mock.Anything).Return(int64(0), nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(&domain.VideoDownload{
+			Status: string(domain.DownloadPreparing), UpdatedAt: time.Now(),
+		}, nil).Once()
+
+		link, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DownloadPreparing, link.Status)
+		mockRepo.AssertNotCalled(t, "SaveWithEvents", mock.Anything, mock.Anything)
+	})
+
+	// **情境 4: 已達下載次數上限**
+	t.Run("下載次數上限", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(readyVideo(), nil).Once()
+		mockRepo.On("CountGrantsSince", "viewer", mock.Anything).Return(int64(domain.DownloadDailyQuota), nil).Once()
+
+		_, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+
+		assert.True(t, errors.Is(err, domain.ErrQuotaExceeded))
+		assert.EqualError(t, err, "已達使用上限: memberID[viewer] 24 小時內最多下載 10 次")
+		mockRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+
+	// **情境 5: 上傳者停用下載，上傳者本人仍可下載**
+	t.Run("停用下載", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+		disabled := readyVideo()
+		disabled.DownloadsDisabled = true
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(disabled, nil).Twice()
+		mockRepo.On("CountGrantsSince", "owner", mock.Anything).Return(int64(0), nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(&domain.VideoDownload{Status: string(domain.DownloadPreparing), UpdatedAt: time.Now()}, nil).Once()
+
+		_, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+		assert.EqualError(t, err, "videoID[1] 上傳者已停用下載")
+
+		link, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: domain.Viewer{MemberID: "owner"}})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DownloadPreparing, link.Status)
+	})
+
+	// **情境 6: 訪客、不支援的畫質、未轉碼完成與分級限制**
+	t.Run("無法下載", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, new(MockDownloadRepo))
+
+		_, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1"})
+		assert.EqualError(t, err, "需登入才能下載影片")
+
+		_, err = usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer, Quality: "4k"})
+		assert.EqualError(t, err, "videoID[1] 不支援的下載畫質: 4k")
+
+		processing := readyVideo()
+		processing.Status = string(domain.VideoProcessing)
+		mockVideoRepo.On("GetByID", uint(1)).Return(processing, nil).Once()
+		_, err = usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+		assert.EqualError(t, err, "videoID[1] 影片尚未處理完成")
+
+		adult := readyVideo()
+		adult.ContentRating = string(domain.RatingAdult)
+		mockVideoRepo.On("GetByID", uint(1)).Return(adult, nil).Once()
+		_, err = usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+		assert.EqualError(t, err, "videoID[1] memberID[viewer] 未達 18+ 分級的觀看年齡")
+	})
+
+	// **情境 7: 影片重新轉碼後，快取的檔案不再發出連結，改為重新產生**
+	t.Run("重新轉碼", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+		oldReadyAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		readyAt := oldReadyAt.Add(time.Hour)
+		video := readyVideo()
+		video.ReadyAt = &readyAt
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+		mockRepo.On("CountGrantsSince", "viewer", mock.Anything).Return(int64(0), nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(&domain.VideoDownload{
+			VideoID: 1, Quality: "720p", Status: string(domain.DownloadReady), ObjectKey: "downloads/1/720p.mp4", SourceReadyAt: &oldReadyAt,
+		}, nil).Once()
+		mockRepo.On("SaveWithEvents", mock.MatchedBy(func(download *domain.VideoDownload) bool {
+			return download.Status == string(domain.DownloadPreparing)
+		}), mock.Anything).Return(nil).Once()
+
+		link, err := usecase.RequestDownload(ctx, domain.RequestDownloadReq{VideoID: "1", Viewer: viewer})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DownloadPreparing, link.Status)
+		mockRepo.AssertNotCalled(t, "CreateGrant", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestSetDownloadEnabled(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 上傳者停用下載**
+	t.Run("停用下載", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("SetDownloadsDisabled", uint(1), true).Return(nil).Once()
+
+		video, err := usecase.SetDownloadEnabled(ctx, domain.SetDownloadEnabledReq{VideoID: "1", MemberID: "owner", Enabled: false})
+
+		assert.NoError(t, err)
+		assert.True(t, video.DownloadsDisabled)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 非上傳者無法設定**
+	t.Run("非上傳者", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewDownloadUseCase(new(MockMinIOClient), mockVideoRepo, mockRepo)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+
+		_, err := usecase.SetDownloadEnabled(ctx, domain.SetDownloadEnabledReq{VideoID: "1", MemberID: "other", Enabled: true})
+
+		assert.EqualError(t, err, "videoID[1] memberID[other] 非影片擁有者")
+		mockRepo.AssertNotCalled(t, "SetDownloadsDisabled", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDownloadWorker(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	originalBuild := buildDownload
+	defer func() { buildDownload = originalBuild }()
+	message := func(job domain.DownloadJob) database.QueueMessage {
+		body, _ := json.Marshal(job)
+		return database.QueueMessage{ID: "m1", Type: domain.DownloadJobEvent, Body: body}
+	}
+
+	// **情境 1: 產生下載檔案後標記為 ready**
+	t.Run("產生完成", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		worker := NewDownloadWorker(new(MockJobQueue), new(MockMinIOClient), mockVideoRepo, mockRepo)
+		buildDownload = func(ctx context.Context, mClient database.MinIOClientRepo, video *domain.Video,
+			quality domain.DownloadQuality, objectKey string) (int64, error) {
+			assert.Equal(t, "downloads/1/360p.mp4", objectKey)
+			return 2048, nil
+		}
+
+		mockRepo.On("Get", uint(1), domain.Download360p).Return(&domain.VideoDownload{Status: string(domain.DownloadPreparing)}, nil).Once()
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(download *domain.VideoDownload) bool {
+			return download.Status == string(domain.DownloadReady) && download.SizeBytes == 2048
+		})).Return(nil).Once()
+
+		assert.NoError(t, worker.Handle(ctx, message(domain.DownloadJob{VideoID: 1, Quality: domain.Download360p})))
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 產生失敗時標記為 failed，不重新投遞**
+	t.Run("產生失敗", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		worker := NewDownloadWorker(new(MockJobQueue), new(MockMinIOClient), mockVideoRepo, mockRepo)
+		buildDownload = func(ctx context.Context, mClient database.MinIOClientRepo, video *domain.Video,
+			quality domain.DownloadQuality, objectKey string) (int64, error) {
+			return 0, errors.New("ffmpeg error")
+		}
+
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(nil, nil).Once()
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(download *domain.VideoDownload) bool {
+			return download.Status == string(domain.DownloadFailed)
+		})).Return(nil).Once()
+
+		assert.NoError(t, worker.Handle(ctx, message(domain.DownloadJob{VideoID: 1, Quality: domain.Download720p})))
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 重複投遞的工作，檔案已由目前的轉碼結果產生時略過**
+	t.Run("已產生", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		worker := NewDownloadWorker(new(MockJobQueue), new(MockMinIOClient), mockVideoRepo, mockRepo)
+		buildDownload = func(ctx context.Context, mClient database.MinIOClientRepo, video *domain.Video,
+			quality domain.DownloadQuality, objectKey string) (int64, error) {
+			t.Fatal("已產生的檔案不應重新產生")
+			return 0, nil
+		}
+		readyAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, ReadyAt: &readyAt}, nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(&domain.VideoDownload{
+			Status: string(domain.DownloadReady), SourceReadyAt: &readyAt,
+		}, nil).Once()
+
+		assert.NoError(t, worker.Handle(ctx, message(domain.DownloadJob{VideoID: 1, Quality: domain.Download720p})))
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 影片重新轉碼後覆寫舊的檔案，並記錄新的 ReadyAt**
+	t.Run("重新轉碼", func(t *testing.T) {
+		mockRepo := new(MockDownloadRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		worker := NewDownloadWorker(new(MockJobQueue), new(MockMinIOClient), mockVideoRepo, mockRepo)
+		buildDownload = func(ctx context.Context, mClient database.MinIOClientRepo, video *domain.Video,
+			quality domain.DownloadQuality, objectKey string) (int64, error) {
+			return 4096, nil
+		}
+		oldReadyAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		readyAt := oldReadyAt.Add(time.Hour)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, ReadyAt: &readyAt}, nil).Once()
+		mockRepo.On("Get", uint(1), domain.Download720p).Return(&domain.VideoDownload{
+			Status: string(domain.DownloadReady), SourceReadyAt: &oldReadyAt,
+		}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(download *domain.VideoDownload) bool {
+			return download.Status == string(domain.DownloadReady) && download.SizeBytes == 4096 &&
+				download.SourceReadyAt.Equal(readyAt)
+		})).Return(nil).Once()
+
+		assert.NoError(t, worker.Handle(ctx, message(domain.DownloadJob{VideoID: 1, Quality: domain.Download720p})))
+		mockRepo.AssertExpectations(t)
+	})
+}
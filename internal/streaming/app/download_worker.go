@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+)
+
+// DownloadWorker 消費 DownloadTopic 的工作，由轉碼後的 HLS 產生指定畫質的 faststart MP4 並上傳到 MinIO
+type DownloadWorker struct {
+	queue        database.JobQueue
+	minioClient  database.MinIOClientRepo
+	videoRepo    repository.VideoRepo
+	downloadRepo repository.DownloadRepo
+}
+
+// NewDownloadWorker 建構 DownloadWorker 實例
+func NewDownloadWorker(queue database.JobQueue, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo,
+	downloadRepo repository.DownloadRepo) *DownloadWorker {
+	return &DownloadWorker{
+		queue:        queue,
+		minioClient:  minioClient,
+		videoRepo:    videoRepo,
+		downloadRepo: downloadRepo,
+	}
+}
+
+// 讓測試可以替換實際的檔案產生（需要 FFmpeg 與 MinIO）
+var buildDownload = buildDownloadFile
+
+// Start 開始消費下載工作，直到 ctx 結束
+func (w *DownloadWorker) Start(ctx context.Context) {
+	logger.Log.Info("DownloadWorker 已啟動，等待下載工作")
+	if err := w.queue.Consume(ctx, domain.DownloadTopic, w.Handle); err != nil && ctx.Err() == nil {
+		logger.Log.Errorf("DownloadWorker 消費下載工作失敗:", err)
+	}
+}
+
+// Handle 產生一個下載檔案；已由目前轉碼結果產生的檔案略過（重複投遞的訊息），影片重新轉碼後覆寫舊的檔案
+// 產生失敗時標記為 failed，不重新投遞，由下一次下載請求重新發布
+func (w *DownloadWorker) Handle(ctx context.Context, msg database.QueueMessage) error {
+	var job domain.DownloadJob
+	if err := json.Unmarshal(msg.Body, &job); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("messageID[%s] 解析下載工作失敗:", msg.ID), err)
+		return nil
+	}
+	video, err := w.videoRepo.GetByID(job.VideoID)
+	if err != nil {
+		return fmt.Errorf("videoID[%d] 取得影片失敗: %w", job.VideoID, err)
+	}
+	download, err := w.downloadRepo.Get(job.VideoID, job.Quality)
+	if err != nil {
+		return fmt.Errorf("videoID[%d] 取得下載檔案失敗: %w", job.VideoID, err)
+	}
+	if download != nil && download.Status == string(domain.DownloadReady) && download.BuiltFrom(video) {
+		return nil
+	}
+
+	result := &domain.VideoDownload{
+		VideoID:       job.VideoID,
+		Quality:       string(job.Quality),
+		Status:        string(domain.DownloadReady),
+		ObjectKey:     domain.DownloadObjectKey(job.VideoID, job.Quality),
+		SourceReadyAt: video.ReadyAt,
+	}
+	size, err := buildDownload(ctx, w.minioClient, video, job.Quality, result.ObjectKey)
+	if err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] quality[%s] 產生下載檔案失敗:", job.VideoID, job.Quality), err)
+		result.Status = string(domain.DownloadFailed)
+	}
+	result.SizeBytes = size
+	if err := w.downloadRepo.Save(result); err != nil {
+		return fmt.Errorf("videoID[%d] 更新下載檔案狀態失敗: %w", job.VideoID, err)
+	}
+	return nil
+}
+
+// buildDownloadFile 下載影片的 HLS 播放清單與分段，轉成指定畫質的 faststart MP4 後上傳到 objectKey，回傳檔案大小
+func buildDownloadFile(ctx context.Context, mClient database.MinIOClientRepo, video *domain.Video,
+	quality domain.DownloadQuality, objectKey string) (int64, error) {
+	workDir := fmt.Sprintf("./tmp/%d_download_%s", video.ID, quality)
+	if err := os.MkdirAll(workDir, 0755); err != nil {
+		return 0, fmt.Errorf("建立下載暫存目錄失敗: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	playlistKey := video.ProcessedKey(domain.PlaylistFileName)
+	sourcePlaylist := filepath.Join(workDir, "source.m3u8")
+	if err := mClient.DownloadFile(ctx, playlistKey, sourcePlaylist); err != nil {
+		return 0, fmt.Errorf("下載播放清單失敗: %w", err)
+	}
+	content, err := os.ReadFile(sourcePlaylist)
+	if err != nil {
+		return 0, fmt.Errorf("讀取播放清單失敗: %w", err)
+	}
+
+	// 分段與 m3u8 放在同一個 object 目錄下，URI 為相對路徑
+	segments := domain.ParsePlaylistSegments(content)
+	if len(segments) == 0 {
+		return 0, fmt.Errorf("播放清單 %s 沒有分段", playlistKey)
+	}
+	prefix := path.Dir(playlistKey)
+	for index, segment := range segments {
+		objectName := path.Join(prefix, segment.URI)
+		segments[index].URI = path.Base(segment.URI)
+		if err := mClient.DownloadFile(ctx, objectName, filepath.Join(workDir, segments[index].URI)); err != nil {
+			return 0, fmt.Errorf("下載分段 %s 失敗: %w", objectName, err)
+		}
+	}
+	inputPath := filepath.Join(workDir, "index.m3u8")
+	if err := os.WriteFile(inputPath, domain.BuildPlaylist(segments), 0644); err != nil {
+		return 0, fmt.Errorf("寫入播放清單失敗: %w", err)
+	}
+
+	outputPath := filepath.Join(workDir, "download.mp4")
+	if err := TranscodeToMP4(inputPath, outputPath, quality.Height()); err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(outputPath)
+	if err != nil {
+		return 0, fmt.Errorf("讀取下載檔案失敗: %w", err)
+	}
+	if err := mClient.UploadFile(ctx, objectKey, outputPath, "video/mp4"); err != nil {
+		return 0, fmt.Errorf("上傳下載檔案失敗: %w", err)
+	}
+	return info.Size(), nil
+}
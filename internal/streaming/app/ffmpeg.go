@@ -4,19 +4,78 @@ import (
 	"fmt"
 	"log"
 	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
 )
 
+// WatermarkOverlay 轉碼時燒錄的浮水印，ImagePath 為下載到本地的圖片
+type WatermarkOverlay struct {
+	ImagePath string
+	Position  domain.WatermarkPosition
+	Opacity   float64
+}
+
+// watermarkMargin 浮水印與畫面邊緣的距離（像素）
+const watermarkMargin = 16
+
+// overlayPosition ffmpeg overlay filter 的座標，W/H 為影片尺寸、w/h 為浮水印尺寸
+func overlayPosition(position domain.WatermarkPosition) string {
+	switch position {
+	case domain.WatermarkTopLeft:
+		return fmt.Sprintf("%d:%d", watermarkMargin, watermarkMargin)
+	case domain.WatermarkTopRight:
+		return fmt.Sprintf("W-w-%d:%d", watermarkMargin, watermarkMargin)
+	case domain.WatermarkBottomLeft:
+		return fmt.Sprintf("%d:H-h-%d", watermarkMargin, watermarkMargin)
+	default:
+		return fmt.Sprintf("W-w-%d:H-h-%d", watermarkMargin, watermarkMargin)
+	}
+}
+
+// watermarkFilter 依序疊上浮水印的 filter_complex，輸入 1..n 為浮水印圖片，輸出標籤為 [vout]
+func watermarkFilter(watermarks []WatermarkOverlay) string {
+	filters := make([]string, 0, len(watermarks)*2)
+	base := "0:v"
+	for index, watermark := range watermarks {
+		out := fmt.Sprintf("v%d", index)
+		if index == len(watermarks)-1 {
+			out = "vout"
+		}
+		filters = append(filters,
+			fmt.Sprintf("[%d:v]format=rgba,colorchannelmixer=aa=%.2f[wm%d]", index+1, watermark.Opacity, index),
+			fmt.Sprintf("[%s][wm%d]overlay=%s[%s]", base, index, overlayPosition(watermark.Position), out),
+		)
+		base = out
+	}
+	return strings.Join(filters, ";")
+}
+
 // TranscodeToHLS 將 inputPath 轉成 HLS 格式，輸出到 outputDir（會產生 index.m3u8 與 TS 分段）
-func TranscodeToHLS(inputPath, outputDir string) error {
-	cmdArgs := []string{
-		"-i", inputPath,
+// 有浮水印時以 overlay filter 燒錄進畫面
+func TranscodeToHLS(inputPath, outputDir string, watermarks []WatermarkOverlay) error {
+	cmdArgs := []string{"-i", inputPath}
+	if len(watermarks) > 0 {
+		for _, watermark := range watermarks {
+			cmdArgs = append(cmdArgs, "-i", watermark.ImagePath)
+		}
+		cmdArgs = append(cmdArgs,
+			"-filter_complex", watermarkFilter(watermarks),
+			"-map", "[vout]",
+			"-map", "0:a?",
+		)
+	}
+	cmdArgs = append(cmdArgs,
 		"-c:v", "libx264",
 		"-c:a", "aac",
 		"-f", "hls",
 		"-hls_time", "4",
 		"-hls_list_size", "0",
 		fmt.Sprintf("%s/index.m3u8", outputDir),
-	}
+	)
 	log.Printf("執行 FFmpeg HLS: ffmpeg %v", cmdArgs)
 	cmd := exec.Command("ffmpeg", cmdArgs...)
 	output, err := cmd.CombinedOutput()
@@ -26,6 +85,208 @@ func TranscodeToHLS(inputPath, outputDir string) error {
 	return nil
 }
 
+// CutHLS 由 inputPath（m3u8）剪出從 offset 開始、長度 duration 的片段並輸出為 HLS
+// copy 為 true 時剪輯點已對齊關鍵影格，直接 stream copy，否則重新編碼
+func CutHLS(inputPath, outputDir string, offset, duration time.Duration, copy bool) error {
+	cmdArgs := []string{"-y"}
+	if offset > 0 {
+		cmdArgs = append(cmdArgs, "-ss", fmt.Sprintf("%.3f", offset.Seconds()))
+	}
+	cmdArgs = append(cmdArgs,
+		"-i", inputPath,
+		"-t", fmt.Sprintf("%.3f", duration.Seconds()),
+	)
+	if copy {
+		cmdArgs = append(cmdArgs, "-c", "copy")
+	} else {
+		cmdArgs = append(cmdArgs, "-c:v", "libx264", "-c:a", "aac")
+	}
+	cmdArgs = append(cmdArgs,
+		"-f", "hls",
+		"-hls_time", "4",
+		"-hls_list_size", "0",
+		fmt.Sprintf("%s/index.m3u8", outputDir),
+	)
+	log.Printf("執行 FFmpeg 剪輯: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("FFmpeg 剪輯錯誤: %v, output: %s", err, string(output))
+	}
+	return nil
+}
+
+// GeneratePoster 由 inputPath 擷取具代表性的一格畫面作為封面圖（JPEG）
+func GeneratePoster(inputPath, outputPath string) error {
+	cmdArgs := []string{
+		"-y",
+		"-i", inputPath,
+		"-vf", "thumbnail",
+		"-frames:v", "1",
+		"-q:v", "2",
+		outputPath,
+	}
+	log.Printf("執行 FFmpeg 封面: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("FFmpeg 封面錯誤: %v, output: %s", err, string(output))
+	}
+	return nil
+}
+
+// ThumbnailFramePattern 場景偵測擷取畫面的檔名，第 N 張為 frame_00N.jpg
+const ThumbnailFramePattern = "frame_%03d.jpg"
+
+// ExtractThumbnailFrames 以場景偵測（select scene）擷取候選畫面到 outputDir，並以 signalstats 量測亮度與對比
+// 第一格與場景沒有變化超過 ThumbnailFrameInterval 時也會擷取，最多 ThumbnailMaxFrames 格；
+// 相鄰兩格至少相隔 ThumbnailFrameGap(duration)，擷取的畫面涵蓋整部影片
+func ExtractThumbnailFrames(inputPath, outputDir string, duration time.Duration) ([]domain.FrameCandidate, error) {
+	gap := domain.ThumbnailFrameGap(duration)
+	interval := domain.ThumbnailFrameInterval
+	if gap > interval {
+		interval = gap
+	}
+	filter := fmt.Sprintf(
+		"select='isnan(prev_selected_t)+gte(t-prev_selected_t,%.3f)*(gt(scene,%.2f)+gte(t-prev_selected_t,%.3f))',"+
+			"signalstats,metadata=mode=print,scale='min(1280,iw)':-2",
+		gap.Seconds(), domain.ThumbnailSceneThreshold, interval.Seconds())
+	cmdArgs := []string{
+		"-y",
+		"-i", inputPath,
+		"-an",
+		"-vf", filter,
+		"-vsync", "vfr",
+		"-frames:v", strconv.Itoa(domain.ThumbnailMaxFrames),
+		"-q:v", "2",
+		filepath.Join(outputDir, ThumbnailFramePattern),
+	}
+	log.Printf("執行 FFmpeg 候選縮圖: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("FFmpeg 候選縮圖錯誤: %v, output: %s", err, string(output))
+	}
+	return domain.ParseFrameMetadata(string(output)), nil
+}
+
+// ResizeThumbnail 將 inputPath 的圖片縮放成 ThumbnailSizes 的每個尺寸，輸出為 outputDir/ThumbnailFileName(name, size)
+// 等比例縮放，不足的部分補黑邊
+func ResizeThumbnail(inputPath, outputDir, name string) error {
+	outputs := make([]string, len(domain.ThumbnailSizes))
+	filters := []string{fmt.Sprintf("[0:v]split=%d", len(domain.ThumbnailSizes))}
+	for index := range domain.ThumbnailSizes {
+		filters[0] += fmt.Sprintf("[in%d]", index)
+	}
+	cmdArgs := []string{"-y", "-i", inputPath}
+	for index, size := range domain.ThumbnailSizes {
+		filters = append(filters, fmt.Sprintf(
+			"[in%d]scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2[out%d]",
+			index, size.Width, size.Height, size.Width, size.Height, index))
+		outputs[index] = filepath.Join(outputDir, domain.ThumbnailFileName(name, size.Size))
+	}
+	cmdArgs = append(cmdArgs, "-filter_complex", strings.Join(filters, ";"))
+	for index, output := range outputs {
+		cmdArgs = append(cmdArgs, "-map", fmt.Sprintf("[out%d]", index), "-frames:v", "1", "-q:v", "2", output)
+	}
+	log.Printf("執行 FFmpeg 縮圖尺寸: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("FFmpeg 縮圖尺寸錯誤: %v, output: %s", err, string(output))
+	}
+	return nil
+}
+
+// ProbeDuration 以 ffprobe 取得 inputPath 的影片長度
+func ProbeDuration(inputPath string) (time.Duration, error) {
+	cmdArgs := []string{
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		inputPath,
+	}
+	cmd := exec.Command("ffprobe", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("FFprobe 影片長度錯誤: %v, output: %s", err, string(output))
+	}
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(string(output)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("FFprobe 影片長度格式錯誤: %s", string(output))
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// DetectBlackFrames 以 blackdetect filter 偵測 inputPath 開頭 length 內的黑畫面區間
+func DetectBlackFrames(inputPath string, length time.Duration) ([]domain.BlackInterval, error) {
+	cmdArgs := []string{
+		"-i", inputPath,
+		"-t", fmt.Sprintf("%.3f", length.Seconds()),
+		"-vf", "blackdetect=d=0.1:pix_th=0.10",
+		"-an",
+		"-f", "null", "-",
+	}
+	log.Printf("執行 FFmpeg 黑畫面偵測: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("FFmpeg 黑畫面偵測錯誤: %v, output: %s", err, string(output))
+	}
+	return domain.ParseBlackDetect(string(output)), nil
+}
+
+// GeneratePreview 由 inputPath 的 start 處擷取 length 長的無聲低碼率 MP4，作為滑鼠移到縮圖上時的預覽
+func GeneratePreview(inputPath, outputPath string, start, length time.Duration) error {
+	cmdArgs := []string{
+		"-y",
+		"-ss", fmt.Sprintf("%.3f", start.Seconds()),
+		"-i", inputPath,
+		"-t", fmt.Sprintf("%.3f", length.Seconds()),
+		"-an",
+		"-vf", "scale=-2:240,fps=15",
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-crf", "32",
+		"-maxrate", "300k",
+		"-bufsize", "600k",
+		"-pix_fmt", "yuv420p",
+		"-movflags", "+faststart",
+		outputPath,
+	}
+	log.Printf("執行 FFmpeg 預覽短片: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("FFmpeg 預覽短片錯誤: %v, output: %s", err, string(output))
+	}
+	return nil
+}
+
+// TranscodeToMP4 將 inputPath 轉成高度不超過 height 的 MP4，moov atom 移到檔頭（faststart），下載後可邊下載邊播放
+func TranscodeToMP4(inputPath, outputPath string, height int) error {
+	cmdArgs := []string{
+		"-y",
+		"-i", inputPath,
+		"-vf", fmt.Sprintf("scale=-2:'min(%d,ih)'", height),
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-crf", "23",
+		"-pix_fmt", "yuv420p",
+		"-c:a", "aac",
+		"-b:a", "128k",
+		"-movflags", "+faststart",
+		outputPath,
+	}
+	log.Printf("執行 FFmpeg 下載檔案: ffmpeg %v", cmdArgs)
+	cmd := exec.Command("ffmpeg", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("FFmpeg 下載檔案錯誤: %v, output: %s", err, string(output))
+	}
+	return nil
+}
+
 // TranscodeToDASH 將 inputPath 轉成 DASH 格式，輸出到 outputDir（會產生 manifest.mpd）
 func TranscodeToDASH(inputPath, outputDir string) error {
 	outputMPD := fmt.Sprintf("%s/manifest.mpd", outputDir)
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 以 filesystem backend 取代 MinIO，上傳、列出、presign 下載都不需要容器
+func TestFileStorage(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	root := t.TempDir()
+
+	server := httptest.NewUnstartedServer(nil)
+	storage, err := database.NewFileStorage(root, "http://"+server.Listener.Addr().String()+"/storage", "secret")
+	assert.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.Handle("/storage/", http.StripPrefix("/storage", storage))
+	server.Config.Handler = mux
+	server.Start()
+	defer server.Close()
+
+	// **情境 1: UploadVideo 將原始影片寫入 original/{id}/，Content-Type 存在 sidecar**
+	t.Run("上傳影片", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*domain.Video).ID = 9
+		}).Return(nil).Once()
+		mockRepo.On("SaveWithEvents", mock.Anything).Return(nil).Once()
+
+		_, err := NewStreamingUseCase(storage, mockRepo, nil, nil).UploadVideo(domain.UploadVideoReq{
+			MemberID: "uploader",
+			Title:    "local",
+			FileName: "local.mp4",
+			Type:     domain.VideoTypeLong,
+			File:     bytes.NewReader([]byte("dummy video content")),
+		})
+		assert.NoError(t, err)
+
+		exists, err := storage.ObjectExists(ctx, "original/9/local.mp4")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		objects, err := storage.ListObjects(ctx, "original/9/")
+		assert.NoError(t, err)
+		assert.Len(t, objects, 1)
+		assert.Equal(t, "original/9/local.mp4", objects[0].Key)
+		assert.Equal(t, "video/mp4", objects[0].ContentType)
+		assert.Equal(t, int64(len("dummy video content")), objects[0].Size)
+	})
+
+	// **情境 2: 依 prefix 列出物件，key 排序與 MinIO 相同，不包含 sidecar**
+	t.Run("prefix 列出物件", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "segment")
+		assert.NoError(t, os.WriteFile(source, []byte("segment"), 0o644))
+		for _, key := range []string{"processed/1/index.m3u8", "processed/1/720p/segment_000.ts", "processed/10/index.m3u8"} {
+			assert.NoError(t, storage.UploadFile(ctx, key, source, "application/octet-stream"))
+		}
+
+		objects, err := storage.ListObjects(ctx, "processed/1/")
+		assert.NoError(t, err)
+		var keys []string
+		for _, object := range objects {
+			keys = append(keys, object.Key)
+		}
+		assert.Equal(t, []string{"processed/1/720p/segment_000.ts", "processed/1/index.m3u8"}, keys)
+
+		objects, err = storage.ListObjects(ctx, "processed/1")
+		assert.NoError(t, err)
+		assert.Len(t, objects, 3)
+
+		objects, err = storage.ListObjects(ctx, "missing/")
+		assert.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	// **情境 3: GetObject 支援 Range**
+	t.Run("讀取部分物件", func(t *testing.T) {
+		opts := minio.GetObjectOptions{}
+		assert.NoError(t, opts.SetRange(6, 10))
+		obj, err := storage.GetObject(ctx, "original/9/local.mp4", opts)
+		assert.NoError(t, err)
+		data, err := io.ReadAll(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, "video", string(data))
+	})
+
+	// **情境 4: presign URL 由 handler 提供下載，竄改或過期時拒絕**
+	t.Run("presign URL", func(t *testing.T) {
+		signedURL, err := storage.PresignGetURL(ctx, "original/9/local.mp4", time.Minute)
+		assert.NoError(t, err)
+
+		resp, err := http.Get(signedURL)
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "video/mp4", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "dummy video content", string(body))
+
+		resp, err = http.Get(signedURL + "0")
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		expiredURL, err := storage.PresignGetURL(ctx, "original/9/local.mp4", -time.Minute)
+		assert.NoError(t, err)
+		resp, err = http.Get(expiredURL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	// **情境 5: 拒絕跳出儲存目錄的 key**
+	t.Run("不合法的 key", func(t *testing.T) {
+		_, err := storage.ObjectExists(ctx, "../secret")
+		assert.ErrorIs(t, err, database.ErrInvalidObjectKey)
+	})
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// FollowUseCase 追蹤頻道（上傳者）
+type FollowUseCase interface {
+	FollowChannel(ctx context.Context, memberID, channelID string) error
+	UnfollowChannel(ctx context.Context, memberID, channelID string) error
+}
+
+type followUseCase struct {
+	FollowRepo repository.FollowRepo
+}
+
+// NewFollowUseCase 建立 FollowUseCase
+func NewFollowUseCase(followRepo repository.FollowRepo) FollowUseCase {
+	return &followUseCase{FollowRepo: followRepo}
+}
+
+// FollowChannel 追蹤頻道，不能追蹤自己
+func (f *followUseCase) FollowChannel(ctx context.Context, memberID, channelID string) error {
+	if memberID == "" {
+		return errprocess.Set("需登入才能追蹤頻道")
+	}
+	if channelID == "" || channelID == memberID {
+		errMsg := fmt.Sprintf("memberID[%s] 無法追蹤頻道: %s", memberID, channelID)
+		return errprocess.Set(errMsg)
+	}
+	if err := f.FollowRepo.Follow(memberID, channelID); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] channelID[%s] 追蹤頻道失敗: %v", memberID, channelID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// UnfollowChannel 取消追蹤頻道
+func (f *followUseCase) UnfollowChannel(ctx context.Context, memberID, channelID string) error {
+	if memberID == "" {
+		return errprocess.Set("需登入才能取消追蹤頻道")
+	}
+	if err := f.FollowRepo.Unfollow(memberID, channelID); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] channelID[%s] 取消追蹤頻道失敗: %v", memberID, channelID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package app
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/logger"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// HotObjectCache 熱門 HLS 物件快取：本機 LRU → Redis（選用）→ MinIO
+//   - 本機 LRU 以位元組數限制容量，播放清單與分段各自的 TTL 到期後重新讀取
+//   - 同一個 key 同時未命中時以 singleflight 合併，只讀取一次 MinIO
+//   - Redis 讀寫失敗時略過 Redis，不影響播放
+//
+// nil 的 *HotObjectCache 表示停用快取，每次都直接讀取
+type HotObjectCache struct {
+	options domain.HotObjectCacheOptions
+	shared  repository.HLSObjectCache // nil 表示不使用 Redis
+	group   singleflight.Group
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // 最近使用的在前面
+	bytes   int64
+
+	counters  map[domain.CachedObjectKind]*cacheCounters
+	evictions int64
+}
+
+type cacheEntry struct {
+	key       string
+	data      []byte
+	expiresAt time.Time
+}
+
+type cacheCounters struct {
+	localHits, sharedHits, misses, coalesced int64
+}
+
+// NewHotObjectCache create hot object cache，shared 為 nil 時只使用本機 LRU
+func NewHotObjectCache(options domain.HotObjectCacheOptions, shared repository.HLSObjectCache) *HotObjectCache {
+	return &HotObjectCache{
+		options: options,
+		shared:  shared,
+		now:     time.Now,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		counters: map[domain.CachedObjectKind]*cacheCounters{
+			domain.CachedPlaylist: {},
+			domain.CachedSegment:  {},
+		},
+	}
+}
+
+// Get 依序由本機 LRU、Redis 取得物件，都未命中時呼叫 load 並寫回快取
+// load 失敗時不快取，錯誤原樣回傳給所有等待同一個 key 的呼叫端
+func (c *HotObjectCache) Get(ctx context.Context, kind domain.CachedObjectKind, objectKey string,
+	load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if c == nil {
+		return load(ctx)
+	}
+	counters := c.counters[kind]
+	if data, ok := c.getLocal(objectKey); ok {
+		atomic.AddInt64(&counters.localHits, 1)
+		return data, nil
+	}
+
+	// 讀取結果由所有等待的呼叫端共用，不因第一個呼叫端斷線而取消
+	ctx = context.WithoutCancel(ctx)
+	result, err, shared := c.group.Do(objectKey, func() (interface{}, error) {
+		ttl := c.ttl(kind)
+		if c.shared != nil {
+			data, ok, err := c.shared.Get(ctx, objectKey)
+			if err != nil {
+				logger.Log.Errorf(fmt.Sprintf("objectKey[%s] 讀取 Redis 快取失敗:", objectKey), err)
+			}
+			if ok {
+				atomic.AddInt64(&counters.sharedHits, 1)
+				c.setLocal(objectKey, data, ttl)
+				return data, nil
+			}
+		}
+
+		data, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		atomic.AddInt64(&counters.misses, 1)
+		if c.cacheable(data) {
+			c.setLocal(objectKey, data, ttl)
+			if c.shared != nil {
+				if err := c.shared.Set(ctx, objectKey, data, ttl); err != nil {
+					logger.Log.Errorf(fmt.Sprintf("objectKey[%s] 寫入 Redis 快取失敗:", objectKey), err)
+				}
+			}
+		}
+		return data, nil
+	})
+	if shared {
+		atomic.AddInt64(&counters.coalesced, 1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
+func (c *HotObjectCache) ttl(kind domain.CachedObjectKind) time.Duration {
+	if kind == domain.CachedPlaylist {
+		return c.options.PlaylistTTL
+	}
+	return c.options.SegmentTTL
+}
+
+func (c *HotObjectCache) cacheable(data []byte) bool {
+	size := int64(len(data))
+	return size <= c.options.MaxObjectBytes && size <= c.options.MaxBytes
+}
+
+func (c *HotObjectCache) getLocal(objectKey string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[objectKey]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return entry.data, true
+}
+
+// setLocal 寫入本機 LRU，超過容量時由最久未使用的物件開始淘汰
+func (c *HotObjectCache) setLocal(objectKey string, data []byte, ttl time.Duration) {
+	if ttl <= 0 || !c.cacheable(data) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[objectKey]; ok {
+		c.remove(element)
+	}
+	c.entries[objectKey] = c.lru.PushFront(&cacheEntry{key: objectKey, data: data, expiresAt: c.now().Add(ttl)})
+	c.bytes += int64(len(data))
+	for c.bytes > c.options.MaxBytes {
+		c.remove(c.lru.Back())
+		c.evictions++
+	}
+}
+
+func (c *HotObjectCache) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= int64(len(entry.data))
+}
+
+// Stats 目前的命中次數與容量使用量
+func (c *HotObjectCache) Stats() domain.CacheStats {
+	if c == nil {
+		return domain.CacheStats{}
+	}
+	c.mu.Lock()
+	stats := domain.CacheStats{
+		Entries:   len(c.entries),
+		Bytes:     c.bytes,
+		MaxBytes:  c.options.MaxBytes,
+		Evictions: c.evictions,
+	}
+	c.mu.Unlock()
+	stats.Playlist = c.counters[domain.CachedPlaylist].snapshot()
+	stats.Segment = c.counters[domain.CachedSegment].snapshot()
+	return stats
+}
+
+func (c *cacheCounters) snapshot() domain.CacheCounters {
+	return domain.CacheCounters{
+		LocalHits:  atomic.LoadInt64(&c.localHits),
+		SharedHits: atomic.LoadInt64(&c.sharedHits),
+		Misses:     atomic.LoadInt64(&c.misses),
+		Coalesced:  atomic.LoadInt64(&c.coalesced),
+	}
+}
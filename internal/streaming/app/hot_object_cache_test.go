@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockHLSObjectCache 是 HLSObjectCache 的 Mock
+type MockHLSObjectCache struct {
+	mock.Mock
+}
+
+// Get 模擬由 Redis 取得物件
+func (m *MockHLSObjectCache) Get(ctx context.Context, objectKey string) ([]byte, bool, error) {
+	args := m.Called(objectKey)
+	data, _ := args.Get(0).([]byte)
+	return data, args.Bool(1), args.Error(2)
+}
+
+// Set 模擬寫入 Redis
+func (m *MockHLSObjectCache) Set(ctx context.Context, objectKey string, data []byte, ttl time.Duration) error {
+	args := m.Called(objectKey, data, ttl)
+	return args.Error(0)
+}
+
+func TestHotObjectCache(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	options := domain.HotObjectCacheOptions{
+		MaxBytes:       10,
+		MaxObjectBytes: 6,
+		PlaylistTTL:    2 * time.Second,
+		SegmentTTL:     time.Hour,
+	}
+	loader := func(data string, calls *int) func(ctx context.Context) ([]byte, error) {
+		return func(ctx context.Context) ([]byte, error) {
+			*calls++
+			return []byte(data), nil
+		}
+	}
+
+	// **情境 1: 第二次讀取由本機 LRU 命中，播放清單與分段使用不同的 TTL**
+	t.Run("TTL", func(t *testing.T) {
+		cache := NewHotObjectCache(options, nil)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+		playlistCalls, segmentCalls := 0, 0
+
+		for i := 0; i < 2; i++ {
+			data, err := cache.Get(ctx, domain.CachedPlaylist, "processed/1/index.m3u8", loader("m3u8", &playlistCalls))
+			assert.NoError(t, err)
+			assert.Equal(t, "m3u8", string(data))
+			_, err = cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", loader("ts", &segmentCalls))
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 1, playlistCalls)
+		assert.Equal(t, 1, segmentCalls)
+
+		// 播放清單過期，分段仍在快取中
+		now = now.Add(3 * time.Second)
+		_, _ = cache.Get(ctx, domain.CachedPlaylist, "processed/1/index.m3u8", loader("m3u8", &playlistCalls))
+		_, _ = cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", loader("ts", &segmentCalls))
+		assert.Equal(t, 2, playlistCalls)
+		assert.Equal(t, 1, segmentCalls)
+
+		stats := cache.Stats()
+		assert.Equal(t, domain.CacheCounters{LocalHits: 1, Misses: 2}, stats.Playlist)
+		assert.Equal(t, domain.CacheCounters{LocalHits: 2, Misses: 1}, stats.Segment)
+		assert.Equal(t, int64(6), stats.Bytes)
+	})
+
+	// **情境 2: 超過容量時淘汰最久未使用的物件，過大的物件不快取**
+	t.Run("容量上限", func(t *testing.T) {
+		cache := NewHotObjectCache(options, nil)
+		calls := 0
+		_, _ = cache.Get(ctx, domain.CachedSegment, "a", loader("aaaa", &calls))
+		_, _ = cache.Get(ctx, domain.CachedSegment, "b", loader("bbbb", &calls))
+		_, _ = cache.Get(ctx, domain.CachedSegment, "a", loader("aaaa", &calls)) // a 變成最近使用
+		_, _ = cache.Get(ctx, domain.CachedSegment, "c", loader("cccc", &calls)) // 淘汰 b
+		assert.Equal(t, 3, calls)
+
+		_, _ = cache.Get(ctx, domain.CachedSegment, "a", loader("aaaa", &calls))
+		assert.Equal(t, 3, calls)
+		_, _ = cache.Get(ctx, domain.CachedSegment, "b", loader("bbbb", &calls))
+		assert.Equal(t, 4, calls)
+
+		_, _ = cache.Get(ctx, domain.CachedSegment, "big", loader("0123456789", &calls))
+		_, _ = cache.Get(ctx, domain.CachedSegment, "big", loader("0123456789", &calls))
+		assert.Equal(t, 6, calls)
+
+		stats := cache.Stats()
+		assert.Equal(t, int64(8), stats.Bytes)
+		assert.Equal(t, 2, stats.Entries)
+		assert.Equal(t, int64(2), stats.Evictions)
+	})
+
+	// **情境 3: 同時未命中的讀取以 singleflight 合併**
+	t.Run("singleflight", func(t *testing.T) {
+		cache := NewHotObjectCache(options, nil)
+		release := make(chan struct{})
+		var mu sync.Mutex
+		calls := 0
+		load := func(ctx context.Context) ([]byte, error) {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			<-release
+			return []byte("ts"), nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				data, err := cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", load)
+				assert.NoError(t, err)
+				assert.Equal(t, "ts", string(data))
+			}()
+		}
+		// 等待其餘呼叫端進入 singleflight 後才完成讀取
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls == 1
+		}, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, 1, calls)
+		stats := cache.Stats().Segment
+		assert.Equal(t, int64(1), stats.Misses)
+		assert.Equal(t, int64(10), stats.LocalHits+stats.Misses+stats.Coalesced-1)
+	})
+
+	// **情境 4: 本機未命中時由 Redis 取得，Redis 也未命中時寫回 Redis**
+	t.Run("Redis", func(t *testing.T) {
+		shared := new(MockHLSObjectCache)
+		cache := NewHotObjectCache(options, shared)
+		calls := 0
+
+		shared.On("Get", "processed/1/index0.ts").Return([]byte("ts"), true, nil).Once()
+		shared.On("Get", "processed/1/index1.ts").Return(nil, false, nil).Once()
+		shared.On("Set", "processed/1/index1.ts", []byte("ts1"), time.Hour).Return(nil).Once()
+		shared.On("Get", "processed/1/index2.ts").Return(nil, false, errors.New("redis down")).Once()
+		shared.On("Set", "processed/1/index2.ts", []byte("ts2"), time.Hour).Return(errors.New("redis down")).Once()
+
+		data, err := cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", loader("ts", &calls))
+		assert.NoError(t, err)
+		assert.Equal(t, "ts", string(data))
+		assert.Equal(t, 0, calls)
+
+		_, err = cache.Get(ctx, domain.CachedSegment, "processed/1/index1.ts", loader("ts1", &calls))
+		assert.NoError(t, err)
+		// Redis 失敗時照常由 MinIO 讀取
+		data, err = cache.Get(ctx, domain.CachedSegment, "processed/1/index2.ts", loader("ts2", &calls))
+		assert.NoError(t, err)
+		assert.Equal(t, "ts2", string(data))
+		assert.Equal(t, 2, calls)
+
+		assert.Equal(t, domain.CacheCounters{SharedHits: 1, Misses: 2}, cache.Stats().Segment)
+		shared.AssertExpectations(t)
+	})
+
+	// **情境 5: 讀取失敗時不快取**
+	t.Run("讀取失敗", func(t *testing.T) {
+		cache := NewHotObjectCache(options, nil)
+		calls := 0
+		failing := func(ctx context.Context) ([]byte, error) {
+			calls++
+			return nil, errors.New("minio down")
+		}
+
+		_, err := cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", failing)
+		assert.Error(t, err)
+		_, err = cache.Get(ctx, domain.CachedSegment, "processed/1/index0.ts", failing)
+		assert.Error(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, 0, cache.Stats().Entries)
+	})
+
+	// **情境 6: GetHlsSegment 第二次讀取不再呼叫 MinIO**
+	t.Run("GetHlsSegment", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, NewHotObjectCache(options, nil), nil)
+
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, Visibility: string(domain.VisibilityPublic)}, nil).Twice()
+		mockMinIO.On("GetObject", mock.Anything, "processed/1/index0.ts", minio.GetObjectOptions{}).
+			Return(strings.NewReader("ts"), nil).Once()
+
+		for i := 0; i < 2; i++ {
+			data, err := usecase.GetHlsSegment(ctx, "1", "index0.ts", domain.Viewer{})
+			assert.NoError(t, err)
+			assert.Equal(t, "ts", string(data))
+		}
+		mockMinIO.AssertExpectations(t)
+	})
+}
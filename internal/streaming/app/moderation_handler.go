@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// ReportVideo 實作 檢舉影片
+func (s *StreamingGRPCServer) ReportVideo(ctx context.Context, req *streaming_pb.ReportVideoReq) (*streaming_pb.ReportVideoRes, error) {
+	report, err := s.ModerationUsecase.ReportVideo(ctx, domain.ReportVideoReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Reason:   domain.ReportReason(req.Reason),
+		Detail:   req.Detail,
+	})
+	if err != nil {
+		return &streaming_pb.ReportVideoRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReportVideoRes{
+		Success:  true,
+		ReportId: uint64(report.ID),
+	}, nil
+}
+
+// ListReports 實作 列出審核佇列
+func (s *StreamingGRPCServer) ListReports(ctx context.Context, req *streaming_pb.ListReportsReq) (*streaming_pb.ListReportsRes, error) {
+	reports, total, err := s.ModerationUsecase.ListReports(ctx, domain.ListReportsReq{
+		Role:    req.Role,
+		Status:  domain.ReportStatus(req.Status),
+		VideoID: uint(req.VideoId),
+		Page:    domain.Pagination{Page: int(req.Page), PageSize: int(req.PageSize)},
+	})
+	if err != nil {
+		return &streaming_pb.ListReportsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	items := make([]*streaming_pb.VideoReport, len(reports))
+	for index := range reports {
+		items[index] = toVideoReportPb(&reports[index])
+	}
+	return &streaming_pb.ListReportsRes{
+		Success: true,
+		Reports: items,
+		Total:   total,
+	}, nil
+}
+
+// ClaimReport 實作 認領檢舉
+func (s *StreamingGRPCServer) ClaimReport(ctx context.Context, req *streaming_pb.ClaimReportReq) (*streaming_pb.ReportRes, error) {
+	report, err := s.ModerationUsecase.ClaimReport(ctx, domain.ClaimReportReq{
+		ReportID: uint(req.ReportId),
+		MemberID: req.MemberId,
+		Role:     req.Role,
+	})
+	if err != nil {
+		return &streaming_pb.ReportRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReportRes{
+		Success: true,
+		Report:  toVideoReportPb(report),
+	}, nil
+}
+
+// ResolveReport 實作 處理檢舉
+func (s *StreamingGRPCServer) ResolveReport(ctx context.Context, req *streaming_pb.ResolveReportReq) (*streaming_pb.ReportRes, error) {
+	report, err := s.ModerationUsecase.ResolveReport(ctx, domain.ResolveReportReq{
+		ReportID: uint(req.ReportId),
+		MemberID: req.MemberId,
+		Role:     req.Role,
+		Action:   domain.ModerationAction(req.Action),
+		Note:     req.Note,
+	})
+	if err != nil {
+		return &streaming_pb.ReportRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReportRes{
+		Success: true,
+		Report:  toVideoReportPb(report),
+	}, nil
+}
+
+// ListModerationAudit 實作 列出審核稽核紀錄
+func (s *StreamingGRPCServer) ListModerationAudit(ctx context.Context, req *streaming_pb.ListModerationAuditReq) (*streaming_pb.ListModerationAuditRes, error) {
+	audits, total, err := s.ModerationUsecase.ListModerationAudit(ctx, domain.ListModerationAuditReq{
+		Role:     req.Role,
+		VideoID:  uint(req.VideoId),
+		ReportID: uint(req.ReportId),
+		Page:     domain.Pagination{Page: int(req.Page), PageSize: int(req.PageSize)},
+	})
+	if err != nil {
+		return &streaming_pb.ListModerationAuditRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	items := make([]*streaming_pb.ModerationAudit, len(audits))
+	for index, audit := range audits {
+		items[index] = &streaming_pb.ModerationAudit{
+			Id:        uint64(audit.ID),
+			ReportId:  uint64(audit.ReportID),
+			VideoId:   uint64(audit.VideoID),
+			ActorId:   audit.ActorID,
+			Action:    audit.Action,
+			Note:      audit.Note,
+			CreatedAt: audit.CreatedAt.Unix(),
+		}
+	}
+	return &streaming_pb.ListModerationAuditRes{
+		Success: true,
+		Audits:  items,
+		Total:   total,
+	}, nil
+}
+
+// SetContentRating 實作 設定影片分級
+func (s *StreamingGRPCServer) SetContentRating(ctx context.Context, req *streaming_pb.SetContentRatingReq) (*streaming_pb.SetContentRatingRes, error) {
+	video, err := s.ModerationUsecase.SetContentRating(ctx, domain.SetContentRatingReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Role:     req.Role,
+		Rating:   domain.ContentRating(req.ContentRating),
+		Note:     req.Note,
+	})
+	if err != nil {
+		return &streaming_pb.SetContentRatingRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.SetContentRatingRes{
+		Success:       true,
+		ContentRating: string(video.Rating()),
+		RatingLocked:  video.RatingLocked,
+	}, nil
+}
+
+func toVideoReportPb(report *domain.VideoReport) *streaming_pb.VideoReport {
+	item := &streaming_pb.VideoReport{
+		Id:         uint64(report.ID),
+		VideoId:    uint64(report.VideoID),
+		ReporterId: report.ReporterID,
+		Reason:     report.Reason,
+		Detail:     report.Detail,
+		Status:     report.Status,
+		ClaimedBy:  report.ClaimedBy,
+		Resolution: report.Resolution,
+		ResolvedBy: report.ResolvedBy,
+		CreatedAt:  report.CreatedAt.Unix(),
+	}
+	if report.ClaimedAt != nil {
+		item.ClaimedAt = report.ClaimedAt.Unix()
+	}
+	if report.ResolvedAt != nil {
+		item.ResolvedAt = report.ResolvedAt.Unix()
+	}
+	return item
+}
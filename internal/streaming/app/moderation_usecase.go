@@ -0,0 +1,277 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+	"streaming_video_service/pkg/token"
+)
+
+// ModerationUseCase 影片檢舉與審核：會員檢舉影片，管理員由審核佇列認領並處理，每個動作寫入稽核紀錄
+type ModerationUseCase interface {
+	ReportVideo(ctx context.Context, req domain.ReportVideoReq) (*domain.VideoReport, error)
+	ListReports(ctx context.Context, req domain.ListReportsReq) ([]domain.VideoReport, int64, error)
+	ClaimReport(ctx context.Context, req domain.ClaimReportReq) (*domain.VideoReport, error)
+	ResolveReport(ctx context.Context, req domain.ResolveReportReq) (*domain.VideoReport, error)
+	ListModerationAudit(ctx context.Context, req domain.ListModerationAuditReq) ([]domain.ModerationAudit, int64, error)
+	SetContentRating(ctx context.Context, req domain.SetContentRatingReq) (*domain.Video, error)
+}
+
+type moderationUseCase struct {
+	ModerationRepo repository.ModerationRepo
+	VideoRepo      repository.VideoRepo
+	MinioClient    database.MinIOClientRepo
+}
+
+// NewModerationUseCase 建立 ModerationUseCase
+func NewModerationUseCase(moderationRepo repository.ModerationRepo, videoRepo repository.VideoRepo,
+	minioClient database.MinIOClientRepo) ModerationUseCase {
+	return &moderationUseCase{
+		ModerationRepo: moderationRepo,
+		VideoRepo:      videoRepo,
+		MinioClient:    minioClient,
+	}
+}
+
+// ReportVideo 會員檢舉可觀看的影片，不可檢舉自己的影片，同一部影片未處理前不可重複檢舉
+func (m *moderationUseCase) ReportVideo(ctx context.Context, req domain.ReportVideoReq) (*domain.VideoReport, error) {
+	if req.MemberID == "" {
+		return nil, errprocess.Set("需登入才能檢舉影片")
+	}
+	if err := req.Validate(); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	id, _ := strconv.Atoi(req.VideoID)
+	video, err := m.VideoRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if video.IsOwner(req.MemberID) {
+		errMsg := fmt.Sprintf("videoID[%s] 不可檢舉自己的影片", req.VideoID)
+		return nil, errprocess.Set(errMsg)
+	}
+	ok, err := canWatch(m.VideoRepo, video, req.MemberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 查詢分享名單失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !ok {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 無權限觀看此影片", req.VideoID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	pending, err := m.ModerationRepo.HasPendingReport(video.ID, req.MemberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 查詢檢舉紀錄失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if pending {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 已檢舉過此影片，等待處理中", req.VideoID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	report := &domain.VideoReport{
+		VideoID:    video.ID,
+		ReporterID: req.MemberID,
+		Reason:     string(req.Reason),
+		Detail:     req.Detail,
+		Status:     string(domain.ReportOpen),
+	}
+	if err := m.ModerationRepo.CreateReport(report); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 新增檢舉失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return report, nil
+}
+
+// ListReports 管理員分頁列出審核佇列，未指定狀態時列出 open 的檢舉，最早的排在最前面
+func (m *moderationUseCase) ListReports(ctx context.Context, req domain.ListReportsReq) ([]domain.VideoReport, int64, error) {
+	if req.Role != string(token.RoleAdmin) {
+		return nil, 0, errprocess.Set("僅管理員可查看檢舉")
+	}
+	status := req.Status
+	if status == "" {
+		status = domain.ReportOpen
+	}
+	if !status.IsValid() {
+		errMsg := fmt.Sprintf("不支援的檢舉狀態: %s", status)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+
+	page := req.Page.Normalize()
+	reports, total, err := m.ModerationRepo.ListReports(status, req.VideoID, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("取得檢舉列表失敗: %v", err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return reports, total, nil
+}
+
+// ClaimReport 管理員認領檢舉，認領後其他管理員在 ReportClaimTimeout 內無法認領
+func (m *moderationUseCase) ClaimReport(ctx context.Context, req domain.ClaimReportReq) (*domain.VideoReport, error) {
+	if req.Role != string(token.RoleAdmin) || req.MemberID == "" {
+		return nil, errprocess.Set("僅管理員可認領檢舉")
+	}
+
+	claimed, err := m.ModerationRepo.ClaimReport(req.ReportID, req.MemberID, time.Now())
+	if err != nil {
+		errMsg := fmt.Sprintf("reportID[%d] 認領檢舉失敗: %v", req.ReportID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !claimed {
+		errMsg := fmt.Sprintf("reportID[%d] 檢舉不存在、已處理或已被其他管理員認領", req.ReportID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	report, err := m.ModerationRepo.GetReport(req.ReportID)
+	if err != nil {
+		errMsg := fmt.Sprintf("reportID[%d] 找不到檢舉: %v", req.ReportID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return report, nil
+}
+
+// ResolveReport 管理員處理自己認領的檢舉
+//   - dismiss：只結案，不變更影片
+//   - age_restrict：分級改為限制級並鎖定
+//   - age_restrict / hide / remove：變更影片，同一部影片其他未處理的檢舉一併結案
+func (m *moderationUseCase) ResolveReport(ctx context.Context, req domain.ResolveReportReq) (*domain.VideoReport, error) {
+	if req.Role != string(token.RoleAdmin) || req.MemberID == "" {
+		return nil, errprocess.Set("僅管理員可處理檢舉")
+	}
+	if err := req.Validate(); err != nil {
+		errMsg := fmt.Sprintf("reportID[%d] %v", req.ReportID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	report, err := m.ModerationRepo.GetReport(req.ReportID)
+	if err != nil {
+		errMsg := fmt.Sprintf("reportID[%d] 找不到檢舉: %v", req.ReportID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if report.Status != string(domain.ReportClaimed) || report.ClaimedBy != req.MemberID {
+		errMsg := fmt.Sprintf("reportID[%d] memberID[%s] 需先認領檢舉才能處理", req.ReportID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	var video *domain.Video
+	if req.Action != domain.ModerationDismiss {
+		if video, err = m.VideoRepo.GetByID(report.VideoID); err != nil {
+			errMsg := fmt.Sprintf("reportID[%d] videoID[%d] 找不到影片: %v", req.ReportID, report.VideoID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		req.Action.Apply(video)
+	}
+
+	now := time.Now()
+	report.Status = string(domain.ReportResolved)
+	report.Resolution = string(req.Action)
+	report.ResolvedBy = req.MemberID
+	report.ResolvedAt = &now
+	clips, err := m.ModerationRepo.ResolveReport(report, video, req.Note)
+	if err != nil {
+		if errors.Is(err, repository.ErrReportNotClaimed) {
+			errMsg := fmt.Sprintf("reportID[%d] memberID[%s] 認領已逾時並被其他管理員認領", req.ReportID, req.MemberID)
+			return nil, errprocess.Set(errMsg)
+		}
+		errMsg := fmt.Sprintf("reportID[%d] 處理檢舉失敗: %v", req.ReportID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	logger.Log.Info(fmt.Sprintf("reportID[%d] videoID[%d] 管理員[%s] 處理檢舉: %s",
+		report.ID, report.VideoID, req.MemberID, req.Action))
+	if video != nil {
+		revokePublicCopy(ctx, m.MinioClient, m.VideoRepo, video)
+	}
+	m.revokeClipPublicCopies(ctx, clips)
+	return report, nil
+}
+
+// ListModerationAudit 管理員分頁查看影片或檢舉的稽核紀錄，最新的排在最前面
+func (m *moderationUseCase) ListModerationAudit(ctx context.Context, req domain.ListModerationAuditReq) ([]domain.ModerationAudit, int64, error) {
+	if req.Role != string(token.RoleAdmin) {
+		return nil, 0, errprocess.Set("僅管理員可查看稽核紀錄")
+	}
+	if req.VideoID == 0 && req.ReportID == 0 {
+		return nil, 0, errprocess.Set("需指定影片或檢舉")
+	}
+
+	page := req.Page.Normalize()
+	audits, total, err := m.ModerationRepo.ListAudits(req.VideoID, req.ReportID, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] reportID[%d] 取得稽核紀錄失敗: %v", req.VideoID, req.ReportID, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return audits, total, nil
+}
+
+// SetContentRating 設定影片分級
+//   - 管理員：覆寫分級並鎖定，寫入稽核紀錄
+//   - 上傳者：分級未被管理員鎖定時可修改
+func (m *moderationUseCase) SetContentRating(ctx context.Context, req domain.SetContentRatingReq) (*domain.Video, error) {
+	if req.MemberID == "" {
+		return nil, errprocess.Set("需登入才能設定分級")
+	}
+	if err := req.Validate(); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	id, _ := strconv.Atoi(req.VideoID)
+	video, err := m.VideoRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	var audit *domain.ModerationAudit
+	switch {
+	case req.Role == string(token.RoleAdmin):
+		note := fmt.Sprintf("%s -> %s", video.Rating(), req.Rating)
+		if req.Note != "" {
+			note += ": " + req.Note
+		}
+		audit = &domain.ModerationAudit{
+			VideoID:   video.ID,
+			ActorID:   req.MemberID,
+			Action:    string(domain.ModerationRate),
+			Note:      note,
+			CreatedAt: time.Now(),
+		}
+		video.RatingLocked = true
+	case !video.IsOwner(req.MemberID):
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", req.VideoID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	case video.RatingLocked:
+		errMsg := fmt.Sprintf("videoID[%s] 分級已由管理員鎖定，無法修改", req.VideoID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	video.ContentRating = string(req.Rating)
+	clips, err := m.ModerationRepo.SetRating(video, audit)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 更新分級失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	revokePublicCopy(ctx, m.MinioClient, m.VideoRepo, video)
+	m.revokeClipPublicCopies(ctx, clips)
+	return video, nil
+}
+
+// revokeClipPublicCopies 審核結果套用到片段後，刪除不再可公開提供的片段副本
+func (m *moderationUseCase) revokeClipPublicCopies(ctx context.Context, clips []domain.Video) {
+	for index := range clips {
+		revokePublicCopy(ctx, m.MinioClient, m.VideoRepo, &clips[index])
+	}
+}
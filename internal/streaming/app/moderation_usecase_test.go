@@ -0,0 +1,445 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockModerationRepo 是 ModerationRepo 的 Mock
+type MockModerationRepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockModerationRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// CreateReport 模擬新增檢舉
+func (m *MockModerationRepo) CreateReport(report *domain.VideoReport) error {
+	args := m.Called(report)
+	return args.Error(0)
+}
+
+// HasPendingReport 模擬查詢未處理的檢舉
+func (m *MockModerationRepo) HasPendingReport(videoID uint, reporterID string) (bool, error) {
+	args := m.Called(videoID, reporterID)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetReport 模擬取得檢舉
+func (m *MockModerationRepo) GetReport(id uint) (*domain.VideoReport, error) {
+	args := m.Called(id)
+	report, _ := args.Get(0).(*domain.VideoReport)
+	return report, args.Error(1)
+}
+
+// ListReports 模擬列出檢舉
+func (m *MockModerationRepo) ListReports(status domain.ReportStatus, videoID uint, offset, limit int) ([]domain.VideoReport, int64, error) {
+	args := m.Called(status, videoID, offset, limit)
+	reports, _ := args.Get(0).([]domain.VideoReport)
+	return reports, args.Get(1).(int64), args.Error(2)
+}
+
+// ClaimReport 模擬認領檢舉
+func (m *MockModerationRepo) ClaimReport(id uint, memberID string, now time.Time) (bool, error) {
+	args := m.Called(id, memberID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+// ResolveReport 模擬處理檢舉
+func (m *MockModerationRepo) ResolveReport(report *domain.VideoReport, video *domain.Video, note string) ([]domain.Video, error) {
+	args := m.Called(report, video, note)
+	clips, _ := args.Get(0).([]domain.Video)
+	return clips, args.Error(1)
+}
+
+// ListAudits 模擬列出稽核紀錄
+func (m *MockModerationRepo) ListAudits(videoID, reportID uint, offset, limit int) ([]domain.ModerationAudit, int64, error) {
+	args := m.Called(videoID, reportID, offset, limit)
+	audits, _ := args.Get(0).([]domain.ModerationAudit)
+	return audits, args.Get(1).(int64), args.Error(2)
+}
+
+// SetRating 模擬更新影片分級
+func (m *MockModerationRepo) SetRating(video *domain.Video, audit *domain.ModerationAudit) ([]domain.Video, error) {
+	args := m.Called(video, audit)
+	clips, _ := args.Get(0).([]domain.Video)
+	return clips, args.Error(1)
+}
+
+func TestReportVideo(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	video := &domain.Video{ID: 7, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+
+	// **情境 1: 檢舉成功，狀態為 open**
+	t.Run("檢舉影片", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, new(MockMinIOClient))
+
+		mockVideoRepo.On("GetByID", uint(7)).Return(video, nil).Once()
+		mockRepo.On("HasPendingReport", uint(7), "viewer").Return(false, nil).Once()
+		mockRepo.On("CreateReport", mock.MatchedBy(func(report *domain.VideoReport) bool {
+			return report.VideoID == 7 && report.ReporterID == "viewer" &&
+				report.Reason == string(domain.ReportSpam) && report.Status == string(domain.ReportOpen)
+		})).Return(nil).Once()
+
+		report, err := usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", MemberID: "viewer", Reason: domain.ReportSpam})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(7), report.VideoID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 原因不合法、other 未附說明、未登入、檢舉自己的影片、重複檢舉皆拒絕**
+	t.Run("拒絕檢舉", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, new(MockMinIOClient))
+		mockVideoRepo.On("GetByID", uint(7)).Return(video, nil)
+		mockRepo.On("HasPendingReport", uint(7), "viewer").Return(true, nil).Once()
+
+		_, err := usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", MemberID: "viewer", Reason: "boring"})
+		assert.Error(t, err)
+		_, err = usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", MemberID: "viewer", Reason: domain.ReportOther})
+		assert.Error(t, err)
+		_, err = usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", Reason: domain.ReportSpam})
+		assert.Error(t, err)
+		_, err = usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", MemberID: "owner", Reason: domain.ReportSpam})
+		assert.Error(t, err)
+		_, err = usecase.ReportVideo(ctx, domain.ReportVideoReq{VideoID: "7", MemberID: "viewer", Reason: domain.ReportSpam})
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "CreateReport", mock.Anything)
+	})
+}
+
+func TestModerationQueue(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 非管理員無法列出、認領、處理檢舉與查看稽核紀錄**
+	t.Run("僅管理員", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		usecase := NewModerationUseCase(mockRepo, new(MockVideoRepo), new(MockMinIOClient))
+
+		_, _, err := usecase.ListReports(ctx, domain.ListReportsReq{Role: "member"})
+		assert.Error(t, err)
+		_, err = usecase.ClaimReport(ctx, domain.ClaimReportReq{ReportID: 1, MemberID: "viewer", Role: "member"})
+		assert.Error(t, err)
+		_, err = usecase.ResolveReport(ctx, domain.ResolveReportReq{ReportID: 1, MemberID: "viewer", Role: "member", Action: domain.ModerationRemove})
+		assert.Error(t, err)
+		_, _, err = usecase.ListModerationAudit(ctx, domain.ListModerationAuditReq{Role: "member", VideoID: 7})
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 未指定狀態時列出 open 的檢舉**
+	t.Run("列出檢舉", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		usecase := NewModerationUseCase(mockRepo, new(MockVideoRepo), new(MockMinIOClient))
+		mockRepo.On("ListReports", domain.ReportOpen, uint(0), 0, domain.DefaultPageSize).
+			Return([]domain.VideoReport{{ID: 1}}, int64(1), nil).Once()
+
+		reports, total, err := usecase.ListReports(ctx, domain.ListReportsReq{Role: "admin"})
+		assert.NoError(t, err)
+		assert.Len(t, reports, 1)
+		assert.Equal(t, int64(1), total)
+
+		_, _, err = usecase.ListReports(ctx, domain.ListReportsReq{Role: "admin", Status: "closed"})
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 已被其他管理員認領時認領失敗**
+	t.Run("認領", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		usecase := NewModerationUseCase(mockRepo, new(MockVideoRepo), new(MockMinIOClient))
+		mockRepo.On("ClaimReport", uint(1), "staff", mock.Anything).Return(true, nil).Once()
+		mockRepo.On("GetReport", uint(1)).Return(&domain.VideoReport{ID: 1, Status: string(domain.ReportClaimed), ClaimedBy: "staff"}, nil).Once()
+		mockRepo.On("ClaimReport", uint(2), "staff", mock.Anything).Return(false, nil).Once()
+
+		report, err := usecase.ClaimReport(ctx, domain.ClaimReportReq{ReportID: 1, MemberID: "staff", Role: "admin"})
+		assert.NoError(t, err)
+		assert.Equal(t, "staff", report.ClaimedBy)
+
+		_, err = usecase.ClaimReport(ctx, domain.ClaimReportReq{ReportID: 2, MemberID: "staff", Role: "admin"})
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: remove 將影片改為 blocked，dismiss 不變更影片**
+	t.Run("處理檢舉", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, new(MockMinIOClient))
+
+		mockRepo.On("GetReport", uint(1)).Return(&domain.VideoReport{ID: 1, VideoID: 7, Status: string(domain.ReportClaimed), ClaimedBy: "staff"}, nil).Once()
+		mockVideoRepo.On("GetByID", uint(7)).Return(&domain.Video{ID: 7, Status: string(domain.VideoReady)}, nil).Once()
+		mockRepo.On("ResolveReport", mock.MatchedBy(func(report *domain.VideoReport) bool {
+			return report.Status == string(domain.ReportResolved) && report.Resolution == string(domain.ModerationRemove) &&
+				report.ResolvedBy == "staff" && report.ResolvedAt != nil
+		}), mock.MatchedBy(func(video *domain.Video) bool {
+			return video.IsBlocked()
+		}), "copyright strike").Return(nil, nil).Once()
+
+		report, err := usecase.ResolveReport(ctx, domain.ResolveReportReq{
+			ReportID: 1, MemberID: "staff", Role: "admin", Action: domain.ModerationRemove, Note: "copyright strike",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, string(domain.ModerationRemove), report.Resolution)
+
+		mockRepo.On("GetReport", uint(2)).Return(&domain.VideoReport{ID: 2, VideoID: 7, Status: string(domain.ReportClaimed), ClaimedBy: "staff"}, nil).Once()
+		mockRepo.On("ResolveReport", mock.Anything, (*domain.Video)(nil), "").Return(nil, nil).Once()
+		_, err = usecase.ResolveReport(ctx, domain.ResolveReportReq{ReportID: 2, MemberID: "staff", Role: "admin", Action: domain.ModerationDismiss})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
+	})
+
+	// **情境 5: 未認領、由其他管理員認領或認領逾時被搶走時無法處理**
+	t.Run("需先認領", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		usecase := NewModerationUseCase(mockRepo, new(MockVideoRepo), new(MockMinIOClient))
+		mockRepo.On("GetReport", uint(1)).Return(&domain.VideoReport{ID: 1, Status: string(domain.ReportOpen)}, nil).Once()
+		mockRepo.On("GetReport", uint(2)).Return(&domain.VideoReport{ID: 2, Status: string(domain.ReportClaimed), ClaimedBy: "other"}, nil).Once()
+		mockRepo.On("GetReport", uint(3)).Return(&domain.VideoReport{ID: 3, Status: string(domain.ReportClaimed), ClaimedBy: "staff"}, nil).Once()
+		mockRepo.On("ResolveReport", mock.Anything, (*domain.Video)(nil), "").Return(nil, repository.ErrReportNotClaimed).Once()
+
+		for _, id := range []uint{1, 2, 3} {
+			_, err := usecase.ResolveReport(ctx, domain.ResolveReportReq{ReportID: id, MemberID: "staff", Role: "admin", Action: domain.ModerationDismiss})
+			assert.Error(t, err)
+		}
+		_, err := usecase.ResolveReport(ctx, domain.ResolveReportReq{ReportID: 1, MemberID: "staff", Role: "admin", Action: "delete"})
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 6: 隱藏影片時由影片剪出的片段一併隱藏，並刪除片段的公開副本**
+	t.Run("片段一併隱藏", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, mockMinIO)
+		clip := domain.Video{ID: 9, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), Hidden: true, PublicCopy: true}
+
+		mockRepo.On("GetReport", uint(1)).Return(&domain.VideoReport{ID: 1, VideoID: 7, Status: string(domain.ReportClaimed), ClaimedBy: "staff"}, nil).Once()
+		mockVideoRepo.On("GetByID", uint(7)).Return(&domain.Video{ID: 7, Status: string(domain.VideoReady)}, nil).Once()
+		mockRepo.On("ResolveReport", mock.Anything, mock.MatchedBy(func(video *domain.Video) bool {
+			return video.Hidden
+		}), "").Return([]domain.Video{clip}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "public/9/").Return([]database.ObjectInfo{{Key: "public/9/index.m3u8"}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "public/9/index.m3u8").Return(nil).Once()
+		mockVideoRepo.On("SetPublicCopy", uint(9), false).Return(nil).Once()
+
+		_, err := usecase.ResolveReport(ctx, domain.ResolveReportReq{ReportID: 1, MemberID: "staff", Role: "admin", Action: domain.ModerationHide})
+
+		assert.NoError(t, err)
+		mockMinIO.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
+	})
+}
+
+func TestModeratedVideoPlayback(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: blocked 影片任何人（含上傳者）都無法取得影片與 HLS**
+	t.Run("blocked", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockVideoRepo, nil, nil)
+		mockVideoRepo.On("GetByID", uint(7)).Return(&domain.Video{
+			ID: 7, MemberID: "owner", Status: string(domain.VideoBlocked), Visibility: string(domain.VisibilityPublic),
+		}, nil)
+
+		_, err := usecase.GetVideo("7", domain.Viewer{MemberID: "owner"}, "")
+		assert.Error(t, err)
+		_, err = usecase.GetIndexM3U8(ctx, "7", domain.Viewer{MemberID: "owner"})
+		assert.Error(t, err)
+		_, err = usecase.GetHlsSegment(ctx, "7", "index0.ts", domain.Viewer{MemberID: "viewer"})
+		assert.Error(t, err)
+	})
+
+	// **情境 2: 隱藏的影片僅上傳者可觀看，分級限制的影片需登入，兩者都只由 gateway 播放**
+	t.Run("隱藏與分級限制", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		hidden := &domain.Video{ID: 8, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), Hidden: true}
+		restricted := &domain.Video{ID: 9, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), ContentRating: string(domain.RatingAdult)}
+
+		for _, video := range []*domain.Video{hidden, restricted} {
+			assert.False(t, video.IsPubliclyServable())
+		}
+		assert.False(t, hidden.IsListed())
+
+		ok, err := canWatch(mockVideoRepo, hidden, "viewer")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		ok, _ = canWatch(mockVideoRepo, hidden, "owner")
+		assert.True(t, ok)
+		ok, _ = canWatch(mockVideoRepo, restricted, "")
+		assert.False(t, ok)
+		ok, _ = canWatch(mockVideoRepo, restricted, "viewer")
+		assert.True(t, ok)
+	})
+}
+
+func TestSetContentRating(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	newVideo := func(locked bool) *domain.Video {
+		return &domain.Video{ID: 7, MemberID: "owner", Status: string(domain.VideoReady), ContentRating: string(domain.RatingAll), RatingLocked: locked}
+	}
+
+	// **情境 1: 上傳者修改未鎖定的分級，不寫入稽核紀錄**
+	t.Run("上傳者設定分級", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, new(MockMinIOClient))
+		mockVideoRepo.On("GetByID", uint(7)).Return(newVideo(false), nil).Once()
+		mockRepo.On("SetRating", mock.MatchedBy(func(video *domain.Video) bool {
+			return video.Rating() == domain.RatingTeen && !video.RatingLocked
+		}), (*domain.ModerationAudit)(nil)).Return(nil, nil).Once()
+
+		video, err := usecase.SetContentRating(ctx, domain.SetContentRatingReq{VideoID: "7", MemberID: "owner", Role: "member", Rating: domain.RatingTeen})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RatingTeen, video.Rating())
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 管理員覆寫分級後鎖定，並寫入稽核紀錄；片段沿用分級並刪除公開副本**
+	t.Run("管理員覆寫分級", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, mockMinIO)
+		clip := domain.Video{ID: 9, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic),
+			ContentRating: string(domain.RatingAdult), RatingLocked: true, PublicCopy: true}
+		mockMinIO.On("ListObjects", ctx, "public/9/").Return([]database.ObjectInfo{{Key: "public/9/index.m3u8"}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "public/9/index.m3u8").Return(nil).Once()
+		mockVideoRepo.On("SetPublicCopy", uint(9), false).Return(nil).Once()
+		mockVideoRepo.On("GetByID", uint(7)).Return(newVideo(false), nil).Once()
+		mockRepo.On("SetRating", mock.MatchedBy(func(video *domain.Video) bool {
+			return video.Rating() == domain.RatingAdult && video.RatingLocked
+		}), mock.MatchedBy(func(audit *domain.ModerationAudit) bool {
+			return audit.VideoID == 7 && audit.ActorID == "staff" && audit.Action == string(domain.ModerationRate) &&
+				audit.Note == "all -> 18+: graphic content"
+		})).Return([]domain.Video{clip}, nil).Once()
+
+		video, err := usecase.SetContentRating(ctx, domain.SetContentRatingReq{
+			VideoID: "7", MemberID: "staff", Role: "admin", Rating: domain.RatingAdult, Note: "graphic content",
+		})
+		assert.NoError(t, err)
+		assert.True(t, video.RatingLocked)
+		mockRepo.AssertExpectations(t)
+		mockMinIO.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 鎖定後上傳者無法修改，非上傳者與不支援的分級皆失敗**
+	t.Run("無法設定分級", func(t *testing.T) {
+		mockRepo := new(MockModerationRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewModerationUseCase(mockRepo, mockVideoRepo, new(MockMinIOClient))
+		mockVideoRepo.On("GetByID", uint(7)).Return(newVideo(true), nil).Once()
+		mockVideoRepo.On("GetByID", uint(7)).Return(newVideo(false), nil).Once()
+
+		_, err := usecase.SetContentRating(ctx, domain.SetContentRatingReq{VideoID: "7", MemberID: "owner", Rating: domain.RatingAll})
+		assert.Error(t, err)
+		_, err = usecase.SetContentRating(ctx, domain.SetContentRatingReq{VideoID: "7", MemberID: "viewer", Rating: domain.RatingTeen})
+		assert.Error(t, err)
+		_, err = usecase.SetContentRating(ctx, domain.SetContentRatingReq{VideoID: "7", MemberID: "owner", Rating: "21+"})
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "SetRating", mock.Anything, mock.Anything)
+		mockVideoRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 年齡限制的處理動作將分級改為限制級並鎖定**
+	t.Run("age_restrict", func(t *testing.T) {
+		video := newVideo(false)
+		assert.True(t, domain.ModerationAgeRestrict.Apply(video))
+		assert.Equal(t, domain.RatingAdult, video.Rating())
+		assert.True(t, video.RatingLocked)
+		assert.False(t, domain.ModerationAgeRestrict.Apply(video))
+	})
+}
+
+func TestRatedVideoPlayback(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	// **情境 1: 依觀看者的年齡資料決定可觀看的最高分級**
+	t.Run("觀看者分級", func(t *testing.T) {
+		assert.Equal(t, domain.RatingAll, domain.Viewer{}.MaxRating(now))
+		assert.Equal(t, domain.RatingAll, domain.Viewer{Birthdate: "1990-01-01", AgeVerified: true}.MaxRating(now))
+		assert.Equal(t, domain.RatingAll, domain.Viewer{MemberID: "viewer"}.MaxRating(now))
+		assert.Equal(t, domain.RatingAdult, domain.Viewer{MemberID: "viewer", AgeVerified: true}.MaxRating(now))
+		assert.Equal(t, domain.RatingAll, domain.Viewer{MemberID: "viewer", Birthdate: "2013-06-16"}.MaxRating(now))
+		assert.Equal(t, domain.RatingTeen, domain.Viewer{MemberID: "viewer", Birthdate: "2013-06-15"}.MaxRating(now))
+		assert.Equal(t, domain.RatingTeen, domain.Viewer{MemberID: "viewer", Birthdate: "2008-06-16"}.MaxRating(now))
+		assert.Equal(t, domain.RatingAdult, domain.Viewer{MemberID: "viewer", Birthdate: "2008-06-15"}.MaxRating(now))
+
+		assert.Equal(t, []string{"all"}, domain.ContentRating("").UpTo())
+		assert.Equal(t, []string{"all", "13+"}, domain.RatingTeen.UpTo())
+		assert.Equal(t, []string{"all", "13+", "18+"}, domain.RatingAdult.UpTo())
+	})
+
+	// **情境 2: 限制級影片拒絕訪客與未成年會員，上傳者與成年會員可播放**
+	t.Run("限制級影片", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockVideoRepo, nil, nil)
+		mockVideoRepo.On("GetByID", uint(9)).Return(&domain.Video{
+			ID: 9, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic),
+			ContentRating: string(domain.RatingAdult),
+		}, nil)
+
+		minor := domain.Viewer{MemberID: "viewer", Birthdate: time.Now().AddDate(-15, 0, 0).Format(domain.BirthdateLayout)}
+		for _, viewer := range []domain.Viewer{{}, {MemberID: "viewer"}, minor} {
+			_, err := usecase.GetVideo("9", viewer, "")
+			assert.Error(t, err)
+			_, err = usecase.GetIndexM3U8(ctx, "9", viewer)
+			assert.Error(t, err)
+			_, err = usecase.GetHlsSegment(ctx, "9", "index0.ts", viewer)
+			assert.Error(t, err)
+		}
+
+		mockVideoRepo.On("GetVideoTags", uint(9)).Return([]string{}, nil)
+		mockVideoRepo.On("GetChapters", uint(9)).Return([]domain.Chapter{}, nil)
+		for _, viewer := range []domain.Viewer{{MemberID: "owner"}, {MemberID: "viewer", AgeVerified: true}} {
+			res, err := usecase.GetVideo("9", viewer, "")
+			assert.NoError(t, err)
+			assert.Equal(t, string(domain.RatingAdult), res.ContentRating)
+			assert.Equal(t, domain.PlaybackGateway, res.PlaybackURLs[0].Source)
+		}
+		mockMinIO.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	// **情境 3: 搜尋與推薦依觀看者可觀看的分級過濾**
+	t.Run("搜尋與推薦", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockVideoRepo, nil, nil)
+		mockVideoRepo.On("SearchVideos", domain.SearchFilter{Keyword: "go", MaxRating: domain.RatingAll}).
+			Return([]domain.Video{{ID: 1, Status: string(domain.VideoReady)}}, nil).Once()
+		mockVideoRepo.On("RecommendVideos", domain.RecommendFilter{Limit: 5, Tags: []string{"go"}, MaxRating: domain.RatingTeen}).
+			Return([]domain.Video{{ID: 2, ContentRating: string(domain.RatingTeen)}}, nil).Once()
+
+		videos, err := usecase.Search(domain.SearchFilter{Keyword: "go", MaxRating: domain.RatingAll})
+		assert.NoError(t, err)
+		assert.Equal(t, string(domain.RatingAll), videos[0].ContentRating)
+		videos, err = usecase.GetRecommendations(domain.RecommendFilter{Limit: 5, Tags: []string{"go"}, MaxRating: domain.RatingTeen})
+		assert.NoError(t, err)
+		assert.Equal(t, string(domain.RatingTeen), videos[0].ContentRating)
+		mockVideoRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// orphanLookupBatch 每次查詢 videos 的 ID 數量
+const orphanLookupBatch = 500
+
+// OrphanGC 定期列出 original/、processed/ 與其封存 prefix 的物件，刪除找不到對應 videos 記錄的物件
+//   - 上傳中斷或刪除影片留下的物件
+//   - 最後修改時間在 gracePeriod 內的物件不處理，避免與進行中的上傳競爭
+//   - dryRun 時只產生報告，不刪除
+type OrphanGC struct {
+	videoRepo   repository.VideoRepo
+	minioClient database.MinIOClientRepo
+	lock        repository.LeaderLock
+	owner       string // leader lock 的持有者，每個 replica 不同
+	interval    time.Duration
+	gracePeriod time.Duration
+	dryRun      bool
+}
+
+// NewOrphanGC 建構 OrphanGC 實例
+func NewOrphanGC(videoRepo repository.VideoRepo, minioClient database.MinIOClientRepo,
+	lock repository.LeaderLock, interval, gracePeriod time.Duration, dryRun bool) *OrphanGC {
+	return &OrphanGC{
+		videoRepo:   videoRepo,
+		minioClient: minioClient,
+		lock:        lock,
+		owner:       uuid.NewString(),
+		interval:    interval,
+		gracePeriod: gracePeriod,
+		dryRun:      dryRun,
+	}
+}
+
+// Start 定期回收孤兒物件，直到 ctx 結束
+func (g *OrphanGC) Start(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("OrphanGC 已啟動，間隔 %s，寬限期 %s，dry run: %t", g.interval, g.gracePeriod, g.dryRun))
+	for {
+		select {
+		case now := <-ticker.C:
+			g.run(ctx, now)
+		case <-ctx.Done():
+			logger.Log.Info("OrphanGC 收到停止訊號")
+			if err := g.lock.Release(context.Background(), domain.OrphanGCLockKey, g.owner); err != nil {
+				logger.Log.Errorf("OrphanGC 釋放 leader lock 失敗:", err)
+			}
+			return
+		}
+	}
+}
+
+func (g *OrphanGC) run(ctx context.Context, now time.Time) {
+	leader, err := g.lock.Acquire(ctx, domain.OrphanGCLockKey, g.owner, 2*g.interval)
+	if err != nil {
+		logger.Log.Errorf("OrphanGC 取得 leader lock 失敗:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	report, err := g.Collect(ctx, now)
+	if err != nil {
+		logger.Log.Errorf("OrphanGC 回收孤兒物件失敗:", err)
+		return
+	}
+	if report.DryRun {
+		for _, orphan := range report.Orphans {
+			logger.Log.Info(fmt.Sprintf("OrphanGC [dry run] videoID[%d] %s（%d bytes，最後修改 %s）",
+				orphan.VideoID, orphan.Key, orphan.Size, orphan.LastModified.Format(time.RFC3339)))
+		}
+	}
+	logger.Log.Info(fmt.Sprintf("OrphanGC 掃描 %d 個物件，孤兒物件 %d 個（%d bytes），刪除 %d 個，dry run: %t",
+		report.Scanned, len(report.Orphans), report.Bytes, report.Deleted, report.DryRun))
+}
+
+// Collect 找出孤兒物件並在非 dry run 時刪除，單一物件刪除失敗時記錄錯誤並繼續
+func (g *OrphanGC) Collect(ctx context.Context, now time.Time) (domain.OrphanReport, error) {
+	report := domain.OrphanReport{DryRun: g.dryRun}
+	var candidates []domain.OrphanObject
+	for _, prefix := range domain.VideoObjectPrefixes() {
+		objects, err := g.minioClient.ListObjects(ctx, prefix)
+		if err != nil {
+			return report, err
+		}
+		report.Scanned += len(objects)
+		for _, object := range objects {
+			videoID, ok := domain.VideoIDFromKey(object.Key)
+			if !ok || object.LastModified.After(now.Add(-g.gracePeriod)) {
+				continue
+			}
+			candidates = append(candidates, domain.OrphanObject{
+				Key:          object.Key,
+				VideoID:      videoID,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+		}
+	}
+
+	existing, err := g.existingVideoIDs(candidates)
+	if err != nil {
+		return report, err
+	}
+	for _, candidate := range candidates {
+		if existing[candidate.VideoID] {
+			continue
+		}
+		report.Orphans = append(report.Orphans, candidate)
+		report.Bytes += candidate.Size
+		if g.dryRun {
+			continue
+		}
+		if err := g.minioClient.RemoveObject(ctx, candidate.Key); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 刪除孤兒物件 %s 失敗:", candidate.VideoID, candidate.Key), err)
+			continue
+		}
+		report.Deleted++
+	}
+	return report, nil
+}
+
+// existingVideoIDs 分批查詢候選物件的 videoID 是否仍有 videos 記錄
+func (g *OrphanGC) existingVideoIDs(candidates []domain.OrphanObject) (map[uint]bool, error) {
+	seen := map[uint]bool{}
+	var ids []uint
+	for _, candidate := range candidates {
+		if !seen[candidate.VideoID] {
+			seen[candidate.VideoID] = true
+			ids = append(ids, candidate.VideoID)
+		}
+	}
+
+	existing := map[uint]bool{}
+	for start := 0; start < len(ids); start += orphanLookupBatch {
+		end := start + orphanLookupBatch
+		if end > len(ids) {
+			end = len(ids)
+		}
+		videos, err := g.videoRepo.GetByIDs(ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("查詢影片記錄失敗: %w", err)
+		}
+		for _, video := range videos {
+			existing[video.ID] = true
+		}
+	}
+	return existing, nil
+}
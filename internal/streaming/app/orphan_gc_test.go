@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOrphanGC(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+
+	listObjects := func(mockMinIO *MockMinIOClient) {
+		mockMinIO.On("ListObjects", ctx, "original/").Return([]database.ObjectInfo{
+			{Key: "original/1/a.mp4", Size: 100, LastModified: old},
+			{Key: "original/2/b.mp4", Size: 200, LastModified: old},
+			{Key: "original/3/c.mp4", Size: 300, LastModified: now.Add(-time.Minute)}, // 寬限期內，可能仍在上傳
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "processed/").Return([]database.ObjectInfo{
+			{Key: "processed/1/index.m3u8", Size: 10, LastModified: old},
+			{Key: "processed/2/index.m3u8", Size: 20, LastModified: old},
+			{Key: "processed/readme.txt", Size: 5, LastModified: old}, // key 沒有 videoID，不處理
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "archive/original/").Return([]database.ObjectInfo{}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "archive/processed/").Return([]database.ObjectInfo{
+			{Key: "archive/processed/4/index.m3u8", Size: 40, LastModified: old},
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "downloads/").Return([]database.ObjectInfo{}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "public/").Return([]database.ObjectInfo{}, nil).Once()
+	}
+
+	// **情境 1: dry run 只產生報告，不刪除**
+	t.Run("dry run", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		gc := NewOrphanGC(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour, 24*time.Hour, true)
+
+		listObjects(mockMinIO)
+		mockRepo.On("GetByIDs", []uint{1, 2, 4}).Return([]domain.Video{{ID: 1}}, nil).Once()
+
+		report, err := gc.Collect(ctx, now)
+
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 7, report.Scanned)
+		assert.Equal(t, []domain.OrphanObject{
+			{Key: "original/2/b.mp4", VideoID: 2, Size: 200, LastModified: old},
+			{Key: "processed/2/index.m3u8", VideoID: 2, Size: 20, LastModified: old},
+			{Key: "archive/processed/4/index.m3u8", VideoID: 4, Size: 40, LastModified: old},
+		}, report.Orphans)
+		assert.Equal(t, int64(260), report.Bytes)
+		assert.Equal(t, 0, report.Deleted)
+		mockMinIO.AssertNotCalled(t, "RemoveObject", mock.Anything, mock.Anything)
+	})
+
+	// **情境 2: 刪除孤兒物件，單一物件刪除失敗時繼續**
+	t.Run("刪除孤兒物件", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		gc := NewOrphanGC(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour, 24*time.Hour, false)
+
+		listObjects(mockMinIO)
+		mockRepo.On("GetByIDs", []uint{1, 2, 4}).Return([]domain.Video{{ID: 1}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "original/2/b.mp4").Return(nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "processed/2/index.m3u8").Return(errors.New("minio down")).Once()
+		mockMinIO.On("RemoveObject", ctx, "archive/processed/4/index.m3u8").Return(nil).Once()
+
+		report, err := gc.Collect(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Len(t, report.Orphans, 3)
+		assert.Equal(t, 2, report.Deleted)
+		mockMinIO.AssertExpectations(t)
+	})
+
+	// **情境 3: 查詢影片記錄失敗時不刪除任何物件**
+	t.Run("查詢失敗", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		gc := NewOrphanGC(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour, 24*time.Hour, false)
+
+		listObjects(mockMinIO)
+		mockRepo.On("GetByIDs", []uint{1, 2, 4}).Return([]domain.Video{}, errors.New("db down")).Once()
+
+		_, err := gc.Collect(ctx, now)
+
+		assert.Error(t, err)
+		mockMinIO.AssertNotCalled(t, "RemoveObject", mock.Anything, mock.Anything)
+	})
+}
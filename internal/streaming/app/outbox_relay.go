@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// OutboxRelay 定期將 outbox 中等待發布的事件發布到 JobQueue
+// JobQueue.Publish 在 broker 確認後才回傳，之後才標記為 sent
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepo
+	queue      database.JobQueue
+	lock       repository.LeaderLock
+	owner      string // leader lock 的持有者，每個 replica 不同
+	interval   time.Duration
+	retention  time.Duration // 已發布的事件保留多久
+}
+
+// NewOutboxRelay 建構 OutboxRelay 實例
+func NewOutboxRelay(outboxRepo repository.OutboxRepo, queue database.JobQueue, lock repository.LeaderLock,
+	interval, retention time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		queue:      queue,
+		lock:       lock,
+		owner:      uuid.NewString(),
+		interval:   interval,
+		retention:  retention,
+	}
+}
+
+// Start 定期發布等待中的事件，直到 ctx 結束
+// 以 leader lock 確保同一時間只有一個 replica 發布，事件依寫入順序送出
+func (r *OutboxRelay) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("OutboxRelay 已啟動，間隔 %s", r.interval))
+	for {
+		select {
+		case now := <-ticker.C:
+			r.run(ctx, now)
+		case <-ctx.Done():
+			logger.Log.Info("OutboxRelay 收到停止訊號")
+			if err := r.lock.Release(context.Background(), domain.OutboxRelayLockKey, r.owner); err != nil {
+				logger.Log.Errorf("OutboxRelay 釋放 leader lock 失敗:", err)
+			}
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) run(ctx context.Context, now time.Time) {
+	leader, err := r.lock.Acquire(ctx, domain.OutboxRelayLockKey, r.owner, 2*r.interval)
+	if err != nil {
+		logger.Log.Errorf("OutboxRelay 取得 leader lock 失敗:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	if _, err := r.Relay(ctx, now); err != nil {
+		logger.Log.Errorf("OutboxRelay 發布事件失敗:", err)
+	}
+	if deleted, err := r.outboxRepo.DeleteSentBefore(now.Add(-r.retention)); err != nil {
+		logger.Log.Errorf("OutboxRelay 清除已發布的事件失敗:", err)
+	} else if deleted > 0 {
+		logger.Log.Info(fmt.Sprintf("OutboxRelay 清除 %d 筆已發布的事件", deleted))
+	}
+}
+
+// Relay 依寫入順序發布等待中的事件，直到沒有事件或發布失敗
+// 發布失敗時記錄原因並停止，後面的事件留到下一輪，避免順序錯亂；
+// 失敗達 OutboxMaxAttempts 次的事件標記為 dead 後略過，繼續發布後面的事件
+func (r *OutboxRelay) Relay(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+	for {
+		events, err := r.outboxRepo.ListPending(domain.OutboxBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("取得等待發布的事件失敗: %w", err)
+		}
+		for _, event := range events {
+			if err := r.publish(ctx, event); err != nil {
+				if markErr := r.outboxRepo.MarkFailed(event.ID, err.Error()); markErr != nil {
+					logger.Log.Errorf(fmt.Sprintf("eventID[%d] 記錄發布失敗失敗:", event.ID), markErr)
+					return sent, fmt.Errorf("eventID[%d] type[%s] 發布失敗: %w", event.ID, event.EventType, err)
+				}
+				if event.Attempts+1 < domain.OutboxMaxAttempts {
+					return sent, fmt.Errorf("eventID[%d] type[%s] 發布失敗: %w", event.ID, event.EventType, err)
+				}
+				logger.Log.Errorf(fmt.Sprintf("eventID[%d] type[%s] 已發布失敗 %d 次，標記為 dead:", event.ID, event.EventType, event.Attempts+1), err)
+				continue
+			}
+			// 已發布但標記失敗時，下一輪會重複發布，由消費端處理重複的事件
+			if err := r.outboxRepo.MarkSent(event.ID, now); err != nil {
+				return sent, fmt.Errorf("eventID[%d] 標記已發布失敗: %w", event.ID, err)
+			}
+			sent++
+		}
+		if len(events) < domain.OutboxBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// publish 以事件 ID 作為訊息 ID，消費端可依此去除重複
+func (r *OutboxRelay) publish(ctx context.Context, event domain.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, domain.OutboxConfirmTimeout)
+	defer cancel()
+	return r.queue.Publish(ctx, event.Topic, database.QueueMessage{
+		ID:        strconv.FormatUint(uint64(event.ID), 10),
+		Type:      event.EventType,
+		Body:      []byte(event.Payload),
+		Timestamp: event.CreatedAt,
+	})
+}
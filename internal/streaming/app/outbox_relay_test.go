@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockJobQueue 是 JobQueue 的 Mock
+type MockJobQueue struct {
+	mock.Mock
+}
+
+func (m *MockJobQueue) Publish(ctx context.Context, topic string, msg database.QueueMessage) error {
+	args := m.Called(topic, msg)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Consume(ctx context.Context, topic string, handler database.JobHandler) error {
+	args := m.Called(topic)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// MockOutboxRepo 是 OutboxRepo 的 Mock
+type MockOutboxRepo struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepo) Save(events []domain.OutboxEvent) error {
+	args := m.Called(events)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepo) ListPending(limit int) ([]domain.OutboxEvent, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]domain.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepo) MarkSent(id uint, sentAt time.Time) error {
+	args := m.Called(id, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepo) MarkFailed(id uint, reason string) error {
+	args := m.Called(id, reason)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepo) DeleteSentBefore(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestOutboxRelay(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	events := []domain.OutboxEvent{
+		{ID: 1, EventType: domain.EventTranscodeRequested, Topic: domain.QueueName, Payload: `{"video_id":1}`},
+		{ID: 2, EventType: domain.EventTranscodeRequested, Topic: domain.QueueName, Payload: `{"video_id":2}`},
+	}
+	published := func(id string) interface{} {
+		return mock.MatchedBy(func(msg database.QueueMessage) bool {
+			return msg.ID == id && msg.Type == domain.EventTranscodeRequested
+		})
+	}
+
+	// **情境 1: 依寫入順序發布並標記為已發布**
+	t.Run("發布事件", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockQueue := new(MockJobQueue)
+		relay := NewOutboxRelay(mockOutbox, mockQueue, new(MockLeaderLock), time.Second, time.Hour)
+
+		mockOutbox.On("ListPending", domain.OutboxBatchSize).Return(events, nil).Once()
+		mockQueue.On("Publish", domain.QueueName, published("1")).Return(nil).Once()
+		mockOutbox.On("MarkSent", uint(1), now).Return(nil).Once()
+		mockQueue.On("Publish", domain.QueueName, published("2")).Return(nil).Once()
+		mockOutbox.On("MarkSent", uint(2), now).Return(nil).Once()
+
+		sent, err := relay.Relay(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		mockOutbox.AssertExpectations(t)
+		mockQueue.AssertExpectations(t)
+	})
+
+	// **情境 2: 發布失敗時記錄原因，後面的事件留到下一輪**
+	t.Run("發布失敗", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockQueue := new(MockJobQueue)
+		relay := NewOutboxRelay(mockOutbox, mockQueue, new(MockLeaderLock), time.Second, time.Hour)
+
+		mockOutbox.On("ListPending", domain.OutboxBatchSize).Return(events, nil).Once()
+		mockQueue.On("Publish", domain.QueueName, published("1")).Return(errors.New("nack")).Once()
+		mockOutbox.On("MarkFailed", uint(1), "nack").Return(nil).Once()
+
+		sent, err := relay.Relay(ctx, now)
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, sent)
+		mockOutbox.AssertExpectations(t)
+		mockOutbox.AssertNotCalled(t, "MarkSent", mock.Anything, mock.Anything)
+		mockQueue.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	// **情境 3: 非 leader 不發布**
+	t.Run("非 leader", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockLock := new(MockLeaderLock)
+		relay := NewOutboxRelay(mockOutbox, new(MockJobQueue), mockLock, time.Second, time.Hour)
+
+		mockLock.On("Acquire", ctx, domain.OutboxRelayLockKey, mock.Anything, 2*time.Second).Return(false, nil).Once()
+
+		relay.run(ctx, now)
+
+		mockOutbox.AssertNotCalled(t, "ListPending", mock.Anything)
+	})
+
+	// **情境 4: leader 發布後清除超過保留時間的事件**
+	t.Run("清除已發布的事件", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockLock := new(MockLeaderLock)
+		relay := NewOutboxRelay(mockOutbox, new(MockJobQueue), mockLock, time.Second, time.Hour)
+
+		mockLock.On("Acquire", ctx, domain.OutboxRelayLockKey, mock.Anything, 2*time.Second).Return(true, nil).Once()
+		mockOutbox.On("ListPending", domain.OutboxBatchSize).Return([]domain.OutboxEvent{}, nil).Once()
+		mockOutbox.On("DeleteSentBefore", now.Add(-time.Hour)).Return(int64(3), nil).Once()
+
+		relay.run(ctx, now)
+
+		mockOutbox.AssertExpectations(t)
+	})
+
+	// **情境 5: 失敗次數用盡的事件標記為 dead 後略過，繼續發布後面的事件**
+	t.Run("失敗次數用盡", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockQueue := new(MockJobQueue)
+		relay := NewOutboxRelay(mockOutbox, mockQueue, new(MockLeaderLock), time.Second, time.Hour)
+
+		exhausted := []domain.OutboxEvent{events[0], events[1]}
+		exhausted[0].Attempts = domain.OutboxMaxAttempts - 1
+		mockOutbox.On("ListPending", domain.OutboxBatchSize).Return(exhausted, nil).Once()
+		mockQueue.On("Publish", domain.QueueName, published("1")).Return(errors.New("nack")).Once()
+		mockOutbox.On("MarkFailed", uint(1), "nack").Return(nil).Once()
+		mockQueue.On("Publish", domain.QueueName, published("2")).Return(nil).Once()
+		mockOutbox.On("MarkSent", uint(2), now).Return(nil).Once()
+
+		sent, err := relay.Relay(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		mockOutbox.AssertExpectations(t)
+		mockQueue.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"testing"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaybackURLBuilder(t *testing.T) {
+	logger.SetNewNop()
+	public := &domain.Video{ID: 5, Visibility: string(domain.VisibilityPublic), PublicCopy: true}
+
+	// **情境 1: 未設定時只有 gateway，播放清單使用 api_gateway 的 index 路由**
+	t.Run("預設 gateway", func(t *testing.T) {
+		var builder *domain.PlaybackURLBuilder
+		assert.Equal(t, []domain.PlaybackURL{
+			{Source: domain.PlaybackGateway, URL: "http://127.0.0.1:8080/streaming/video/hls/5/index"},
+		}, builder.Candidates(public, ""))
+		assert.Equal(t, []string{
+			"http://127.0.0.1:8080/streaming/video/hls/5/poster.jpg",
+			"http://127.0.0.1:8080/streaming/video/hls/5/index0.ts",
+		}, builder.AssetURLs(public, domain.PosterFileName, domain.ShortsFirstSegment))
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/5/chapters.vtt", builder.ChaptersURL(5))
+	})
+
+	// **情境 2: CDN 依權重排序，其後為公開 bucket 與 gateway，只使用 public/ 下的副本；沒有副本的影片只有 gateway**
+	t.Run("CDN 與公開 bucket", func(t *testing.T) {
+		picks := []int{3, 0}
+		builder, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{
+			GatewayURL: "https://api.example.com/streaming/",
+			StorageURL: "http://minio:9000/video-bucket",
+			CDNs: []domain.CDNHost{
+				{URL: "https://a.cdn.example.com", Weight: 3},
+				{URL: "https://b.cdn.example.com", Weight: 1},
+			},
+			Rand: func(n int) int {
+				pick := picks[0]
+				picks = picks[1:]
+				return pick
+			},
+		})
+		assert.NoError(t, err)
+
+		unlisted := &domain.Video{ID: 5, Visibility: string(domain.VisibilityUnlisted), PublicCopy: true}
+		assert.Equal(t, []domain.PlaybackURL{
+			{Source: domain.PlaybackCDN, URL: "https://b.cdn.example.com/public/5/index.m3u8"},
+			{Source: domain.PlaybackCDN, URL: "https://a.cdn.example.com/public/5/index.m3u8"},
+			{Source: domain.PlaybackStorage, URL: "http://minio:9000/video-bucket/public/5/index.m3u8"},
+			{Source: domain.PlaybackGateway, URL: "https://api.example.com/streaming/video/hls/5/index"},
+		}, builder.Candidates(unlisted, ""))
+
+		cold := &domain.Video{ID: 5, Visibility: string(domain.VisibilityUnlisted), StorageTier: string(domain.StorageCold)}
+		assert.Equal(t, []domain.PlaybackURL{
+			{Source: domain.PlaybackGateway, URL: "https://api.example.com/streaming/video/hls/5/index"},
+		}, builder.Candidates(cold, ""))
+	})
+
+	// **情境 3: 地區專屬 CDN 優先，其他地區不使用**
+	t.Run("地區", func(t *testing.T) {
+		builder, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{
+			CDNs: []domain.CDNHost{
+				{URL: "https://global.cdn.example.com"},
+				{URL: "https://tw.cdn.example.com", Regions: []string{"TW", "hk"}},
+			},
+		})
+		assert.NoError(t, err)
+
+		candidates := builder.Candidates(public, "tw")
+		assert.Len(t, candidates, 3)
+		assert.Equal(t, "https://tw.cdn.example.com/public/5/index.m3u8", candidates[0].URL)
+		assert.Equal(t, "https://global.cdn.example.com/public/5/index.m3u8", candidates[1].URL)
+
+		candidates = builder.Candidates(public, "jp")
+		assert.Len(t, candidates, 2)
+		assert.Equal(t, "https://global.cdn.example.com/public/5/index.m3u8", candidates[0].URL)
+	})
+
+	// **情境 4: private 影片不經過 CDN 與公開 bucket**
+	t.Run("private 影片", func(t *testing.T) {
+		builder, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{
+			StorageURL: "http://minio:9000/video-bucket",
+			CDNs:       []domain.CDNHost{{URL: "https://a.cdn.example.com"}},
+		})
+		assert.NoError(t, err)
+
+		private := &domain.Video{ID: 5, Visibility: string(domain.VisibilityPrivate)}
+		assert.Equal(t, []domain.PlaybackURL{
+			{Source: domain.PlaybackGateway, URL: "http://127.0.0.1:8080/streaming/video/hls/5/index"},
+		}, builder.Candidates(private, "tw"))
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/5/index", builder.HLSURL(private))
+	})
+
+	// **情境 5: 設定錯誤時無法建立**
+	t.Run("設定錯誤", func(t *testing.T) {
+		_, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{GatewayURL: "127.0.0.1:8080"})
+		assert.Error(t, err)
+		_, err = domain.NewPlaybackURLBuilder(domain.PlaybackOptions{CDNs: []domain.CDNHost{{URL: "https://a.cdn.example.com", Weight: -1}}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// CreatePlaylist 實作 建立播放清單
+func (s *StreamingGRPCServer) CreatePlaylist(ctx context.Context, req *streaming_pb.CreatePlaylistReq) (*streaming_pb.CreatePlaylistRes, error) {
+	playlist, err := s.PlaylistUsecase.CreatePlaylist(ctx, req.MemberId, req.Title, domain.VideoVisibility(req.Visibility))
+	if err != nil {
+		return &streaming_pb.CreatePlaylistRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.CreatePlaylistRes{
+		Success:  true,
+		Playlist: toPlaylistPb(playlist),
+	}, nil
+}
+
+// UpdatePlaylist 實作 修改播放清單名稱或可見度
+func (s *StreamingGRPCServer) UpdatePlaylist(ctx context.Context, req *streaming_pb.UpdatePlaylistReq) (*streaming_pb.UpdatePlaylistRes, error) {
+	playlist, err := s.PlaylistUsecase.UpdatePlaylist(ctx, domain.UpdatePlaylistReq{
+		PlaylistID: req.PlaylistId,
+		MemberID:   req.MemberId,
+		Title:      req.Title,
+		Visibility: domain.VideoVisibility(req.Visibility),
+	})
+	if err != nil {
+		return &streaming_pb.UpdatePlaylistRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.UpdatePlaylistRes{
+		Success:  true,
+		Playlist: toPlaylistPb(playlist),
+	}, nil
+}
+
+// DeletePlaylist 實作 刪除播放清單
+func (s *StreamingGRPCServer) DeletePlaylist(ctx context.Context, req *streaming_pb.DeletePlaylistReq) (*streaming_pb.DeletePlaylistRes, error) {
+	if err := s.PlaylistUsecase.DeletePlaylist(ctx, req.PlaylistId, req.MemberId); err != nil {
+		return &streaming_pb.DeletePlaylistRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.DeletePlaylistRes{Success: true}, nil
+}
+
+// ListPlaylists 實作 列出會員的播放清單
+func (s *StreamingGRPCServer) ListPlaylists(ctx context.Context, req *streaming_pb.ListPlaylistsReq) (*streaming_pb.ListPlaylistsRes, error) {
+	playlists, err := s.PlaylistUsecase.ListPlaylists(ctx, req.MemberId)
+	if err != nil {
+		return &streaming_pb.ListPlaylistsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	playlistRes := make([]*streaming_pb.Playlist, len(playlists))
+	for index := range playlists {
+		playlistRes[index] = toPlaylistPb(&playlists[index])
+	}
+	return &streaming_pb.ListPlaylistsRes{
+		Success:   true,
+		Playlists: playlistRes,
+	}, nil
+}
+
+// GetPlaylist 實作 取得播放清單與播放佇列
+func (s *StreamingGRPCServer) GetPlaylist(ctx context.Context, req *streaming_pb.GetPlaylistReq) (*streaming_pb.GetPlaylistRes, error) {
+	detail, err := s.PlaylistUsecase.GetPlaylist(ctx, req.PlaylistId, req.MemberId)
+	if err != nil {
+		return &streaming_pb.GetPlaylistRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	items := make([]*streaming_pb.PlaylistItem, len(detail.Items))
+	for index, item := range detail.Items {
+		items[index] = &streaming_pb.PlaylistItem{
+			Position: int32(item.Position),
+			VideoId:  int64(item.VideoID),
+			Title:    item.Title,
+			Type:     item.Type,
+			HlsUrl:   item.HlsURL,
+		}
+	}
+	return &streaming_pb.GetPlaylistRes{
+		Success:  true,
+		Playlist: toPlaylistPb(&detail.Playlist),
+		Items:    items,
+	}, nil
+}
+
+// AddPlaylistItem 實作 加入影片到播放清單
+func (s *StreamingGRPCServer) AddPlaylistItem(ctx context.Context, req *streaming_pb.AddPlaylistItemReq) (*streaming_pb.AddPlaylistItemRes, error) {
+	if err := s.PlaylistUsecase.AddPlaylistItem(ctx, req.PlaylistId, req.MemberId, uint(req.VideoId)); err != nil {
+		return &streaming_pb.AddPlaylistItemRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.AddPlaylistItemRes{Success: true}, nil
+}
+
+// RemovePlaylistItem 實作 從播放清單移除影片
+func (s *StreamingGRPCServer) RemovePlaylistItem(ctx context.Context, req *streaming_pb.RemovePlaylistItemReq) (*streaming_pb.RemovePlaylistItemRes, error) {
+	if err := s.PlaylistUsecase.RemovePlaylistItem(ctx, req.PlaylistId, req.MemberId, uint(req.VideoId)); err != nil {
+		return &streaming_pb.RemovePlaylistItemRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.RemovePlaylistItemRes{Success: true}, nil
+}
+
+// ReorderPlaylist 實作 播放清單重新排序
+func (s *StreamingGRPCServer) ReorderPlaylist(ctx context.Context, req *streaming_pb.ReorderPlaylistReq) (*streaming_pb.ReorderPlaylistRes, error) {
+	videoIDs := make([]uint, len(req.VideoIds))
+	for index, videoID := range req.VideoIds {
+		videoIDs[index] = uint(videoID)
+	}
+	if err := s.PlaylistUsecase.ReorderPlaylist(ctx, req.PlaylistId, req.MemberId, videoIDs); err != nil {
+		return &streaming_pb.ReorderPlaylistRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReorderPlaylistRes{Success: true}, nil
+}
+
+// toPlaylistPb 將播放清單轉為 proto 格式
+func toPlaylistPb(playlist *domain.Playlist) *streaming_pb.Playlist {
+	return &streaming_pb.Playlist{
+		PlaylistId: int64(playlist.ID),
+		MemberId:   playlist.MemberID,
+		Title:      playlist.Title,
+		Kind:       playlist.Kind,
+		Visibility: playlist.Visibility,
+		CreatedAt:  playlist.CreatedAt.Unix(),
+		UpdatedAt:  playlist.UpdatedAt.Unix(),
+	}
+}
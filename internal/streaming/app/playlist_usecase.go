@@ -0,0 +1,334 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// PlaylistUseCase 播放清單與「稍後觀看」
+type PlaylistUseCase interface {
+	CreatePlaylist(ctx context.Context, memberID, title string, visibility domain.VideoVisibility) (*domain.Playlist, error)
+	UpdatePlaylist(ctx context.Context, req domain.UpdatePlaylistReq) (*domain.Playlist, error)
+	DeletePlaylist(ctx context.Context, playlistID, memberID string) error
+	ListPlaylists(ctx context.Context, memberID string) ([]domain.Playlist, error)
+	GetPlaylist(ctx context.Context, playlistID, memberID string) (*domain.PlaylistDetail, error)
+	AddPlaylistItem(ctx context.Context, playlistID, memberID string, videoID uint) error
+	RemovePlaylistItem(ctx context.Context, playlistID, memberID string, videoID uint) error
+	ReorderPlaylist(ctx context.Context, playlistID, memberID string, videoIDs []uint) error
+}
+
+type playlistUseCase struct {
+	PlaylistRepo repository.PlaylistRepo
+	VideoRepo    repository.VideoRepo
+	PlaybackURLs *domain.PlaybackURLBuilder // 播放網址，nil 使用預設的 gateway
+}
+
+// NewPlaylistUseCase 建立 PlaylistUseCase
+func NewPlaylistUseCase(playlistRepo repository.PlaylistRepo, videoRepo repository.VideoRepo,
+	playbackURLs *domain.PlaybackURLBuilder) PlaylistUseCase {
+	return &playlistUseCase{
+		PlaylistRepo: playlistRepo,
+		VideoRepo:    videoRepo,
+		PlaybackURLs: playbackURLs,
+	}
+}
+
+// CreatePlaylist 建立自訂播放清單，visibility 未指定時為 private
+func (p *playlistUseCase) CreatePlaylist(ctx context.Context, memberID, title string, visibility domain.VideoVisibility) (*domain.Playlist, error) {
+	title, err := playlistTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	if visibility == "" {
+		visibility = domain.VisibilityPrivate
+	}
+	if !playlistVisibilityValid(visibility) {
+		errMsg := fmt.Sprintf("memberID[%s] 播放清單不支援的可見度: %s", memberID, visibility)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	playlist := &domain.Playlist{
+		MemberID:   memberID,
+		Title:      title,
+		Kind:       string(domain.PlaylistCustom),
+		Visibility: string(visibility),
+	}
+	if err := p.PlaylistRepo.Create(playlist); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 建立播放清單失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return playlist, nil
+}
+
+// UpdatePlaylist 修改播放清單名稱或可見度，「稍後觀看」不可修改
+func (p *playlistUseCase) UpdatePlaylist(ctx context.Context, req domain.UpdatePlaylistReq) (*domain.Playlist, error) {
+	playlist, err := p.getOwnedPlaylist(req.PlaylistID, req.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if playlist.Kind == string(domain.PlaylistWatchLater) {
+		errMsg := fmt.Sprintf("playlistID[%s] 稍後觀看清單不可修改", req.PlaylistID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	if req.Title != "" {
+		title, err := playlistTitle(req.Title)
+		if err != nil {
+			return nil, err
+		}
+		playlist.Title = title
+	}
+	if req.Visibility != "" {
+		if !playlistVisibilityValid(req.Visibility) {
+			errMsg := fmt.Sprintf("playlistID[%s] 播放清單不支援的可見度: %s", req.PlaylistID, req.Visibility)
+			return nil, errprocess.Set(errMsg)
+		}
+		playlist.Visibility = string(req.Visibility)
+	}
+
+	if err := p.PlaylistRepo.Update(playlist); err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 更新播放清單失敗: %v", req.PlaylistID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return playlist, nil
+}
+
+// DeletePlaylist 刪除播放清單，「稍後觀看」不可刪除
+func (p *playlistUseCase) DeletePlaylist(ctx context.Context, playlistID, memberID string) error {
+	playlist, err := p.getOwnedPlaylist(playlistID, memberID)
+	if err != nil {
+		return err
+	}
+	if playlist.Kind == string(domain.PlaylistWatchLater) {
+		errMsg := fmt.Sprintf("playlistID[%s] 稍後觀看清單不可刪除", playlistID)
+		return errprocess.Set(errMsg)
+	}
+
+	if err := p.PlaylistRepo.Delete(playlist.ID); err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 刪除播放清單失敗: %v", playlistID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// ListPlaylists 列出會員的播放清單，確保「稍後觀看」存在並排在第一個
+func (p *playlistUseCase) ListPlaylists(ctx context.Context, memberID string) ([]domain.Playlist, error) {
+	if _, err := p.PlaylistRepo.GetOrCreateWatchLater(memberID); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 建立稍後觀看清單失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	playlists, err := p.PlaylistRepo.ListByMember(memberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得播放清單失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return playlists, nil
+}
+
+// GetPlaylist 取得播放清單與依序排列的播放資訊，可直接當作播放佇列使用
+// 擁有者可看 private 清單；清單內呼叫者無權觀看或尚未處理完成的影片會被略過
+func (p *playlistUseCase) GetPlaylist(ctx context.Context, playlistID, memberID string) (*domain.PlaylistDetail, error) {
+	playlist, err := p.resolvePlaylist(playlistID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !playlist.IsOwner(memberID) && playlist.Visibility != string(domain.VisibilityPublic) {
+		errMsg := fmt.Sprintf("playlistID[%s] memberID[%s] 無權限觀看此播放清單", playlistID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	items, err := p.PlaylistRepo.ListItems(playlist.ID)
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 取得播放清單項目失敗: %v", playlistID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	videoIDs := make([]uint, len(items))
+	for i, item := range items {
+		videoIDs[i] = item.VideoID
+	}
+	videos, err := p.VideoRepo.GetByIDs(videoIDs)
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 取得播放清單影片失敗: %v", playlistID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	videoMap := make(map[uint]*domain.Video, len(videos))
+	for i := range videos {
+		videoMap[videos[i].ID] = &videos[i]
+	}
+
+	entries := make([]domain.PlaylistEntry, 0, len(items))
+	for _, item := range items {
+		video, ok := videoMap[item.VideoID]
+		if !ok || video.Status != string(domain.VideoReady) {
+			continue
+		}
+		if ok, err := canWatch(p.VideoRepo, video, memberID); err != nil || !ok {
+			continue
+		}
+		entries = append(entries, domain.PlaylistEntry{
+			Position: len(entries),
+			VideoID:  video.ID,
+			Title:    video.Title,
+			Type:     video.Type,
+			HlsURL:   p.PlaybackURLs.HLSURL(video),
+		})
+	}
+
+	return &domain.PlaylistDetail{
+		Playlist: *playlist,
+		Items:    entries,
+	}, nil
+}
+
+// AddPlaylistItem 將呼叫者可觀看的影片加到播放清單最後
+func (p *playlistUseCase) AddPlaylistItem(ctx context.Context, playlistID, memberID string, videoID uint) error {
+	playlist, err := p.getOwnedPlaylist(playlistID, memberID)
+	if err != nil {
+		return err
+	}
+
+	video, err := p.VideoRepo.GetByID(videoID)
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] videoID[%d] 找不到影片: %v", playlistID, videoID, err)
+		return errprocess.Set(errMsg)
+	}
+	if ok, err := canWatch(p.VideoRepo, video, memberID); err != nil || !ok {
+		errMsg := fmt.Sprintf("playlistID[%s] videoID[%d] 無權限加入此影片", playlistID, videoID)
+		return errprocess.Set(errMsg)
+	}
+
+	count, err := p.PlaylistRepo.CountItems(playlist.ID)
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 取得播放清單數量失敗: %v", playlistID, err)
+		return errprocess.Set(errMsg)
+	}
+	if count >= domain.MaxPlaylistItems {
+		errMsg := fmt.Sprintf("playlistID[%s] 播放清單已達上限 %d 部", playlistID, domain.MaxPlaylistItems)
+		return errprocess.Set(errMsg)
+	}
+
+	if err := p.PlaylistRepo.AddItem(playlist.ID, videoID); err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] videoID[%d] 加入播放清單失敗: %v", playlistID, videoID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// RemovePlaylistItem 將影片從播放清單移除
+func (p *playlistUseCase) RemovePlaylistItem(ctx context.Context, playlistID, memberID string, videoID uint) error {
+	playlist, err := p.getOwnedPlaylist(playlistID, memberID)
+	if err != nil {
+		return err
+	}
+	if err := p.PlaylistRepo.RemoveItem(playlist.ID, videoID); err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] videoID[%d] 移除播放清單影片失敗: %v", playlistID, videoID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// ReorderPlaylist 依 videoIDs 重新排序，videoIDs 必須剛好是清單內所有影片
+func (p *playlistUseCase) ReorderPlaylist(ctx context.Context, playlistID, memberID string, videoIDs []uint) error {
+	playlist, err := p.getOwnedPlaylist(playlistID, memberID)
+	if err != nil {
+		return err
+	}
+
+	items, err := p.PlaylistRepo.ListItems(playlist.ID)
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 取得播放清單項目失敗: %v", playlistID, err)
+		return errprocess.Set(errMsg)
+	}
+	if !samePlaylistItems(items, videoIDs) {
+		errMsg := fmt.Sprintf("playlistID[%s] 排序內容與播放清單項目不符", playlistID)
+		return errprocess.Set(errMsg)
+	}
+
+	if err := p.PlaylistRepo.ReorderItems(playlist.ID, videoIDs); err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 播放清單排序失敗: %v", playlistID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// resolvePlaylist 取得播放清單，playlistID 為 WatchLaterAlias 時取得呼叫者的「稍後觀看」
+func (p *playlistUseCase) resolvePlaylist(playlistID, memberID string) (*domain.Playlist, error) {
+	if playlistID == domain.WatchLaterAlias {
+		if memberID == "" {
+			errMsg := fmt.Sprintf("playlistID[%s] 需登入才能使用稍後觀看", playlistID)
+			return nil, errprocess.Set(errMsg)
+		}
+		playlist, err := p.PlaylistRepo.GetOrCreateWatchLater(memberID)
+		if err != nil {
+			errMsg := fmt.Sprintf("memberID[%s] 建立稍後觀看清單失敗: %v", memberID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		return playlist, nil
+	}
+
+	id, _ := strconv.Atoi(playlistID)
+	playlist, err := p.PlaylistRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("playlistID[%s] 找不到播放清單: %v", playlistID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return playlist, nil
+}
+
+// getOwnedPlaylist 取得播放清單並確認 memberID 為擁有者
+func (p *playlistUseCase) getOwnedPlaylist(playlistID, memberID string) (*domain.Playlist, error) {
+	playlist, err := p.resolvePlaylist(playlistID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !playlist.IsOwner(memberID) {
+		errMsg := fmt.Sprintf("playlistID[%s] memberID[%s] 非播放清單擁有者", playlistID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return playlist, nil
+}
+
+// playlistTitle 檢查播放清單名稱
+func playlistTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", errprocess.Set("播放清單名稱不可為空")
+	}
+	if utf8.RuneCountInString(title) > domain.MaxPlaylistTitleLength {
+		errMsg := fmt.Sprintf("播放清單名稱超過 %d 字", domain.MaxPlaylistTitleLength)
+		return "", errprocess.Set(errMsg)
+	}
+	return title, nil
+}
+
+// playlistVisibilityValid 播放清單僅支援 public / private
+func playlistVisibilityValid(v domain.VideoVisibility) bool {
+	return v == domain.VisibilityPublic || v == domain.VisibilityPrivate
+}
+
+// samePlaylistItems check videoIDs 與 items 為相同集合且沒有重複
+func samePlaylistItems(items []domain.PlaylistItem, videoIDs []uint) bool {
+	if len(items) != len(videoIDs) {
+		return false
+	}
+	exists := make(map[uint]bool, len(items))
+	for _, item := range items {
+		exists[item.VideoID] = true
+	}
+	for _, videoID := range videoIDs {
+		if !exists[videoID] {
+			return false
+		}
+		delete(exists, videoID)
+	}
+	return true
+}
@@ -0,0 +1,312 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPlaylistRepo 播放清單儲存庫的 Mock
+type MockPlaylistRepo struct {
+	mock.Mock
+}
+
+func (m *MockPlaylistRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) Create(playlist *domain.Playlist) error {
+	args := m.Called(playlist)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) GetByID(id uint) (*domain.Playlist, error) {
+	args := m.Called(id)
+	return args.Get(0).(*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepo) Update(playlist *domain.Playlist) error {
+	args := m.Called(playlist)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) ListByMember(memberID string) ([]domain.Playlist, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepo) GetOrCreateWatchLater(memberID string) (*domain.Playlist, error) {
+	args := m.Called(memberID)
+	return args.Get(0).(*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepo) CountItems(playlistID uint) (int64, error) {
+	args := m.Called(playlistID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPlaylistRepo) AddItem(playlistID, videoID uint) error {
+	args := m.Called(playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) RemoveItem(playlistID, videoID uint) error {
+	args := m.Called(playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepo) ListItems(playlistID uint) ([]domain.PlaylistItem, error) {
+	args := m.Called(playlistID)
+	return args.Get(0).([]domain.PlaylistItem), args.Error(1)
+}
+
+func (m *MockPlaylistRepo) ReorderItems(playlistID uint, videoIDs []uint) error {
+	args := m.Called(playlistID, videoIDs)
+	return args.Error(0)
+}
+
+func TestCreatePlaylist(t *testing.T) {
+	mockPlaylist := new(MockPlaylistRepo)
+	mockVideo := new(MockVideoRepo)
+
+	logger.SetNewNop()
+	usecase := NewPlaylistUseCase(mockPlaylist, mockVideo, nil)
+	ctx := context.Background()
+
+	// **情境 1: 預設為私人清單**
+	t.Run("預設為私人清單", func(t *testing.T) {
+		mockPlaylist.On("Create", mock.MatchedBy(func(p *domain.Playlist) bool {
+			return p.MemberID == "member" && p.Title == "My list" &&
+				p.Visibility == string(domain.VisibilityPrivate) && p.Kind == string(domain.PlaylistCustom)
+		})).Return(nil).Once()
+
+		playlist, err := usecase.CreatePlaylist(ctx, "member", "  My list ", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "My list", playlist.Title)
+		mockPlaylist.AssertExpectations(t)
+	})
+
+	// **情境 2: 不支援 unlisted**
+	t.Run("不支援 unlisted", func(t *testing.T) {
+		playlist, err := usecase.CreatePlaylist(ctx, "member", "My list", domain.VisibilityUnlisted)
+
+		assert.Error(t, err)
+		assert.Nil(t, playlist)
+		assert.Equal(t, "memberID[member] 播放清單不支援的可見度: unlisted", err.Error())
+	})
+
+	// **情境 3: 名稱不可為空**
+	t.Run("名稱不可為空", func(t *testing.T) {
+		playlist, err := usecase.CreatePlaylist(ctx, "member", "   ", "")
+
+		assert.Error(t, err)
+		assert.Nil(t, playlist)
+		assert.Equal(t, "播放清單名稱不可為空", err.Error())
+	})
+}
+
+func TestUpdateAndDeletePlaylist(t *testing.T) {
+	mockPlaylist := new(MockPlaylistRepo)
+	mockVideo := new(MockVideoRepo)
+
+	logger.SetNewNop()
+	usecase := NewPlaylistUseCase(mockPlaylist, mockVideo, nil)
+	ctx := context.Background()
+
+	watchLater := &domain.Playlist{ID: 1, MemberID: "member", Kind: string(domain.PlaylistWatchLater)}
+
+	// **情境 1: 稍後觀看不可改名**
+	t.Run("稍後觀看不可改名", func(t *testing.T) {
+		mockPlaylist.On("GetOrCreateWatchLater", "member").Return(watchLater, nil).Once()
+
+		playlist, err := usecase.UpdatePlaylist(ctx, domain.UpdatePlaylistReq{
+			PlaylistID: domain.WatchLaterAlias,
+			MemberID:   "member",
+			Title:      "renamed",
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, playlist)
+		assert.Equal(t, "playlistID[watch_later] 稍後觀看清單不可修改", err.Error())
+		mockPlaylist.AssertExpectations(t)
+	})
+
+	// **情境 2: 擁有者改名並設為公開**
+	t.Run("擁有者改名並設為公開", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "member", Title: "old", Visibility: "private"}, nil).Once()
+		mockPlaylist.On("Update", mock.MatchedBy(func(p *domain.Playlist) bool {
+			return p.Title == "new" && p.Visibility == string(domain.VisibilityPublic)
+		})).Return(nil).Once()
+
+		playlist, err := usecase.UpdatePlaylist(ctx, domain.UpdatePlaylistReq{
+			PlaylistID: "2",
+			MemberID:   "member",
+			Title:      "new",
+			Visibility: domain.VisibilityPublic,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "new", playlist.Title)
+		mockPlaylist.AssertExpectations(t)
+	})
+
+	// **情境 3: 非擁有者無法刪除**
+	t.Run("非擁有者無法刪除", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "member"}, nil).Once()
+
+		err := usecase.DeletePlaylist(ctx, "2", "other")
+
+		assert.Error(t, err)
+		assert.Equal(t, "playlistID[2] memberID[other] 非播放清單擁有者", err.Error())
+		mockPlaylist.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
+
+func TestGetPlaylist(t *testing.T) {
+	mockPlaylist := new(MockPlaylistRepo)
+	mockVideo := new(MockVideoRepo)
+
+	logger.SetNewNop()
+	usecase := NewPlaylistUseCase(mockPlaylist, mockVideo, nil)
+	ctx := context.Background()
+
+	// **情境 1: 略過無法播放的影片並回傳播放資訊**
+	t.Run("略過無法播放的影片並回傳播放資訊", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(1)).Return(&domain.Playlist{ID: 1, MemberID: "owner", Visibility: "public"}, nil).Once()
+		mockPlaylist.On("ListItems", uint(1)).Return([]domain.PlaylistItem{
+			{PlaylistID: 1, VideoID: 3, Position: 0},
+			{PlaylistID: 1, VideoID: 4, Position: 1},
+			{PlaylistID: 1, VideoID: 5, Position: 2},
+			{PlaylistID: 1, VideoID: 6, Position: 3},
+		}, nil).Once()
+		mockVideo.On("GetByIDs", []uint{3, 4, 5, 6}).Return([]domain.Video{
+			{ID: 3, Title: "ready", Status: string(domain.VideoReady), Visibility: "public"},
+			{ID: 4, Title: "processing", Status: string(domain.VideoProcessing), Visibility: "public"},
+			{ID: 5, Title: "private", Status: string(domain.VideoReady), Visibility: "private", MemberID: "someone"},
+			{ID: 6, Title: "unlisted", Status: string(domain.VideoReady), Visibility: "unlisted"},
+		}, nil).Once()
+		mockVideo.On("IsSharedWith", uint(5), "viewer").Return(false, nil).Once()
+
+		detail, err := usecase.GetPlaylist(ctx, "1", "viewer")
+
+		assert.NoError(t, err)
+		assert.Len(t, detail.Items, 2)
+		assert.Equal(t, uint(3), detail.Items[0].VideoID)
+		assert.Equal(t, 0, detail.Items[0].Position)
+		assert.Equal(t, uint(6), detail.Items[1].VideoID)
+		assert.Equal(t, 1, detail.Items[1].Position)
+		assert.Equal(t, domain.DefaultGatewayURL+"/video/hls/6/index", detail.Items[1].HlsURL)
+		mockPlaylist.AssertExpectations(t)
+		mockVideo.AssertExpectations(t)
+	})
+
+	// **情境 2: 非擁有者無法觀看私人清單**
+	t.Run("非擁有者無法觀看私人清單", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "owner", Visibility: "private"}, nil).Once()
+
+		detail, err := usecase.GetPlaylist(ctx, "2", "viewer")
+
+		assert.Error(t, err)
+		assert.Nil(t, detail)
+		assert.Equal(t, "playlistID[2] memberID[viewer] 無權限觀看此播放清單", err.Error())
+		mockPlaylist.AssertExpectations(t)
+	})
+}
+
+func TestPlaylistItems(t *testing.T) {
+	mockPlaylist := new(MockPlaylistRepo)
+	mockVideo := new(MockVideoRepo)
+
+	logger.SetNewNop()
+	usecase := NewPlaylistUseCase(mockPlaylist, mockVideo, nil)
+	ctx := context.Background()
+
+	watchLater := &domain.Playlist{ID: 9, MemberID: "member", Kind: string(domain.PlaylistWatchLater)}
+
+	// **情境 1: 加入稍後觀看**
+	t.Run("加入稍後觀看", func(t *testing.T) {
+		mockPlaylist.On("GetOrCreateWatchLater", "member").Return(watchLater, nil).Once()
+		mockVideo.On("GetByID", uint(3)).Return(&domain.Video{ID: 3, Visibility: "public"}, nil).Once()
+		mockPlaylist.On("CountItems", uint(9)).Return(int64(0), nil).Once()
+		mockPlaylist.On("AddItem", uint(9), uint(3)).Return(nil).Once()
+
+		err := usecase.AddPlaylistItem(ctx, domain.WatchLaterAlias, "member", 3)
+
+		assert.NoError(t, err)
+		mockPlaylist.AssertExpectations(t)
+		mockVideo.AssertExpectations(t)
+	})
+
+	// **情境 2: 無法加入無權觀看的私人影片**
+	t.Run("無法加入無權觀看的私人影片", func(t *testing.T) {
+		mockPlaylist.On("GetOrCreateWatchLater", "member").Return(watchLater, nil).Once()
+		mockVideo.On("GetByID", uint(4)).Return(&domain.Video{ID: 4, Visibility: "private", MemberID: "other"}, nil).Once()
+		mockVideo.On("IsSharedWith", uint(4), "member").Return(false, nil).Once()
+
+		err := usecase.AddPlaylistItem(ctx, domain.WatchLaterAlias, "member", 4)
+
+		assert.Error(t, err)
+		assert.Equal(t, "playlistID[watch_later] videoID[4] 無權限加入此影片", err.Error())
+		mockPlaylist.AssertNotCalled(t, "AddItem", uint(9), uint(4))
+	})
+
+	// **情境 3: 播放清單已滿**
+	t.Run("播放清單已滿", func(t *testing.T) {
+		mockPlaylist.On("GetOrCreateWatchLater", "member").Return(watchLater, nil).Once()
+		mockVideo.On("GetByID", uint(3)).Return(&domain.Video{ID: 3, Visibility: "public"}, nil).Once()
+		mockPlaylist.On("CountItems", uint(9)).Return(int64(domain.MaxPlaylistItems), nil).Once()
+
+		err := usecase.AddPlaylistItem(ctx, domain.WatchLaterAlias, "member", 3)
+
+		assert.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("playlistID[watch_later] 播放清單已達上限 %d 部", domain.MaxPlaylistItems), err.Error())
+	})
+
+	// **情境 4: 重新排序**
+	t.Run("重新排序", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "member"}, nil).Once()
+		mockPlaylist.On("ListItems", uint(2)).Return([]domain.PlaylistItem{{VideoID: 1}, {VideoID: 2}, {VideoID: 3}}, nil).Once()
+		mockPlaylist.On("ReorderItems", uint(2), []uint{3, 1, 2}).Return(nil).Once()
+
+		err := usecase.ReorderPlaylist(ctx, "2", "member", []uint{3, 1, 2})
+
+		assert.NoError(t, err)
+		mockPlaylist.AssertExpectations(t)
+	})
+
+	// **情境 5: 排序內容不符**
+	t.Run("排序內容不符", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "member"}, nil).Once()
+		mockPlaylist.On("ListItems", uint(2)).Return([]domain.PlaylistItem{{VideoID: 1}, {VideoID: 2}, {VideoID: 3}}, nil).Once()
+
+		err := usecase.ReorderPlaylist(ctx, "2", "member", []uint{1, 1, 2})
+
+		assert.Error(t, err)
+		assert.Equal(t, "playlistID[2] 排序內容與播放清單項目不符", err.Error())
+	})
+
+	// **情境 6: 移除失敗**
+	t.Run("移除失敗", func(t *testing.T) {
+		mockPlaylist.On("GetByID", uint(2)).Return(&domain.Playlist{ID: 2, MemberID: "member"}, nil).Once()
+		mockPlaylist.On("RemoveItem", uint(2), uint(1)).Return(errors.New("db error")).Once()
+
+		err := usecase.RemovePlaylistItem(ctx, "2", "member", 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, "playlistID[2] videoID[1] 移除播放清單影片失敗: db error", err.Error())
+	})
+}
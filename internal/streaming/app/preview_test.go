@@ -0,0 +1,102 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewWindow(t *testing.T) {
+	logger.SetNewNop()
+
+	// **情境 1: 解析 blackdetect 輸出**
+	t.Run("解析黑畫面", func(t *testing.T) {
+		output := "[blackdetect @ 0x1] black_start:0 black_end:2.5 black_duration:2.5\n" +
+			"frame=  100 fps=0.0 q=-0.0 size=N/A\n" +
+			"[blackdetect @ 0x1] black_start:31.04 black_end:33.2 black_duration:2.16\n"
+		assert.Equal(t, []domain.BlackInterval{
+			{Start: 0, End: 2500 * time.Millisecond},
+			{Start: 31040 * time.Millisecond, End: 33200 * time.Millisecond},
+		}, domain.ParseBlackDetect(output))
+		assert.Empty(t, domain.ParseBlackDetect("no black frames"))
+	})
+
+	// **情境 2: 預設由 1/5 處擷取 5 秒，掃描長度不超過影片長度**
+	t.Run("預設位置", func(t *testing.T) {
+		start, length := domain.PreviewWindow(100*time.Second, nil)
+		assert.Equal(t, 20*time.Second, start)
+		assert.Equal(t, domain.PreviewMaxDuration, length)
+		assert.Equal(t, 50*time.Second, domain.PreviewScanLength(100*time.Second))
+		assert.Equal(t, 10*time.Second, domain.PreviewScanLength(10*time.Second))
+	})
+
+	// **情境 3: 擷取範圍遇到黑畫面時往後移到黑畫面結束**
+	t.Run("避開黑畫面", func(t *testing.T) {
+		blacks := []domain.BlackInterval{
+			{Start: 22 * time.Second, End: 24 * time.Second},
+			{Start: 0, End: 21 * time.Second},
+		}
+		start, length := domain.PreviewWindow(100*time.Second, blacks)
+		assert.Equal(t, 24*time.Second, start)
+		assert.Equal(t, domain.PreviewMaxDuration, length)
+	})
+
+	// **情境 4: 短影片使用整部影片，黑畫面延伸到結尾時對齊結尾**
+	t.Run("短影片", func(t *testing.T) {
+		start, length := domain.PreviewWindow(2*time.Second, nil)
+		assert.Equal(t, time.Duration(0), start)
+		assert.Equal(t, 2*time.Second, length)
+
+		start, length = domain.PreviewWindow(10*time.Second, []domain.BlackInterval{{Start: 0, End: 8 * time.Second}})
+		assert.Equal(t, 5*time.Second, start)
+		assert.Equal(t, domain.PreviewMaxDuration, length)
+
+		_, length = domain.PreviewWindow(0, nil)
+		assert.Equal(t, time.Duration(0), length)
+	})
+}
+
+func TestPreviewURL(t *testing.T) {
+	logger.SetNewNop()
+
+	// **情境 1: 已產生預覽的影片才有預覽網址，公開影片可由公開 bucket 提供**
+	t.Run("預覽網址", func(t *testing.T) {
+		builder, err := domain.NewPlaybackURLBuilder(domain.PlaybackOptions{StorageURL: "http://minio:9000/video-bucket"})
+		assert.NoError(t, err)
+		video := &domain.Video{ID: 5, Visibility: string(domain.VisibilityPublic), HasPreview: true, PublicCopy: true}
+		assert.Equal(t, "http://minio:9000/video-bucket/public/5/preview.mp4", builder.PreviewURL(video))
+
+		video.ContentRating = string(domain.RatingAdult)
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/5/preview.mp4", builder.PreviewURL(video))
+
+		video.HasPreview = false
+		assert.Empty(t, builder.PreviewURL(video))
+	})
+
+	// **情境 2: 搜尋與推薦結果帶入預覽網址**
+	t.Run("搜尋與推薦", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockVideoRepo, nil, nil)
+		videos := []domain.Video{{ID: 1, HasPreview: true}, {ID: 2}}
+		mockVideoRepo.On("SearchVideos", domain.SearchFilter{Keyword: "go"}).Return(videos, nil).Once()
+		mockVideoRepo.On("RecommendVideos", domain.RecommendFilter{Limit: 2, Tags: []string{"go"}}).Return(videos, nil).Once()
+
+		results, err := usecase.Search(domain.SearchFilter{Keyword: "go"})
+		assert.NoError(t, err)
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/1/preview.mp4", results[0].PreviewURL)
+		assert.Empty(t, results[1].PreviewURL)
+
+		results, err = usecase.GetRecommendations(domain.RecommendFilter{Limit: 2, Tags: []string{"go"}})
+		assert.NoError(t, err)
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/1/preview.mp4", results[0].PreviewURL)
+		assert.Empty(t, results[1].PreviewURL)
+
+		feed := toSearchFeedBack(results)
+		assert.Equal(t, results[0].PreviewURL, feed[0].PreviewUrl)
+		mockVideoRepo.AssertExpectations(t)
+	})
+}
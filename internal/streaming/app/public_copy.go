@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+)
+
+// publicCopyBatch PublishScheduler 每次最多同步幾部影片的公開副本
+const publicCopyBatch = 100
+
+// syncPublicCopy 依影片目前的狀態建立或刪除 public/{videoID}/ 的副本，已一致時不做任何事
+// 失敗時 public_copy 維持原值，由 PublishScheduler 重試
+func syncPublicCopy(ctx context.Context, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo, video *domain.Video) error {
+	if !video.WantsPublicCopy() {
+		return removePublicCopy(ctx, minioClient, videoRepo, video)
+	}
+	if video.PublicCopy {
+		return nil
+	}
+	return publishPublicCopy(ctx, minioClient, videoRepo, video)
+}
+
+// publishPublicCopy 將 processed/{videoID}/ 複製到 public/{videoID}/，複製完成後才記錄 public_copy
+// 重新轉碼後也以此覆寫原有的副本
+func publishPublicCopy(ctx context.Context, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo, video *domain.Video) error {
+	prefix := video.ProcessedKey("")
+	objects, err := minioClient.ListObjects(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("列出轉碼後檔案失敗: %w", err)
+	}
+	if len(objects) == 0 {
+		return fmt.Errorf("找不到轉碼後檔案 %s", prefix)
+	}
+	for _, object := range objects {
+		if err := minioClient.CopyObject(ctx, object.Key, video.PublicKey(strings.TrimPrefix(object.Key, prefix))); err != nil {
+			return fmt.Errorf("複製公開副本 %s 失敗: %w", object.Key, err)
+		}
+	}
+	if video.PublicCopy {
+		return nil
+	}
+	if err := videoRepo.SetPublicCopy(video.ID, true); err != nil {
+		return fmt.Errorf("記錄公開副本失敗: %w", err)
+	}
+	video.PublicCopy = true
+	return nil
+}
+
+// removePublicCopy 刪除 public/{videoID}/ 的副本，全部刪除後才清除 public_copy，刪除失敗時下次重試
+func removePublicCopy(ctx context.Context, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo, video *domain.Video) error {
+	if !video.PublicCopy {
+		return nil
+	}
+	objects, err := minioClient.ListObjects(ctx, video.PublicKey(""))
+	if err != nil {
+		return fmt.Errorf("列出公開副本失敗: %w", err)
+	}
+	for _, object := range objects {
+		if err := minioClient.RemoveObject(ctx, object.Key); err != nil {
+			return fmt.Errorf("刪除公開副本 %s 失敗: %w", object.Key, err)
+		}
+	}
+	if err := videoRepo.SetPublicCopy(video.ID, false); err != nil {
+		return fmt.Errorf("清除公開副本記錄失敗: %w", err)
+	}
+	video.PublicCopy = false
+	return nil
+}
+
+// revokePublicCopy 影片不再可公開提供時立即刪除副本，新增副本交由 Worker 與 PublishScheduler
+// 失敗時只記錄，影片記錄已更新，PublishScheduler 下一輪會再刪除
+func revokePublicCopy(ctx context.Context, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo, video *domain.Video) {
+	if video.WantsPublicCopy() {
+		return
+	}
+	if err := removePublicCopy(ctx, minioClient, videoRepo, video); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 刪除公開副本失敗:", video.ID), err)
+	}
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicCopy(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 可公開提供的影片先複製到 public/，複製完成後才記錄 public_copy**
+	t.Run("建立公開副本", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		video := &domain.Video{ID: 5, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+
+		mockMinIO.On("ListObjects", ctx, "processed/5/").Return([]database.ObjectInfo{
+			{Key: "processed/5/index.m3u8"},
+			{Key: "processed/5/720p/index0.ts"},
+		}, nil).Once()
+		first := mockMinIO.On("CopyObject", ctx, "processed/5/index.m3u8", "public/5/index.m3u8").Return(nil).Once()
+		second := mockMinIO.On("CopyObject", ctx, "processed/5/720p/index0.ts", "public/5/720p/index0.ts").Return(nil).Once()
+		mockRepo.On("SetPublicCopy", uint(5), true).Return(nil).Once().NotBefore(first, second)
+
+		assert.NoError(t, syncPublicCopy(ctx, mockMinIO, mockRepo, video))
+		assert.True(t, video.PublicCopy)
+		mockMinIO.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 改為 private、被封鎖或移到冷儲存後刪除副本，刪除失敗時保留 public_copy 以便重試**
+	t.Run("刪除公開副本", func(t *testing.T) {
+		for _, video := range []*domain.Video{
+			{ID: 5, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate), PublicCopy: true},
+			{ID: 5, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), Hidden: true, PublicCopy: true},
+			{ID: 5, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), StorageTier: string(domain.StorageCold), PublicCopy: true},
+		} {
+			mockRepo := new(MockVideoRepo)
+			mockMinIO := new(MockMinIOClient)
+			mockMinIO.On("ListObjects", ctx, "public/5/").Return([]database.ObjectInfo{{Key: "public/5/index.m3u8"}}, nil).Once()
+			removed := mockMinIO.On("RemoveObject", ctx, "public/5/index.m3u8").Return(nil).Once()
+			mockRepo.On("SetPublicCopy", uint(5), false).Return(nil).Once().NotBefore(removed)
+
+			assert.NoError(t, syncPublicCopy(ctx, mockMinIO, mockRepo, video))
+			assert.False(t, video.PublicCopy)
+			mockMinIO.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+		}
+
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		video := &domain.Video{ID: 5, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate), PublicCopy: true}
+		mockMinIO.On("ListObjects", ctx, "public/5/").Return([]database.ObjectInfo{{Key: "public/5/index.m3u8"}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "public/5/index.m3u8").Return(errors.New("minio down")).Once()
+
+		assert.Error(t, syncPublicCopy(ctx, mockMinIO, mockRepo, video))
+		assert.True(t, video.PublicCopy)
+		mockRepo.AssertNotCalled(t, "SetPublicCopy", uint(5), false)
+	})
+
+	// **情境 3: PublishScheduler 同步不一致的影片，單部失敗不影響其他影片**
+	t.Run("PublishScheduler 同步", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		scheduler := NewPublishScheduler(mockRepo, mockMinIO, time.Minute)
+
+		mockRepo.On("FindPublicCopyMismatches", publicCopyBatch).Return([]domain.Video{
+			{ID: 1, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)},
+			{ID: 2, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate), PublicCopy: true},
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "processed/1/").Return([]database.ObjectInfo{}, errors.New("minio down")).Once()
+		mockMinIO.On("ListObjects", ctx, "public/2/").Return([]database.ObjectInfo{}, nil).Once()
+		mockRepo.On("SetPublicCopy", uint(2), false).Return(nil).Once()
+
+		scheduler.syncPublicCopies(ctx)
+
+		mockMinIO.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+)
+
+// PublishScheduler 定期檢查 publish_at 已到期的影片，將其改為 public，並同步 public/ 下的公開副本
+type PublishScheduler struct {
+	videoRepo   repository.VideoRepo
+	minioClient database.MinIOClientRepo
+	interval    time.Duration
+}
+
+// NewPublishScheduler 建構 PublishScheduler 實例
+func NewPublishScheduler(videoRepo repository.VideoRepo, minioClient database.MinIOClientRepo, interval time.Duration) *PublishScheduler {
+	return &PublishScheduler{
+		videoRepo:   videoRepo,
+		minioClient: minioClient,
+		interval:    interval,
+	}
+}
+
+// Start 開始定期發布排程影片並同步公開副本，直到 ctx 結束
+// 多個 replica 同時執行也無妨：更新條件只命中到期影片，副本的複製與刪除重複執行結果相同
+func (p *PublishScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("PublishScheduler 已啟動，間隔 %s", p.interval))
+	for {
+		select {
+		case now := <-ticker.C:
+			p.publishDue(now)
+			p.syncPublicCopies(ctx)
+		case <-ctx.Done():
+			logger.Log.Info("PublishScheduler 收到停止訊號")
+			return
+		}
+	}
+}
+
+// publishDue 發布所有 publish_at <= now 的影片
+func (p *PublishScheduler) publishDue(now time.Time) {
+	count, err := p.videoRepo.PublishDue(now)
+	if err != nil {
+		logger.Log.Errorf("PublishScheduler 發布排程影片失敗:", err)
+		return
+	}
+	if count > 0 {
+		logger.Log.Info(fmt.Sprintf("PublishScheduler 已發布 %d 部排程影片", count))
+	}
+}
+
+// syncPublicCopies 補上剛公開影片的副本，並刪除已不可公開提供的影片殘留的副本
+func (p *PublishScheduler) syncPublicCopies(ctx context.Context) {
+	videos, err := p.videoRepo.FindPublicCopyMismatches(publicCopyBatch)
+	if err != nil {
+		logger.Log.Errorf("PublishScheduler 取得公開副本不一致的影片失敗:", err)
+		return
+	}
+	for index := range videos {
+		video := &videos[index]
+		if err := syncPublicCopy(ctx, p.minioClient, p.videoRepo, video); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 同步公開副本失敗:", video.ID), err)
+		}
+	}
+}
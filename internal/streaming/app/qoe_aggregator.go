@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+)
+
+// QoEAggregator 消費 QoETopic 的 beacon 批次，依影片、rendition、日期累加到 video_qoe_daily
+// 每個 startup beacon 代表一次播放，同時記錄為一次瀏覽；由 HLS 或 CDN 播放都會回報，不受重新整理播放清單影響
+// 佇列為 at-least-once，重新投遞的批次會重複累加；QoE 只用來找出異常的 rendition，可接受少量誤差
+type QoEAggregator struct {
+	queue     database.JobQueue
+	qoeRepo   repository.QoERepo
+	videoRepo repository.VideoRepo
+}
+
+// NewQoEAggregator 建構 QoEAggregator 實例
+func NewQoEAggregator(queue database.JobQueue, qoeRepo repository.QoERepo, videoRepo repository.VideoRepo) *QoEAggregator {
+	return &QoEAggregator{
+		queue:     queue,
+		qoeRepo:   qoeRepo,
+		videoRepo: videoRepo,
+	}
+}
+
+// Start 開始消費 QoE beacon，直到 ctx 結束
+func (a *QoEAggregator) Start(ctx context.Context) {
+	logger.Log.Info("QoEAggregator 已啟動，等待 QoE beacon")
+	if err := a.queue.Consume(ctx, domain.QoETopic, a.Handle); err != nil && ctx.Err() == nil {
+		logger.Log.Errorf("QoEAggregator 消費 QoE beacon 失敗:", err)
+	}
+}
+
+// Handle 累加一批 beacon，寫入失敗時回傳錯誤讓批次重新投遞
+// 無法解析的批次重新投遞也不會成功，記錄後略過
+func (a *QoEAggregator) Handle(ctx context.Context, msg database.QueueMessage) error {
+	var batch domain.QoEBatch
+	if err := json.Unmarshal(msg.Body, &batch); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("messageID[%s] 解析 QoE beacon 失敗:", msg.ID), err)
+		return nil
+	}
+	for _, beacon := range batch.Beacons {
+		if beacon.Event == domain.QoEError && beacon.ErrorCode != "" {
+			logger.Log.Debug(fmt.Sprintf("videoID[%d] rendition[%s] 播放錯誤: %s",
+				beacon.VideoID, beacon.Rendition, beacon.ErrorCode))
+		}
+	}
+
+	stats := domain.AggregateQoE(batch.Beacons)
+	if len(stats) == 0 {
+		return nil
+	}
+	if err := a.qoeRepo.Accumulate(stats); err != nil {
+		return fmt.Errorf("messageID[%s] 寫入 QoE 統計失敗: %w", msg.ID, err)
+	}
+	a.recordViews(batch.Beacons)
+	return nil
+}
+
+// recordViews 每個 startup beacon 記錄一次瀏覽
+// 統計已寫入，記錄失敗時只留下 log，不讓批次重新投遞而重複累加 QoE
+func (a *QoEAggregator) recordViews(beacons []domain.QoEBeacon) {
+	for _, beacon := range beacons {
+		if beacon.Event != domain.QoEStartup {
+			continue
+		}
+		if err := a.videoRepo.RecordView(beacon.VideoID, beacon.At); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 記錄瀏覽次數失敗:", beacon.VideoID), err)
+		}
+	}
+}
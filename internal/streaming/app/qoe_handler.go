@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// ReportQoE 實作 回報播放 QoE beacon
+func (s *StreamingGRPCServer) ReportQoE(ctx context.Context, req *streaming_pb.ReportQoEReq) (*streaming_pb.ReportQoERes, error) {
+	beacons := make([]domain.QoEBeacon, len(req.Beacons))
+	for index, beacon := range req.Beacons {
+		beacons[index] = domain.QoEBeacon{
+			VideoID:   uint(beacon.VideoId),
+			Rendition: beacon.Rendition,
+			Event:     domain.QoEEvent(beacon.Event),
+			Value:     beacon.Value,
+			ErrorCode: beacon.ErrorCode,
+		}
+		if beacon.AtMs > 0 {
+			beacons[index].At = time.UnixMilli(beacon.AtMs)
+		}
+	}
+	res, err := s.QoEUsecase.ReportQoE(ctx, domain.ReportQoEReq{
+		MemberID: req.MemberId,
+		Beacons:  beacons,
+	})
+	if err != nil {
+		return &streaming_pb.ReportQoERes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReportQoERes{
+		Success:  true,
+		Accepted: int32(res.Accepted),
+		Rejected: int32(res.Rejected),
+	}, nil
+}
+
+// GetQoESummary 實作 取得影片 QoE 統計
+func (s *StreamingGRPCServer) GetQoESummary(ctx context.Context, req *streaming_pb.GetQoESummaryReq) (*streaming_pb.GetQoESummaryRes, error) {
+	summary, err := s.QoEUsecase.GetQoESummary(ctx, domain.GetQoESummaryReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Role:     req.Role,
+		Days:     int(req.Days),
+	})
+	if err != nil {
+		return &streaming_pb.GetQoESummaryRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	renditions := make([]*streaming_pb.QoEStats, len(summary.Renditions))
+	for index, stats := range summary.Renditions {
+		renditions[index] = toQoEStats(stats)
+	}
+	return &streaming_pb.GetQoESummaryRes{
+		Success:    true,
+		Days:       int32(summary.Days),
+		Overall:    toQoEStats(summary.Overall),
+		Renditions: renditions,
+	}, nil
+}
+
+func toQoEStats(stats domain.QoEStats) *streaming_pb.QoEStats {
+	return &streaming_pb.QoEStats{
+		Rendition:       stats.Rendition,
+		Plays:           stats.Plays,
+		AvgStartupMs:    stats.AvgStartupMs,
+		RebufferCount:   stats.RebufferCount,
+		RebufferMs:      stats.RebufferMsSum,
+		RebufferPerPlay: stats.RebufferPerPlay,
+		BitrateSwitches: stats.BitrateSwitches,
+		Errors:          stats.Errors,
+		ErrorRate:       stats.ErrorRate,
+		DroppedFrames:   stats.DroppedFrames,
+	}
+}
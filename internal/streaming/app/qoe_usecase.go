@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/token"
+)
+
+// QoEUseCase 播放 QoE：接收播放器 beacon，查詢每部影片、每個 rendition 的統計
+type QoEUseCase interface {
+	ReportQoE(ctx context.Context, req domain.ReportQoEReq) (*domain.ReportQoERes, error)
+	GetQoESummary(ctx context.Context, req domain.GetQoESummaryReq) (*domain.QoESummary, error)
+}
+
+type qoeUseCase struct {
+	OutboxRepo repository.OutboxRepo
+	QoERepo    repository.QoERepo
+	VideoRepo  repository.VideoRepo
+}
+
+// NewQoEUseCase 建立 QoEUseCase
+func NewQoEUseCase(outboxRepo repository.OutboxRepo, qoeRepo repository.QoERepo, videoRepo repository.VideoRepo) QoEUseCase {
+	return &qoeUseCase{
+		OutboxRepo: outboxRepo,
+		QoERepo:    qoeRepo,
+		VideoRepo:  videoRepo,
+	}
+}
+
+// ReportQoE 檢查 beacon 後寫入 outbox，由 OutboxRelay 發布到 QoETopic，QoEAggregator 非同步寫入統計
+// 不合法或影片不存在的 beacon 略過並計入 Rejected，不影響同一批的其他 beacon
+func (q *qoeUseCase) ReportQoE(ctx context.Context, req domain.ReportQoEReq) (*domain.ReportQoERes, error) {
+	if len(req.Beacons) == 0 {
+		return nil, errprocess.Set("沒有 QoE beacon")
+	}
+	if len(req.Beacons) > domain.MaxQoEBeaconsPerBatch {
+		errMsg := fmt.Sprintf("QoE beacon 數量超過上限 %d: %d", domain.MaxQoEBeaconsPerBatch, len(req.Beacons))
+		return nil, errprocess.Set(errMsg)
+	}
+
+	now := time.Now().UTC()
+	batch := domain.QoEBatch{MemberID: req.MemberID, ReceivedAt: now}
+	res := &domain.ReportQoERes{}
+	var valid []domain.QoEBeacon
+	for _, beacon := range req.Beacons {
+		if err := beacon.Validate(now); err != nil {
+			res.Rejected++
+			continue
+		}
+		valid = append(valid, beacon)
+	}
+	exists, err := q.existingVideos(valid)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 查詢 QoE 影片失敗: %v", req.MemberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	for _, beacon := range valid {
+		if !exists[beacon.VideoID] {
+			res.Rejected++
+			continue
+		}
+		batch.Beacons = append(batch.Beacons, beacon)
+	}
+	res.Accepted = len(batch.Beacons)
+	if res.Accepted == 0 {
+		return res, nil
+	}
+
+	event, err := domain.NewOutboxEvent(0, domain.QoEBatchEvent, domain.QoETopic, batch)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 編碼 QoE beacon 失敗: %v", req.MemberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := q.OutboxRepo.Save([]domain.OutboxEvent{event}); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 發布 QoE beacon 失敗: %v", req.MemberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return res, nil
+}
+
+// existingVideos 一次查詢 beacon 所屬的影片，回傳存在的影片 ID
+func (q *qoeUseCase) existingVideos(beacons []domain.QoEBeacon) (map[uint]bool, error) {
+	exists := map[uint]bool{}
+	if len(beacons) == 0 {
+		return exists, nil
+	}
+	var ids []uint
+	for _, beacon := range beacons {
+		if _, ok := exists[beacon.VideoID]; !ok {
+			exists[beacon.VideoID] = false
+			ids = append(ids, beacon.VideoID)
+		}
+	}
+	videos, err := q.VideoRepo.GetByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, video := range videos {
+		exists[video.ID] = true
+	}
+	return exists, nil
+}
+
+// GetQoESummary 影片最近幾天的 QoE 統計，整體與各 rendition 分開列出，僅上傳者與管理員可查詢
+func (q *qoeUseCase) GetQoESummary(ctx context.Context, req domain.GetQoESummaryReq) (*domain.QoESummary, error) {
+	days := req.Days
+	if days <= 0 {
+		days = domain.DefaultQoESummaryDays
+	}
+	if days > domain.MaxQoESummaryDays {
+		days = domain.MaxQoESummaryDays
+	}
+
+	id, _ := strconv.Atoi(req.VideoID)
+	video, err := q.VideoRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !video.IsOwner(req.MemberID) && req.Role != string(token.RoleAdmin) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 僅上傳者與管理員可查看 QoE", req.VideoID, req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	// 含今天共 days 天
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -(days - 1))
+	totals, err := q.QoERepo.SumByRendition(video.ID, since)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得 QoE 統計失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	summary := &domain.QoESummary{VideoID: video.ID, Days: days}
+	var overall domain.VideoQoEDaily
+	for _, total := range totals {
+		summary.Renditions = append(summary.Renditions, domain.NewQoEStats(total))
+		overall.Plays += total.Plays
+		overall.StartupMsSum += total.StartupMsSum
+		overall.RebufferCount += total.RebufferCount
+		overall.RebufferMsSum += total.RebufferMsSum
+		overall.BitrateSwitches += total.BitrateSwitches
+		overall.Errors += total.Errors
+		overall.DroppedFrames += total.DroppedFrames
+	}
+	summary.Overall = domain.NewQoEStats(overall)
+	return summary, nil
+}
@@ -0,0 +1,219 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockQoERepo 是 QoERepo 的 Mock
+type MockQoERepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockQoERepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Accumulate 模擬累加 QoE 統計
+func (m *MockQoERepo) Accumulate(stats []domain.VideoQoEDaily) error {
+	args := m.Called(stats)
+	return args.Error(0)
+}
+
+// SumByRendition 模擬加總每個 rendition 的統計
+func (m *MockQoERepo) SumByRendition(videoID uint, since time.Time) ([]domain.VideoQoEDaily, error) {
+	args := m.Called(videoID, since)
+	totals, _ := args.Get(0).([]domain.VideoQoEDaily)
+	return totals, args.Error(1)
+}
+
+func TestReportQoE(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 合法的 beacon 以一則 outbox 事件寫入，不合法的略過**
+	t.Run("發布 beacon", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewQoEUseCase(mockOutbox, new(MockQoERepo), mockVideoRepo)
+
+		mockVideoRepo.On("GetByIDs", []uint{1, 9}).Return([]domain.Video{{ID: 1}}, nil).Once()
+		var published domain.QoEBatch
+		mockOutbox.On("Save", mock.MatchedBy(func(events []domain.OutboxEvent) bool {
+			return len(events) == 1 && events[0].Topic == domain.QoETopic && events[0].EventType == domain.QoEBatchEvent &&
+				json.Unmarshal([]byte(events[0].Payload), &published) == nil
+		})).Return(nil).Once()
+
+		res, err := usecase.ReportQoE(ctx, domain.ReportQoEReq{
+			MemberID: "viewer",
+			Beacons: []domain.QoEBeacon{
+				{VideoID: 1, Rendition: "720p", Event: domain.QoEStartup, Value: 800},
+				{VideoID: 1, Event: domain.QoERebuffer, Value: 1500, At: time.Now().Add(time.Hour)},
+				{VideoID: 0, Event: domain.QoEStartup, Value: 100},
+				{VideoID: 1, Event: "seek"},
+				{VideoID: 1, Event: domain.QoEDroppedFrames, Value: -1},
+				{VideoID: 1, Rendition: "potato", Event: domain.QoEStartup, Value: 100},
+				{VideoID: 1, Event: domain.QoEStartup, Value: 100, At: time.Now().Add(-2 * domain.MaxQoEBeaconAge)},
+				// 影片不存在
+				{VideoID: 9, Event: domain.QoEStartup, Value: 100},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.ReportQoERes{Accepted: 2, Rejected: 6}, res)
+
+		assert.Equal(t, "viewer", published.MemberID)
+		assert.Len(t, published.Beacons, 2)
+		assert.Equal(t, domain.DefaultRendition, published.Beacons[1].Rendition)
+		// 未來的時間改為收到的時間
+		assert.False(t, published.Beacons[1].At.After(published.ReceivedAt))
+		mockOutbox.AssertExpectations(t)
+	})
+
+	// **情境 2: 沒有 beacon 或超過上限時拒絕整批**
+	t.Run("數量限制", func(t *testing.T) {
+		usecase := NewQoEUseCase(new(MockOutboxRepo), new(MockQoERepo), new(MockVideoRepo))
+
+		_, err := usecase.ReportQoE(ctx, domain.ReportQoEReq{})
+		assert.Error(t, err)
+
+		beacons := make([]domain.QoEBeacon, domain.MaxQoEBeaconsPerBatch+1)
+		_, err = usecase.ReportQoE(ctx, domain.ReportQoEReq{Beacons: beacons})
+		assert.Error(t, err)
+	})
+
+	// **情境 3: 全部不合法時不發布**
+	t.Run("全部不合法", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		usecase := NewQoEUseCase(mockOutbox, new(MockQoERepo), new(MockVideoRepo))
+
+		res, err := usecase.ReportQoE(ctx, domain.ReportQoEReq{Beacons: []domain.QoEBeacon{{Event: domain.QoEError}}})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.Accepted)
+		mockOutbox.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	// **情境 4: 寫入 outbox 失敗時回傳錯誤**
+	t.Run("發布失敗", func(t *testing.T) {
+		mockOutbox := new(MockOutboxRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewQoEUseCase(mockOutbox, new(MockQoERepo), mockVideoRepo)
+		mockVideoRepo.On("GetByIDs", []uint{1}).Return([]domain.Video{{ID: 1}}, nil).Once()
+		mockOutbox.On("Save", mock.Anything).Return(errors.New("db down")).Once()
+
+		_, err := usecase.ReportQoE(ctx, domain.ReportQoEReq{Beacons: []domain.QoEBeacon{{VideoID: 1, Event: domain.QoEError}}})
+		assert.Error(t, err)
+	})
+}
+
+func TestQoEAggregator(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	// **情境 1: 依影片、rendition、日期加總後寫入，起播記錄為瀏覽**
+	t.Run("彙整 beacon", func(t *testing.T) {
+		mockRepo := new(MockQoERepo)
+		mockVideoRepo := new(MockVideoRepo)
+		aggregator := NewQoEAggregator(new(MockJobQueue), mockRepo, mockVideoRepo)
+		body, _ := json.Marshal(domain.QoEBatch{Beacons: []domain.QoEBeacon{
+			{VideoID: 2, Rendition: "720p", Event: domain.QoEStartup, Value: 1000, At: day.Add(time.Hour)},
+			{VideoID: 2, Rendition: "720p", Event: domain.QoEStartup, Value: 500, At: day.Add(2 * time.Hour)},
+			{VideoID: 2, Rendition: "720p", Event: domain.QoERebuffer, Value: 300, At: day.Add(2 * time.Hour)},
+			{VideoID: 2, Rendition: "480p", Event: domain.QoEBitrateSwitch, At: day.Add(3 * time.Hour)},
+			{VideoID: 2, Rendition: "480p", Event: domain.QoEDroppedFrames, Value: 12, At: day.Add(3 * time.Hour)},
+			{VideoID: 2, Rendition: "720p", Event: domain.QoEError, ErrorCode: "MEDIA_ERR_DECODE", At: day.Add(25 * time.Hour)},
+			{VideoID: 1, Rendition: "720p", Event: domain.QoEStartup, Value: 200, At: day},
+		}})
+
+		mockRepo.On("Accumulate", []domain.VideoQoEDaily{
+			{VideoID: 1, Rendition: "720p", Day: day, Plays: 1, StartupMsSum: 200},
+			{VideoID: 2, Rendition: "480p", Day: day, BitrateSwitches: 1, DroppedFrames: 12},
+			{VideoID: 2, Rendition: "720p", Day: day, Plays: 2, StartupMsSum: 1500, RebufferCount: 1, RebufferMsSum: 300},
+			{VideoID: 2, Rendition: "720p", Day: day.AddDate(0, 0, 1), Errors: 1},
+		}).Return(nil).Once()
+		// 每個 startup beacon 記錄一次瀏覽
+		mockVideoRepo.On("RecordView", uint(2), day.Add(time.Hour)).Return(nil).Once()
+		mockVideoRepo.On("RecordView", uint(2), day.Add(2*time.Hour)).Return(nil).Once()
+		mockVideoRepo.On("RecordView", uint(1), day).Return(errors.New("db down")).Once()
+
+		assert.NoError(t, aggregator.Handle(ctx, database.QueueMessage{ID: "m1", Body: body}))
+		mockRepo.AssertExpectations(t)
+		mockVideoRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 寫入失敗時回傳錯誤讓批次重新投遞，無法解析的批次略過**
+	t.Run("寫入失敗", func(t *testing.T) {
+		mockRepo := new(MockQoERepo)
+		mockVideoRepo := new(MockVideoRepo)
+		aggregator := NewQoEAggregator(new(MockJobQueue), mockRepo, mockVideoRepo)
+		body, _ := json.Marshal(domain.QoEBatch{Beacons: []domain.QoEBeacon{
+			{VideoID: 1, Rendition: "720p", Event: domain.QoEStartup, Value: 200, At: day},
+		}})
+		mockRepo.On("Accumulate", mock.Anything).Return(errors.New("db down")).Once()
+
+		assert.Error(t, aggregator.Handle(ctx, database.QueueMessage{ID: "m2", Body: body}))
+		assert.NoError(t, aggregator.Handle(ctx, database.QueueMessage{ID: "m3", Body: []byte("not json")}))
+		mockRepo.AssertExpectations(t)
+		mockVideoRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetQoESummary(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 上傳者取得整體與各 rendition 的統計**
+	t.Run("上傳者", func(t *testing.T) {
+		mockRepo := new(MockQoERepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewQoEUseCase(new(MockOutboxRepo), mockRepo, mockVideoRepo)
+
+		mockVideoRepo.On("GetByID", uint(3)).Return(&domain.Video{ID: 3, MemberID: "owner"}, nil).Once()
+		mockRepo.On("SumByRendition", uint(3), time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -6)).Return([]domain.VideoQoEDaily{
+			{Rendition: "480p", Plays: 2, StartupMsSum: 4000, RebufferCount: 4, Errors: 1},
+			{Rendition: "720p", Plays: 2, StartupMsSum: 1000},
+		}, nil).Once()
+
+		summary, err := usecase.GetQoESummary(ctx, domain.GetQoESummaryReq{VideoID: "3", MemberID: "owner"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DefaultQoESummaryDays, summary.Days)
+		assert.Len(t, summary.Renditions, 2)
+		assert.Equal(t, 2000.0, summary.Renditions[0].AvgStartupMs)
+		assert.Equal(t, 2.0, summary.Renditions[0].RebufferPerPlay)
+		assert.Equal(t, 0.5, summary.Renditions[0].ErrorRate)
+		assert.Equal(t, int64(4), summary.Overall.Plays)
+		assert.Equal(t, 1250.0, summary.Overall.AvgStartupMs)
+		assert.Equal(t, 1.0, summary.Overall.RebufferPerPlay)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 非上傳者也非管理員時拒絕，管理員可查詢**
+	t.Run("權限", func(t *testing.T) {
+		mockRepo := new(MockQoERepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewQoEUseCase(new(MockOutboxRepo), mockRepo, mockVideoRepo)
+		mockVideoRepo.On("GetByID", uint(3)).Return(&domain.Video{ID: 3, MemberID: "owner"}, nil).Twice()
+		mockRepo.On("SumByRendition", uint(3), mock.Anything).Return(nil, nil).Once()
+
+		_, err := usecase.GetQoESummary(ctx, domain.GetQoESummaryReq{VideoID: "3", MemberID: "viewer"})
+		assert.Error(t, err)
+
+		summary, err := usecase.GetQoESummary(ctx, domain.GetQoESummaryReq{VideoID: "3", MemberID: "staff", Role: "admin", Days: 365})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MaxQoESummaryDays, summary.Days)
+		assert.Equal(t, int64(0), summary.Overall.Plays)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// reserveUploadQuota 接收檔案前預留上傳配額，未啟用配額時回傳 nil, nil
+// 超過配額或預留失敗時回傳要送給客戶端的失敗回應
+func (s *StreamingGRPCServer) reserveUploadQuota(stream streaming_pb.StreamingService_UploadVideoServer,
+	metadata *streaming_pb.VideoMetadata) (*domain.UploadRecord, *streaming_pb.UploadVideoRes) {
+	if s.QuotaUsecase == nil {
+		return nil, nil
+	}
+	record, err := s.QuotaUsecase.ReserveUpload(stream.Context(), metadata.MemberId, metadata.Role, metadata.SizeBytes)
+	if err != nil {
+		return nil, quotaFailure(err)
+	}
+	return record, nil
+}
+
+// resizeUploadQuota 以實際收到的大小調整預留的配額，超過時回傳要送給客戶端的失敗回應
+func (s *StreamingGRPCServer) resizeUploadQuota(stream streaming_pb.StreamingService_UploadVideoServer,
+	metadata *streaming_pb.VideoMetadata, record *domain.UploadRecord, sizeBytes int64) *streaming_pb.UploadVideoRes {
+	if record == nil {
+		return nil
+	}
+	if err := s.QuotaUsecase.ResizeUpload(stream.Context(), record, metadata.Role, sizeBytes); err != nil {
+		return quotaFailure(err)
+	}
+	return nil
+}
+
+// releaseUploadQuota 上傳未建立影片時歸還預留的配額，失敗時只記錄
+func (s *StreamingGRPCServer) releaseUploadQuota(ctx context.Context, record *domain.UploadRecord) {
+	if record == nil || record.VideoID != 0 {
+		return
+	}
+	if err := s.QuotaUsecase.ReleaseUpload(ctx, record); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("memberID[%s] 上傳失敗後歸還配額失敗:", record.MemberID), err)
+	}
+}
+
+// quotaFailure 配額錯誤轉為上傳的失敗回應，超過配額時帶上超過的項目
+func quotaFailure(err error) *streaming_pb.UploadVideoRes {
+	res := &streaming_pb.UploadVideoRes{
+		Success: false,
+		Message: err.Error(),
+	}
+	var exceeded *domain.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		res.QuotaExceeded = string(exceeded.Kind)
+	}
+	return res
+}
+
+// GetQuotaUsage 實作 取得上傳配額與用量
+func (s *StreamingGRPCServer) GetQuotaUsage(ctx context.Context, req *streaming_pb.GetQuotaUsageReq) (*streaming_pb.GetQuotaUsageRes, error) {
+	report, err := s.QuotaUsecase.GetQuotaUsage(ctx, req.MemberId, req.Role)
+	if err != nil {
+		return &streaming_pb.GetQuotaUsageRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.GetQuotaUsageRes{
+		Success:      true,
+		Limits:       toQuotaLimitsPb(report.Limits),
+		Source:       report.Source,
+		StoredBytes:  report.StoredBytes,
+		VideoCount:   report.VideoCount,
+		UploadsToday: report.UploadsToday,
+	}, nil
+}
+
+// SetMemberQuota 實作 管理員為會員個別設定配額
+func (s *StreamingGRPCServer) SetMemberQuota(ctx context.Context, req *streaming_pb.SetMemberQuotaReq) (*streaming_pb.SetMemberQuotaRes, error) {
+	limits := req.GetLimits()
+	quota, err := s.QuotaUsecase.SetMemberQuota(ctx, domain.SetMemberQuotaReq{
+		MemberID: req.MemberId,
+		Role:     req.Role,
+		Limits: domain.QuotaLimits{
+			MaxStorageBytes:  limits.GetMaxStorageBytes(),
+			MaxVideos:        limits.GetMaxVideos(),
+			MaxUploadsPerDay: limits.GetMaxUploadsPerDay(),
+		},
+	})
+	if err != nil {
+		return &streaming_pb.SetMemberQuotaRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.SetMemberQuotaRes{
+		Success: true,
+		Limits:  toQuotaLimitsPb(quota.Limits()),
+	}, nil
+}
+
+func toQuotaLimitsPb(limits domain.QuotaLimits) *streaming_pb.QuotaLimits {
+	return &streaming_pb.QuotaLimits{
+		MaxStorageBytes:  limits.MaxStorageBytes,
+		MaxVideos:        limits.MaxVideos,
+		MaxUploadsPerDay: limits.MaxUploadsPerDay,
+	}
+}
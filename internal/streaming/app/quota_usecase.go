@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+	"streaming_video_service/pkg/token"
+)
+
+// QuotaUseCase 上傳配額：依會員個別設定或角色限制總儲存量、影片數與每日上傳次數
+type QuotaUseCase interface {
+	GetQuotaUsage(ctx context.Context, memberID, role string) (*domain.QuotaReport, error)
+	ReserveUpload(ctx context.Context, memberID, role string, sizeBytes int64) (*domain.UploadRecord, error)
+	ResizeUpload(ctx context.Context, record *domain.UploadRecord, role string, sizeBytes int64) error
+	ReleaseUpload(ctx context.Context, record *domain.UploadRecord) error
+	CompleteUpload(ctx context.Context, record *domain.UploadRecord, videoID uint) error
+	SetMemberQuota(ctx context.Context, req domain.SetMemberQuotaReq) (*domain.MemberQuota, error)
+}
+
+type quotaUseCase struct {
+	QuotaRepo repository.QuotaRepo
+	Policy    domain.QuotaPolicy
+}
+
+// NewQuotaUseCase 建立 QuotaUseCase
+func NewQuotaUseCase(quotaRepo repository.QuotaRepo, policy domain.QuotaPolicy) QuotaUseCase {
+	return &quotaUseCase{
+		QuotaRepo: quotaRepo,
+		Policy:    policy,
+	}
+}
+
+// GetQuotaUsage 取得會員的配額與目前用量
+func (q *quotaUseCase) GetQuotaUsage(ctx context.Context, memberID, role string) (*domain.QuotaReport, error) {
+	if memberID == "" {
+		return nil, errprocess.Set("需登入才能查詢配額")
+	}
+	override, err := q.QuotaRepo.GetMemberQuota(memberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得個別配額失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	usage, err := q.QuotaRepo.GetUsage(memberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得用量失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	uploads, err := q.QuotaRepo.CountUploadsSince(memberID, time.Now().Add(-domain.QuotaUploadWindow))
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得上傳次數失敗: %v", memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	limits, source := q.Policy.Limits(role, override)
+	return &domain.QuotaReport{
+		Limits:       limits,
+		Source:       source,
+		StoredBytes:  usage.StoredBytes,
+		VideoCount:   usage.VideoCount,
+		UploadsToday: uploads,
+	}, nil
+}
+
+// ReserveUpload 接收檔案前依宣告的大小預留配額，超過時回傳 *domain.QuotaExceededError
+// 預留的用量在上傳失敗時以 ReleaseUpload 歸還，影片建立後以 CompleteUpload 關聯到影片
+func (q *quotaUseCase) ReserveUpload(ctx context.Context, memberID, role string, sizeBytes int64) (*domain.UploadRecord, error) {
+	if memberID == "" {
+		return nil, errprocess.Set("需登入才能上傳影片")
+	}
+	limits, err := q.limits(memberID, role)
+	if err != nil {
+		return nil, err
+	}
+	record, err := q.QuotaRepo.ReserveUpload(memberID, limits, sizeBytes, time.Now())
+	if err != nil {
+		return nil, quotaError(memberID, "預留上傳配額", err)
+	}
+	return record, nil
+}
+
+// ResizeUpload 收完檔案後以實際大小調整預留的用量，未宣告或宣告不實的大小不會繞過儲存配額
+func (q *quotaUseCase) ResizeUpload(ctx context.Context, record *domain.UploadRecord, role string, sizeBytes int64) error {
+	limits, err := q.limits(record.MemberID, role)
+	if err != nil {
+		return err
+	}
+	if err := q.QuotaRepo.ResizeUpload(record, sizeBytes, limits); err != nil {
+		return quotaError(record.MemberID, "調整上傳配額", err)
+	}
+	return nil
+}
+
+// ReleaseUpload 上傳失敗時歸還預留的用量與當日上傳次數
+func (q *quotaUseCase) ReleaseUpload(ctx context.Context, record *domain.UploadRecord) error {
+	if err := q.QuotaRepo.ReleaseUpload(record); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 歸還上傳配額失敗: %v", record.MemberID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// CompleteUpload 影片建立後將預留的上傳記錄關聯到影片
+func (q *quotaUseCase) CompleteUpload(ctx context.Context, record *domain.UploadRecord, videoID uint) error {
+	if err := q.QuotaRepo.CompleteUpload(record.ID, videoID); err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 記錄上傳用量失敗: %v", videoID, record.MemberID, err)
+		return errprocess.Set(errMsg)
+	}
+	record.VideoID = videoID
+	return nil
+}
+
+// limits 取得會員目前適用的配額
+func (q *quotaUseCase) limits(memberID, role string) (domain.QuotaLimits, error) {
+	override, err := q.QuotaRepo.GetMemberQuota(memberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 取得個別配額失敗: %v", memberID, err)
+		return domain.QuotaLimits{}, errprocess.Set(errMsg)
+	}
+	limits, _ := q.Policy.Limits(role, override)
+	return limits, nil
+}
+
+// quotaError 超過配額時原樣回傳 *domain.QuotaExceededError，其餘錯誤包成 errprocess
+func quotaError(memberID, action string, err error) error {
+	var exceeded *domain.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		logger.Log.Error(exceeded.Error())
+		return exceeded
+	}
+	errMsg := fmt.Sprintf("memberID[%s] %s失敗: %v", memberID, action, err)
+	return errprocess.Set(errMsg)
+}
+
+// SetMemberQuota 管理員為會員個別設定配額，整組取代角色配額，0 表示不限制
+func (q *quotaUseCase) SetMemberQuota(ctx context.Context, req domain.SetMemberQuotaReq) (*domain.MemberQuota, error) {
+	if req.Role != string(token.RoleAdmin) {
+		return nil, errprocess.Set("僅管理員可設定會員配額")
+	}
+	if req.MemberID == "" {
+		return nil, errprocess.Set("缺少會員 ID")
+	}
+	limits := req.Limits
+	if limits.MaxStorageBytes < 0 || limits.MaxVideos < 0 || limits.MaxUploadsPerDay < 0 {
+		errMsg := fmt.Sprintf("memberID[%s] 配額不可為負數", req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	quota := &domain.MemberQuota{
+		MemberID:         req.MemberID,
+		MaxStorageBytes:  limits.MaxStorageBytes,
+		MaxVideos:        limits.MaxVideos,
+		MaxUploadsPerDay: limits.MaxUploadsPerDay,
+	}
+	if err := q.QuotaRepo.SetMemberQuota(quota); err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] 設定配額失敗: %v", req.MemberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return quota, nil
+}
@@ -0,0 +1,358 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+// MockQuotaRepo 是 QuotaRepo 的 Mock
+type MockQuotaRepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockQuotaRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// GetMemberQuota 模擬取得會員個別配額
+func (m *MockQuotaRepo) GetMemberQuota(memberID string) (*domain.MemberQuota, error) {
+	args := m.Called(memberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MemberQuota), args.Error(1)
+}
+
+// SetMemberQuota 模擬設定會員個別配額
+func (m *MockQuotaRepo) SetMemberQuota(quota *domain.MemberQuota) error {
+	args := m.Called(quota)
+	return args.Error(0)
+}
+
+// GetUsage 模擬取得用量
+func (m *MockQuotaRepo) GetUsage(memberID string) (*domain.QuotaUsage, error) {
+	args := m.Called(memberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QuotaUsage), args.Error(1)
+}
+
+// CountUploadsSince 模擬計算上傳次數
+func (m *MockQuotaRepo) CountUploadsSince(memberID string, since time.Time) (int64, error) {
+	args := m.Called(memberID, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ReserveUpload 模擬預留上傳配額
+func (m *MockQuotaRepo) ReserveUpload(memberID string, limits domain.QuotaLimits, sizeBytes int64, now time.Time) (*domain.UploadRecord, error) {
+	args := m.Called(memberID, limits, sizeBytes, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadRecord), args.Error(1)
+}
+
+// ResizeUpload 模擬調整預留的大小
+func (m *MockQuotaRepo) ResizeUpload(record *domain.UploadRecord, sizeBytes int64, limits domain.QuotaLimits) error {
+	args := m.Called(record, sizeBytes, limits)
+	return args.Error(0)
+}
+
+// ReleaseUpload 模擬歸還預留的配額
+func (m *MockQuotaRepo) ReleaseUpload(record *domain.UploadRecord) error {
+	args := m.Called(record)
+	return args.Error(0)
+}
+
+// CompleteUpload 模擬將上傳記錄關聯到影片
+func (m *MockQuotaRepo) CompleteUpload(recordID, videoID uint) error {
+	args := m.Called(recordID, videoID)
+	return args.Error(0)
+}
+
+var testQuotaPolicy = domain.QuotaPolicy{
+	Default: domain.QuotaLimits{MaxStorageBytes: 1000, MaxVideos: 5, MaxUploadsPerDay: 2},
+	Roles: map[string]domain.QuotaLimits{
+		"premium": {MaxStorageBytes: 10000, MaxVideos: 50, MaxUploadsPerDay: 20},
+		"admin":   {},
+	},
+}
+
+// mockUsage 設定 GetQuotaUsage 需要的三個查詢
+func mockUsage(repo *MockQuotaRepo, memberID string, override *domain.MemberQuota, usage domain.QuotaUsage, uploads int64) {
+	repo.On("GetMemberQuota", memberID).Return(override, nil).Once()
+	repo.On("GetUsage", memberID).Return(&usage, nil).Once()
+	repo.On("CountUploadsSince", memberID, mock.Anything).Return(uploads, nil).Once()
+}
+
+func TestGetQuotaUsage(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 依角色取得配額，未列出的角色使用預設值**
+	t.Run("角色配額", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+		mockUsage(mockRepo, "m1", nil, domain.QuotaUsage{StoredBytes: 300, VideoCount: 2}, 1)
+		mockUsage(mockRepo, "m2", nil, domain.QuotaUsage{}, 0)
+
+		report, err := usecase.GetQuotaUsage(ctx, "m1", "premium")
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.QuotaReport{
+			Limits:       testQuotaPolicy.Roles["premium"],
+			Source:       "role",
+			StoredBytes:  300,
+			VideoCount:   2,
+			UploadsToday: 1,
+		}, report)
+
+		report, err = usecase.GetQuotaUsage(ctx, "m2", "member")
+		assert.NoError(t, err)
+		assert.Equal(t, testQuotaPolicy.Default, report.Limits)
+	})
+
+	// **情境 2: 會員個別配額優先於角色配額**
+	t.Run("個別配額", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+		mockUsage(mockRepo, "m1", &domain.MemberQuota{MemberID: "m1", MaxVideos: 1}, domain.QuotaUsage{}, 0)
+
+		report, err := usecase.GetQuotaUsage(ctx, "m1", "premium")
+		assert.NoError(t, err)
+		assert.Equal(t, "member", report.Source)
+		assert.Equal(t, domain.QuotaLimits{MaxVideos: 1}, report.Limits)
+	})
+
+	// **情境 3: 未登入**
+	t.Run("未登入", func(t *testing.T) {
+		usecase := NewQuotaUseCase(new(MockQuotaRepo), testQuotaPolicy)
+		_, err := usecase.GetQuotaUsage(ctx, "", "")
+		assert.EqualError(t, err, "需登入才能查詢配額")
+	})
+}
+
+func TestReserveUpload(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 依會員適用的配額預留**
+	t.Run("預留", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+		record := &domain.UploadRecord{ID: 3, MemberID: "m1", SizeBytes: 500}
+		mockRepo.On("GetMemberQuota", "m1").Return(nil, nil).Once()
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Roles["premium"], int64(500), mock.Anything).Return(record, nil).Once()
+
+		got, err := usecase.ReserveUpload(ctx, "m1", "premium", 500)
+
+		assert.NoError(t, err)
+		assert.Equal(t, record, got)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 超過配額時原樣回傳，gateway 依項目回傳 507 或 429**
+	t.Run("超過配額", func(t *testing.T) {
+		tests := []struct {
+			kind   domain.QuotaKind
+			target error
+		}{
+			{kind: domain.QuotaStorage, target: domain.ErrStorageQuotaExceeded},
+			{kind: domain.QuotaVideos, target: domain.ErrQuotaExceeded},
+			{kind: domain.QuotaDailyUploads, target: domain.ErrQuotaExceeded},
+		}
+		for _, tt := range tests {
+			mockRepo := new(MockQuotaRepo)
+			usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+			mockRepo.On("GetMemberQuota", "m1").Return(nil, nil).Once()
+			mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(501), mock.Anything).
+				Return(nil, &domain.QuotaExceededError{Kind: tt.kind, Msg: "memberID[m1]"}).Once()
+
+			_, err := usecase.ReserveUpload(ctx, "m1", "member", 501)
+
+			var exceeded *domain.QuotaExceededError
+			assert.True(t, errors.As(err, &exceeded))
+			assert.Equal(t, tt.kind, exceeded.Kind)
+			assert.True(t, errors.Is(err, tt.target))
+		}
+	})
+
+	// **情境 3: 資料庫錯誤與未登入**
+	t.Run("無法預留", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+		mockRepo.On("GetMemberQuota", "m1").Return(nil, nil).Once()
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(1), mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		_, err := usecase.ReserveUpload(ctx, "m1", "member", 1)
+		assert.EqualError(t, err, "memberID[m1] 預留上傳配額失敗: db down")
+
+		_, err = usecase.ReserveUpload(ctx, "", "member", 1)
+		assert.EqualError(t, err, "需登入才能上傳影片")
+	})
+}
+
+func TestSetMemberQuota(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 管理員設定會員配額**
+	t.Run("管理員", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+		limits := domain.QuotaLimits{MaxStorageBytes: 2000, MaxVideos: 10}
+		mockRepo.On("SetMemberQuota", &domain.MemberQuota{MemberID: "m1", MaxStorageBytes: 2000, MaxVideos: 10}).Return(nil).Once()
+
+		quota, err := usecase.SetMemberQuota(ctx, domain.SetMemberQuotaReq{MemberID: "m1", Role: "admin", Limits: limits})
+
+		assert.NoError(t, err)
+		assert.Equal(t, limits, quota.Limits())
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 非管理員與負數配額**
+	t.Run("無法設定", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		usecase := NewQuotaUseCase(mockRepo, testQuotaPolicy)
+
+		_, err := usecase.SetMemberQuota(ctx, domain.SetMemberQuotaReq{MemberID: "m1", Role: "premium"})
+		assert.EqualError(t, err, "僅管理員可設定會員配額")
+
+		_, err = usecase.SetMemberQuota(ctx, domain.SetMemberQuotaReq{MemberID: "m1", Role: "admin",
+			Limits: domain.QuotaLimits{MaxVideos: -1}})
+		assert.EqualError(t, err, "memberID[m1] 配額不可為負數")
+		mockRepo.AssertNotCalled(t, "SetMemberQuota", mock.Anything)
+	})
+}
+
+// quotaUploadStream 模擬上傳影片的 client stream，記錄服務端讀取的訊息數
+type quotaUploadStream struct {
+	grpc.ServerStream
+	requests []*streaming_pb.UploadVideoReq
+	received int
+	response *streaming_pb.UploadVideoRes
+}
+
+func (s *quotaUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *quotaUploadStream) Recv() (*streaming_pb.UploadVideoReq, error) {
+	if s.received == len(s.requests) {
+		return nil, io.EOF
+	}
+	s.received++
+	return s.requests[s.received-1], nil
+}
+
+func (s *quotaUploadStream) SendAndClose(res *streaming_pb.UploadVideoRes) error {
+	s.response = res
+	return nil
+}
+
+// uploadOnlyUseCase 只實作 UploadVideo 的 StreamingUseCase
+type uploadOnlyUseCase struct {
+	StreamingUseCase
+	mock.Mock
+}
+
+func (u *uploadOnlyUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.UploadVideoRes, error) {
+	args := u.Called(up)
+	return args.Get(0).(*domain.UploadVideoRes), args.Error(1)
+}
+
+func TestUploadVideoQuota(t *testing.T) {
+	logger.SetNewNop()
+	chunk := &streaming_pb.UploadVideoReq{Data: &streaming_pb.UploadVideoReq_Chunk{Chunk: &streaming_pb.VideoChunk{Content: []byte("video")}}}
+	metadata := func(size int64) *streaming_pb.UploadVideoReq {
+		return &streaming_pb.UploadVideoReq{Data: &streaming_pb.UploadVideoReq_Metadata{Metadata: &streaming_pb.VideoMetadata{
+			Title: "t", FileName: "a.mp4", MemberId: "m1", Role: "member", SizeBytes: size,
+		}}}
+	}
+	newServer := func(mockRepo *MockQuotaRepo, mockUsecase *uploadOnlyUseCase) *StreamingGRPCServer {
+		mockRepo.On("GetMemberQuota", "m1").Return(nil, nil)
+		return &StreamingGRPCServer{Usecase: mockUsecase, QuotaUsecase: NewQuotaUseCase(mockRepo, testQuotaPolicy)}
+	}
+
+	// **情境 1: 宣告的大小無法預留，不接收檔案**
+	t.Run("收到元資料時拒絕", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		server := newServer(mockRepo, new(uploadOnlyUseCase))
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(200), mock.Anything).
+			Return(nil, &domain.QuotaExceededError{Kind: domain.QuotaStorage}).Once()
+		stream := &quotaUploadStream{requests: []*streaming_pb.UploadVideoReq{metadata(200), chunk}}
+
+		assert.NoError(t, server.UploadVideo(stream))
+		assert.Equal(t, 1, stream.received)
+		assert.False(t, stream.response.Success)
+		assert.Equal(t, string(domain.QuotaStorage), stream.response.QuotaExceeded)
+		mockRepo.AssertNotCalled(t, "ReleaseUpload", mock.Anything)
+	})
+
+	// **情境 2: 實際大小超過配額時拒絕並歸還預留的配額**
+	t.Run("收完檔案後拒絕", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		mockUsecase := new(uploadOnlyUseCase)
+		server := newServer(mockRepo, mockUsecase)
+		record := &domain.UploadRecord{ID: 3, MemberID: "m1"}
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(0), mock.Anything).Return(record, nil).Once()
+		mockRepo.On("ResizeUpload", record, int64(5), testQuotaPolicy.Default).
+			Return(&domain.QuotaExceededError{Kind: domain.QuotaStorage}).Once()
+		mockRepo.On("ReleaseUpload", record).Return(nil).Once()
+		stream := &quotaUploadStream{requests: []*streaming_pb.UploadVideoReq{metadata(0), chunk}}
+
+		assert.NoError(t, server.UploadVideo(stream))
+		assert.Equal(t, string(domain.QuotaStorage), stream.response.QuotaExceeded)
+		mockUsecase.AssertNotCalled(t, "UploadVideo", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 建立影片失敗時歸還預留的配額**
+	t.Run("建立影片失敗", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		mockUsecase := new(uploadOnlyUseCase)
+		server := newServer(mockRepo, mockUsecase)
+		record := &domain.UploadRecord{ID: 3, MemberID: "m1", SizeBytes: 5}
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(5), mock.Anything).Return(record, nil).Once()
+		mockRepo.On("ResizeUpload", record, int64(5), testQuotaPolicy.Default).Return(nil).Once()
+		mockUsecase.On("UploadVideo", mock.Anything).Return((*domain.UploadVideoRes)(nil), errors.New("minio down")).Once()
+		mockRepo.On("ReleaseUpload", record).Return(nil).Once()
+		stream := &quotaUploadStream{requests: []*streaming_pb.UploadVideoReq{metadata(5), chunk}}
+
+		assert.NoError(t, server.UploadVideo(stream))
+		assert.False(t, stream.response.Success)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 上傳完成後將預留的記錄關聯到影片，不歸還配額**
+	t.Run("關聯影片", func(t *testing.T) {
+		mockRepo := new(MockQuotaRepo)
+		mockUsecase := new(uploadOnlyUseCase)
+		server := newServer(mockRepo, mockUsecase)
+		record := &domain.UploadRecord{ID: 3, MemberID: "m1", SizeBytes: 5}
+		mockRepo.On("ReserveUpload", "m1", testQuotaPolicy.Default, int64(5), mock.Anything).Return(record, nil).Once()
+		mockRepo.On("ResizeUpload", record, int64(5), testQuotaPolicy.Default).Return(nil).Once()
+		mockUsecase.On("UploadVideo", mock.Anything).Return(&domain.UploadVideoRes{Message: "ok", VideoID: 7}, nil).Once()
+		mockRepo.On("CompleteUpload", uint(3), uint(7)).Return(nil).Once()
+		stream := &quotaUploadStream{requests: []*streaming_pb.UploadVideoReq{metadata(5), chunk}}
+
+		assert.NoError(t, server.UploadVideo(stream))
+		assert.True(t, stream.response.Success)
+		assert.Empty(t, stream.response.QuotaExceeded)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "ReleaseUpload", mock.Anything)
+	})
+}
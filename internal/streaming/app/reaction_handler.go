@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// ReactToVideo 實作 按讚 / 倒讚 / 清除表態
+func (s *StreamingGRPCServer) ReactToVideo(ctx context.Context, req *streaming_pb.ReactToVideoReq) (*streaming_pb.ReactToVideoRes, error) {
+	reactions, err := s.ReactionUsecase.ReactToVideo(ctx, uint(req.VideoId), req.MemberId, domain.ReactionType(req.Reaction))
+	if err != nil {
+		return &streaming_pb.ReactToVideoRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ReactToVideoRes{
+		Success:      true,
+		LikeCount:    reactions.Likes,
+		DislikeCount: reactions.Dislikes,
+		MyReaction:   string(reactions.MyReaction),
+	}, nil
+}
+
+// GetVideoReactions 實作 取得影片按讚 / 倒讚數
+func (s *StreamingGRPCServer) GetVideoReactions(ctx context.Context, req *streaming_pb.GetVideoReactionsReq) (*streaming_pb.GetVideoReactionsRes, error) {
+	reactions, err := s.ReactionUsecase.GetVideoReactions(ctx, uint(req.VideoId), req.MemberId)
+	if err != nil {
+		return &streaming_pb.GetVideoReactionsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.GetVideoReactionsRes{
+		Success:      true,
+		LikeCount:    reactions.Likes,
+		DislikeCount: reactions.Dislikes,
+		MyReaction:   string(reactions.MyReaction),
+	}, nil
+}
+
+// ListLikedVideos 實作 列出按讚過的影片
+func (s *StreamingGRPCServer) ListLikedVideos(ctx context.Context, req *streaming_pb.ListLikedVideosReq) (*streaming_pb.ListLikedVideosRes, error) {
+	videos, total, err := s.ReactionUsecase.ListLikedVideos(ctx, req.MemberId, domain.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return &streaming_pb.ListLikedVideosRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ListLikedVideosRes{
+		Success: true,
+		Video:   toSearchFeedBack(videos),
+		Total:   total,
+	}, nil
+}
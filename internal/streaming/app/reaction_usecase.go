@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+)
+
+// ReactionUseCase 影片按讚 / 倒讚
+type ReactionUseCase interface {
+	ReactToVideo(ctx context.Context, videoID uint, memberID string, reaction domain.ReactionType) (*domain.VideoReactions, error)
+	GetVideoReactions(ctx context.Context, videoID uint, memberID string) (*domain.VideoReactions, error)
+	ListLikedVideos(ctx context.Context, memberID string, page domain.Pagination) ([]domain.Video, int64, error)
+}
+
+type reactionUseCase struct {
+	ReactionRepo  repository.ReactionRepo
+	ReactionCache repository.ReactionCache
+	VideoRepo     repository.VideoRepo
+}
+
+// NewReactionUseCase 建立 ReactionUseCase
+func NewReactionUseCase(reactionRepo repository.ReactionRepo, reactionCache repository.ReactionCache, videoRepo repository.VideoRepo) ReactionUseCase {
+	return &reactionUseCase{
+		ReactionRepo:  reactionRepo,
+		ReactionCache: reactionCache,
+		VideoRepo:     videoRepo,
+	}
+}
+
+// ReactToVideo 對可觀看的 ready 影片按讚、倒讚或清除表態（reaction 為空值）
+// 重複送出相同的表態不會重複計數
+func (r *reactionUseCase) ReactToVideo(ctx context.Context, videoID uint, memberID string, reaction domain.ReactionType) (*domain.VideoReactions, error) {
+	if memberID == "" {
+		errMsg := fmt.Sprintf("videoID[%d] 需登入才能按讚", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !reaction.IsValid() {
+		errMsg := fmt.Sprintf("videoID[%d] 不支援的表態: %s", videoID, reaction)
+		return nil, errprocess.Set(errMsg)
+	}
+	video, err := getWatchableVideo(r.VideoRepo, videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Status != string(domain.VideoReady) {
+		errMsg := fmt.Sprintf("videoID[%d] 影片尚未就緒，無法按讚", videoID)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	prev, err := r.ReactionRepo.SetReaction(videoID, memberID, reaction)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 設定表態失敗: %v", videoID, memberID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	// 寫入 PostgreSQL 後刪除快取而非累加，避免與重建快取的請求互相覆蓋
+	if prev != reaction {
+		if err := r.ReactionCache.Invalidate(ctx, videoID); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 清除按讚數快取失敗:", videoID), err)
+		}
+	}
+
+	counts, err := r.counts(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.VideoReactions{
+		ReactionCounts: *counts,
+		MyReaction:     reaction,
+	}, nil
+}
+
+// GetVideoReactions 取得影片的按讚 / 倒讚數，以及 memberID 自己的表態
+func (r *reactionUseCase) GetVideoReactions(ctx context.Context, videoID uint, memberID string) (*domain.VideoReactions, error) {
+	if _, err := getWatchableVideo(r.VideoRepo, videoID, memberID); err != nil {
+		return nil, err
+	}
+
+	counts, err := r.counts(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	reactions := &domain.VideoReactions{ReactionCounts: *counts}
+	if memberID != "" {
+		if reactions.MyReaction, err = r.ReactionRepo.GetReaction(videoID, memberID); err != nil {
+			errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 取得表態失敗: %v", videoID, memberID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+	}
+	return reactions, nil
+}
+
+// ListLikedVideos 分頁列出會員按讚過的影片，最近按讚的排在最前面
+func (r *reactionUseCase) ListLikedVideos(ctx context.Context, memberID string, page domain.Pagination) ([]domain.Video, int64, error) {
+	if memberID == "" {
+		return nil, 0, errprocess.Set("需登入才能查看按讚的影片")
+	}
+	page = page.Normalize()
+	videos, total, err := r.ReactionRepo.ListLikedVideos(memberID, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("memberID[%s] page[%d] 取得按讚影片失敗: %v", memberID, page.Page, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	return videos, total, nil
+}
+
+// counts 優先由 Redis 取得按讚數，快取不存在或 Redis 異常時改查 PostgreSQL 並重建快取
+// 重建只在快取不存在時寫入，不覆蓋其他請求已重建的值
+func (r *reactionUseCase) counts(ctx context.Context, videoID uint) (*domain.ReactionCounts, error) {
+	counts, err := r.ReactionCache.Get(ctx, videoID)
+	if err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 讀取按讚數快取失敗:", videoID), err)
+	}
+	if counts != nil {
+		return counts, nil
+	}
+
+	counts, err = r.ReactionRepo.GetCounts(videoID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 取得按讚數失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := r.ReactionCache.SetNX(ctx, videoID, *counts); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 寫入按讚數快取失敗:", videoID), err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,268 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReactionRepo 按讚儲存庫的 Mock
+type MockReactionRepo struct {
+	mock.Mock
+}
+
+func (m *MockReactionRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockReactionRepo) SetReaction(videoID uint, memberID string, reaction domain.ReactionType) (domain.ReactionType, error) {
+	args := m.Called(videoID, memberID, reaction)
+	return args.Get(0).(domain.ReactionType), args.Error(1)
+}
+
+func (m *MockReactionRepo) GetReaction(videoID uint, memberID string) (domain.ReactionType, error) {
+	args := m.Called(videoID, memberID)
+	return args.Get(0).(domain.ReactionType), args.Error(1)
+}
+
+func (m *MockReactionRepo) GetCounts(videoID uint) (*domain.ReactionCounts, error) {
+	args := m.Called(videoID)
+	return args.Get(0).(*domain.ReactionCounts), args.Error(1)
+}
+
+func (m *MockReactionRepo) ListLikedVideos(memberID string, offset, limit int) ([]domain.Video, int64, error) {
+	args := m.Called(memberID, offset, limit)
+	return args.Get(0).([]domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockReactionRepo) HourlyLikes(since time.Time) ([]domain.HourlyActivity, error) {
+	args := m.Called(since)
+	return args.Get(0).([]domain.HourlyActivity), args.Error(1)
+}
+
+// MockReactionCache 按讚數快取的 Mock
+type MockReactionCache struct {
+	mock.Mock
+}
+
+func (m *MockReactionCache) Get(ctx context.Context, videoID uint) (*domain.ReactionCounts, error) {
+	args := m.Called(ctx, videoID)
+	return args.Get(0).(*domain.ReactionCounts), args.Error(1)
+}
+
+func (m *MockReactionCache) SetNX(ctx context.Context, videoID uint, counts domain.ReactionCounts) error {
+	args := m.Called(ctx, videoID, counts)
+	return args.Error(0)
+}
+
+func (m *MockReactionCache) Invalidate(ctx context.Context, videoID uint) error {
+	args := m.Called(ctx, videoID)
+	return args.Error(0)
+}
+
+func TestReactToVideo(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	readyVideo := &domain.Video{ID: 1, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+
+	// **情境 1: 倒讚改為按讚，寫入後清除快取**
+	t.Run("倒讚改為按讚", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockCache := new(MockReactionCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, mockCache, mockVideo)
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockReaction.On("SetReaction", uint(1), "member", domain.ReactionLike).Return(domain.ReactionDislike, nil).Once()
+		mockCache.On("Invalidate", ctx, uint(1)).Return(nil).Once()
+		mockCache.On("Get", ctx, uint(1)).Return(&domain.ReactionCounts{Likes: 5, Dislikes: 2}, nil).Once()
+
+		reactions, err := usecase.ReactToVideo(ctx, 1, "member", domain.ReactionLike)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), reactions.Likes)
+		assert.Equal(t, int64(2), reactions.Dislikes)
+		assert.Equal(t, domain.ReactionLike, reactions.MyReaction)
+		mockReaction.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	// **情境 2: 重複按讚不更新快取**
+	t.Run("重複按讚不更新快取", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockCache := new(MockReactionCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, mockCache, mockVideo)
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockReaction.On("SetReaction", uint(1), "member", domain.ReactionLike).Return(domain.ReactionLike, nil).Once()
+		mockCache.On("Get", ctx, uint(1)).Return(&domain.ReactionCounts{Likes: 5}, nil).Once()
+
+		reactions, err := usecase.ReactToVideo(ctx, 1, "member", domain.ReactionLike)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), reactions.Likes)
+		mockCache.AssertNotCalled(t, "Invalidate", mock.Anything, mock.Anything)
+	})
+
+	// **情境 3: 快取不存在時由 PostgreSQL 重建**
+	t.Run("快取不存在時由 PostgreSQL 重建", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockCache := new(MockReactionCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, mockCache, mockVideo)
+
+		counts := &domain.ReactionCounts{Likes: 0, Dislikes: 0}
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockReaction.On("SetReaction", uint(1), "member", domain.ReactionNone).Return(domain.ReactionLike, nil).Once()
+		mockCache.On("Invalidate", ctx, uint(1)).Return(nil).Once()
+		mockCache.On("Get", ctx, uint(1)).Return((*domain.ReactionCounts)(nil), nil).Once()
+		mockReaction.On("GetCounts", uint(1)).Return(counts, nil).Once()
+		mockCache.On("SetNX", ctx, uint(1), *counts).Return(nil).Once()
+
+		reactions, err := usecase.ReactToVideo(ctx, 1, "member", domain.ReactionNone)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReactionNone, reactions.MyReaction)
+		mockReaction.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	// **情境 4: 未登入不可按讚**
+	t.Run("未登入不可按讚", func(t *testing.T) {
+		usecase := NewReactionUseCase(new(MockReactionRepo), new(MockReactionCache), new(MockVideoRepo))
+
+		reactions, err := usecase.ReactToVideo(ctx, 1, "", domain.ReactionLike)
+
+		assert.Error(t, err)
+		assert.Nil(t, reactions)
+		assert.Equal(t, "videoID[1] 需登入才能按讚", err.Error())
+	})
+
+	// **情境 5: 不支援的表態**
+	t.Run("不支援的表態", func(t *testing.T) {
+		usecase := NewReactionUseCase(new(MockReactionRepo), new(MockReactionCache), new(MockVideoRepo))
+
+		reactions, err := usecase.ReactToVideo(ctx, 1, "member", domain.ReactionType("love"))
+
+		assert.Error(t, err)
+		assert.Nil(t, reactions)
+		assert.Equal(t, "videoID[1] 不支援的表態: love", err.Error())
+	})
+
+	// **情境 6: 無權限觀看的私人影片不可按讚**
+	t.Run("私人影片不可按讚", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, new(MockReactionCache), mockVideo)
+
+		privateVideo := &domain.Video{ID: 2, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate)}
+		mockVideo.On("GetByID", uint(2)).Return(privateVideo, nil).Once()
+		mockVideo.On("IsSharedWith", uint(2), "member").Return(false, nil).Once()
+
+		reactions, err := usecase.ReactToVideo(ctx, 2, "member", domain.ReactionLike)
+
+		assert.Error(t, err)
+		assert.Nil(t, reactions)
+		assert.Equal(t, "videoID[2] memberID[member] 無權限觀看此影片", err.Error())
+		mockReaction.AssertNotCalled(t, "SetReaction", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetVideoReactions(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	readyVideo := &domain.Video{ID: 1, MemberID: "owner", Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+
+	// **情境 1: 登入會員取得自己的表態**
+	t.Run("登入會員取得自己的表態", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockCache := new(MockReactionCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, mockCache, mockVideo)
+
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockCache.On("Get", ctx, uint(1)).Return(&domain.ReactionCounts{Likes: 3, Dislikes: 1}, nil).Once()
+		mockReaction.On("GetReaction", uint(1), "member").Return(domain.ReactionDislike, nil).Once()
+
+		reactions, err := usecase.GetVideoReactions(ctx, 1, "member")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), reactions.Likes)
+		assert.Equal(t, domain.ReactionDislike, reactions.MyReaction)
+	})
+
+	// **情境 2: Redis 異常時改查 PostgreSQL，訪客不查詢表態**
+	t.Run("Redis 異常時改查 PostgreSQL", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		mockCache := new(MockReactionCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewReactionUseCase(mockReaction, mockCache, mockVideo)
+
+		counts := &domain.ReactionCounts{Likes: 7}
+		mockVideo.On("GetByID", uint(1)).Return(readyVideo, nil).Once()
+		mockCache.On("Get", ctx, uint(1)).Return((*domain.ReactionCounts)(nil), errors.New("redis down")).Once()
+		mockReaction.On("GetCounts", uint(1)).Return(counts, nil).Once()
+		mockCache.On("SetNX", ctx, uint(1), *counts).Return(errors.New("redis down")).Once()
+
+		reactions, err := usecase.GetVideoReactions(ctx, 1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), reactions.Likes)
+		assert.Equal(t, domain.ReactionNone, reactions.MyReaction)
+		mockReaction.AssertNotCalled(t, "GetReaction", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListLikedVideos(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 分頁參數正規化**
+	t.Run("分頁參數正規化", func(t *testing.T) {
+		mockReaction := new(MockReactionRepo)
+		usecase := NewReactionUseCase(mockReaction, new(MockReactionCache), new(MockVideoRepo))
+
+		mockReaction.On("ListLikedVideos", "member", 20, domain.DefaultPageSize).
+			Return([]domain.Video{{ID: 1}}, int64(21), nil).Once()
+
+		videos, total, err := usecase.ListLikedVideos(ctx, "member", domain.Pagination{Page: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, videos, 1)
+		assert.Equal(t, int64(21), total)
+		mockReaction.AssertExpectations(t)
+	})
+
+	// **情境 2: 未登入**
+	t.Run("未登入", func(t *testing.T) {
+		usecase := NewReactionUseCase(new(MockReactionRepo), new(MockReactionCache), new(MockVideoRepo))
+
+		videos, _, err := usecase.ListLikedVideos(ctx, "", domain.Pagination{})
+
+		assert.Error(t, err)
+		assert.Nil(t, videos)
+	})
+}
+
+func TestReactionDelta(t *testing.T) {
+	likes, dislikes := domain.ReactionDelta(domain.ReactionNone, domain.ReactionLike)
+	assert.Equal(t, int64(1), likes)
+	assert.Equal(t, int64(0), dislikes)
+
+	likes, dislikes = domain.ReactionDelta(domain.ReactionLike, domain.ReactionDislike)
+	assert.Equal(t, int64(-1), likes)
+	assert.Equal(t, int64(1), dislikes)
+
+	likes, dislikes = domain.ReactionDelta(domain.ReactionDislike, domain.ReactionDislike)
+	assert.Equal(t, int64(0), likes)
+	assert.Equal(t, int64(0), dislikes)
+}
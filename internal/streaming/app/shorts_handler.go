@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// GetShortsFeed 實作 取得短影音動態
+func (s *StreamingGRPCServer) GetShortsFeed(ctx context.Context, req *streaming_pb.GetShortsFeedReq) (*streaming_pb.GetShortsFeedRes, error) {
+	maxRating := toViewer(req.MemberId, req.ViewerAge).MaxRating(time.Now())
+	feed, err := s.ShortsUsecase.GetShortsFeed(ctx, req.MemberId, req.Cursor, maxRating, int(req.Size))
+	if err != nil {
+		return &streaming_pb.GetShortsFeedRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	videos := make([]domain.Video, len(feed.Items))
+	for index, item := range feed.Items {
+		videos[index] = item.Video
+	}
+	feedBack := toSearchFeedBack(videos)
+	items := make([]*streaming_pb.ShortsItem, len(feed.Items))
+	for index, item := range feed.Items {
+		items[index] = &streaming_pb.ShortsItem{
+			Video:           feedBack[index],
+			Source:          string(item.Source),
+			HlsUrl:          item.HlsURL,
+			PosterUrl:       item.PosterURL,
+			FirstSegmentUrl: item.FirstSegmentURL,
+		}
+	}
+	return &streaming_pb.GetShortsFeedRes{
+		Success:    true,
+		Items:      items,
+		NextCursor: feed.NextCursor,
+	}, nil
+}
+
+// FollowChannel 實作 追蹤頻道
+func (s *StreamingGRPCServer) FollowChannel(ctx context.Context, req *streaming_pb.FollowChannelReq) (*streaming_pb.FollowChannelRes, error) {
+	if err := s.FollowUsecase.FollowChannel(ctx, req.MemberId, req.ChannelId); err != nil {
+		return &streaming_pb.FollowChannelRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.FollowChannelRes{Success: true}, nil
+}
+
+// UnfollowChannel 實作 取消追蹤頻道
+func (s *StreamingGRPCServer) UnfollowChannel(ctx context.Context, req *streaming_pb.FollowChannelReq) (*streaming_pb.FollowChannelRes, error) {
+	if err := s.FollowUsecase.UnfollowChannel(ctx, req.MemberId, req.ChannelId); err != nil {
+		return &streaming_pb.FollowChannelRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.FollowChannelRes{Success: true}, nil
+}
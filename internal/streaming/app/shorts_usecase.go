@@ -0,0 +1,276 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// maxShortsFetches 每個來源每次最多抓取幾批，避免已看過的影片太多時整張表掃過一遍
+const maxShortsFetches = 3
+
+// ShortsUseCase 短影音動態
+type ShortsUseCase interface {
+	GetShortsFeed(ctx context.Context, memberID, cursor string, maxRating domain.ContentRating, size int) (*domain.ShortsFeed, error)
+}
+
+type shortsUseCase struct {
+	VideoRepo     repository.VideoRepo
+	FollowRepo    repository.FollowRepo
+	TrendingCache repository.TrendingCache
+	SeenCache     repository.ShortsSeenCache
+	PlaybackURLs  *domain.PlaybackURLBuilder // 播放網址，nil 使用預設的 gateway
+}
+
+// NewShortsUseCase 建立 ShortsUseCase
+func NewShortsUseCase(videoRepo repository.VideoRepo, followRepo repository.FollowRepo,
+	trendingCache repository.TrendingCache, seenCache repository.ShortsSeenCache,
+	playbackURLs *domain.PlaybackURLBuilder) ShortsUseCase {
+	return &shortsUseCase{
+		VideoRepo:     videoRepo,
+		FollowRepo:    followRepo,
+		TrendingCache: trendingCache,
+		SeenCache:     seenCache,
+		PlaybackURLs:  playbackURLs,
+	}
+}
+
+// shortsCandidate 某個來源中尚未取用的影片，prev 為取用前該來源的位置
+type shortsCandidate struct {
+	video domain.Video
+	prev  int
+}
+
+// shortsQueue 單一來源的候選影片
+//   - followed / fresh：位置為 BeforeID，依 ID 由新到舊
+//   - trending：位置為排行榜的 offset
+type shortsQueue struct {
+	source     domain.ShortsSource
+	pos        int // 已抓取到的位置
+	candidates []shortsCandidate
+	exhausted  bool
+	fetches    int
+}
+
+// cursor 目前該來源已看過的位置：還有候選影片時為第一部候選影片之前，否則為已抓取到的位置
+func (q *shortsQueue) cursor() int {
+	if len(q.candidates) > 0 {
+		return q.candidates[0].prev
+	}
+	return q.pos
+}
+
+// GetShortsFeed 取得一頁短影音動態，依 domain.ShortsMix 輪流穿插追蹤頻道、熱門與最新的短影音
+// 已出現過的短影音會記錄下來（會員依 member_id，訪客依游標中的 session），不會重複出現；
+// 全部看完時清除紀錄從頭開始，動態不會結束；只列出 maxRating（含）以下的分級
+func (s *shortsUseCase) GetShortsFeed(ctx context.Context, memberID, cursor string, maxRating domain.ContentRating, size int) (*domain.ShortsFeed, error) {
+	feedCursor, err := domain.DecodeShortsFeedCursor(cursor)
+	if err != nil {
+		errMsg := fmt.Sprintf("cursor[%s] 游標格式錯誤: %v", cursor, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if size < 1 || size > domain.MaxShortsFeedSize {
+		size = domain.DefaultShortsFeedSize
+	}
+	viewer := memberID
+	if memberID != "" {
+		feedCursor.Session = ""
+	} else {
+		if feedCursor.Session == "" {
+			feedCursor.Session = uuid.NewString()
+		}
+		viewer = "guest:" + feedCursor.Session
+	}
+
+	var following []string
+	if memberID != "" {
+		if following, err = s.FollowRepo.ListFollowing(memberID); err != nil {
+			errMsg := fmt.Sprintf("memberID[%s] 取得追蹤頻道失敗: %v", memberID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+	}
+
+	items, queues, err := s.collect(ctx, viewer, following, maxRating, feedCursor, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 && allExhausted(queues) {
+		// 全部看完：清除已看過的紀錄並從頭開始
+		if err := s.SeenCache.Reset(ctx, viewer); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("viewer[%s] 清除已看過的短影音失敗:", viewer), err)
+		}
+		feedCursor = feedCursor.Restart()
+		if items, queues, err = s.collect(ctx, viewer, following, maxRating, feedCursor, size); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]uint, len(items))
+	for index, item := range items {
+		ids[index] = item.Video.ID
+	}
+	if err := s.SeenCache.Add(ctx, viewer, ids, domain.ShortsSeenTTL); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("viewer[%s] 記錄已看過的短影音失敗:", viewer), err)
+	}
+
+	for _, q := range queues {
+		switch q.source {
+		case domain.ShortsFollowed:
+			feedCursor.FollowedBefore = uint(q.cursor())
+		case domain.ShortsTrending:
+			feedCursor.TrendingOffset = q.cursor()
+		case domain.ShortsFresh:
+			feedCursor.FreshBefore = uint(q.cursor())
+		}
+	}
+	return &domain.ShortsFeed{
+		Items:      items,
+		NextCursor: feedCursor.Encode(),
+	}, nil
+}
+
+// collect 由各來源輪流取用未看過的短影音，直到湊滿 size 或所有來源都沒有影片
+func (s *shortsUseCase) collect(ctx context.Context, viewer string, following []string, maxRating domain.ContentRating,
+	cursor domain.ShortsFeedCursor, size int) ([]domain.ShortsItem, []*shortsQueue, error) {
+	queues := make([]*shortsQueue, len(domain.ShortsMix))
+	for index, source := range domain.ShortsMix {
+		q := &shortsQueue{source: source}
+		switch source {
+		case domain.ShortsFollowed:
+			q.pos = int(cursor.FollowedBefore)
+			q.exhausted = len(following) == 0
+		case domain.ShortsTrending:
+			q.pos = cursor.TrendingOffset
+		case domain.ShortsFresh:
+			q.pos = int(cursor.FreshBefore)
+		}
+		queues[index] = q
+	}
+
+	items := make([]domain.ShortsItem, 0, size)
+	picked := map[uint]bool{}
+	for len(items) < size {
+		progressed := false
+		for _, q := range queues {
+			if len(items) >= size {
+				break
+			}
+			for len(q.candidates) == 0 && !q.exhausted && q.fetches < maxShortsFetches {
+				if err := s.fetch(ctx, q, viewer, following, maxRating, size); err != nil {
+					return nil, nil, err
+				}
+			}
+			if len(q.candidates) == 0 {
+				continue
+			}
+			candidate := q.candidates[0]
+			q.candidates = q.candidates[1:]
+			progressed = true
+			// 同時出現在多個來源（例如追蹤頻道的熱門影片）時只取用一次
+			if picked[candidate.video.ID] {
+				continue
+			}
+			picked[candidate.video.ID] = true
+			items = append(items, s.toShortsItem(candidate.video, q.source))
+		}
+		if !progressed {
+			break
+		}
+	}
+	return items, queues, nil
+}
+
+// fetch 由來源抓取下一批影片，排除已看過的影片後加入候選
+func (s *shortsUseCase) fetch(ctx context.Context, q *shortsQueue, viewer string, following []string,
+	maxRating domain.ContentRating, limit int) error {
+	q.fetches++
+	var candidates []shortsCandidate
+	switch q.source {
+	case domain.ShortsFollowed, domain.ShortsFresh:
+		query := domain.ShortsQuery{BeforeID: uint(q.pos), MaxRating: maxRating, Limit: limit}
+		if q.source == domain.ShortsFollowed {
+			query.MemberIDs = following
+		}
+		videos, err := s.VideoRepo.ListShorts(query)
+		if err != nil {
+			errMsg := fmt.Sprintf("source[%s] before[%d] 取得短影音失敗: %v", q.source, q.pos, err)
+			return errprocess.Set(errMsg)
+		}
+		for _, video := range videos {
+			candidates = append(candidates, shortsCandidate{video: video, prev: int(video.ID) + 1})
+		}
+		if len(videos) > 0 {
+			q.pos = int(videos[len(videos)-1].ID)
+		}
+		q.exhausted = len(videos) < limit
+	case domain.ShortsTrending:
+		key := domain.TrendingKey(0, domain.VideoTypeShort)
+		ids, _, err := s.TrendingCache.Top(ctx, key, q.pos, limit)
+		if err != nil {
+			// 排行榜異常時略過熱門來源，其他來源仍可提供影片
+			logger.Log.Errorf(fmt.Sprintf("key[%s] offset[%d] 取得熱門短影音失敗:", key, q.pos), err)
+			q.exhausted = true
+			return nil
+		}
+		if len(ids) > 0 {
+			videos, err := s.VideoRepo.GetByIDs(ids)
+			if err != nil {
+				errMsg := fmt.Sprintf("key[%s] offset[%d] 取得熱門短影音失敗: %v", key, q.pos, err)
+				return errprocess.Set(errMsg)
+			}
+			byID := make(map[uint]domain.Video, len(videos))
+			for _, video := range videos {
+				byID[video.ID] = video
+			}
+			for rank, id := range ids {
+				if video, ok := byID[id]; ok && video.IsListed() && video.Type == domain.VideoTypeShort && maxRating.Allows(video.Rating()) {
+					candidates = append(candidates, shortsCandidate{video: video, prev: q.pos + rank})
+				}
+			}
+		}
+		q.pos += len(ids)
+		q.exhausted = len(ids) < limit
+	}
+
+	ids := make([]uint, len(candidates))
+	for index, candidate := range candidates {
+		ids[index] = candidate.video.ID
+	}
+	seen, err := s.SeenCache.Seen(ctx, viewer, ids)
+	if err != nil {
+		logger.Log.Errorf(fmt.Sprintf("viewer[%s] 取得已看過的短影音失敗:", viewer), err)
+	}
+	for _, candidate := range candidates {
+		if !seen[candidate.video.ID] {
+			q.candidates = append(q.candidates, candidate)
+		}
+	}
+	return nil
+}
+
+func allExhausted(queues []*shortsQueue) bool {
+	for _, q := range queues {
+		if !q.exhausted || len(q.candidates) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// toShortsItem 附上播放、封面與第一個分段的網址，客戶端可預先載入下一部影片
+func (s *shortsUseCase) toShortsItem(video domain.Video, source domain.ShortsSource) domain.ShortsItem {
+	urls := s.PlaybackURLs.AssetURLs(&video, domain.PlaylistFileName, video.PosterFile(), domain.ShortsFirstSegment)
+	return domain.ShortsItem{
+		Video:           video,
+		Source:          source,
+		HlsURL:          urls[0],
+		PosterURL:       urls[1],
+		FirstSegmentURL: urls[2],
+	}
+}
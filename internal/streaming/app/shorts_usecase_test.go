@@ -0,0 +1,197 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFollowRepo 追蹤頻道儲存庫的 Mock
+type MockFollowRepo struct {
+	mock.Mock
+}
+
+func (m *MockFollowRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockFollowRepo) Follow(memberID, channelID string) error {
+	args := m.Called(memberID, channelID)
+	return args.Error(0)
+}
+
+func (m *MockFollowRepo) Unfollow(memberID, channelID string) error {
+	args := m.Called(memberID, channelID)
+	return args.Error(0)
+}
+
+func (m *MockFollowRepo) ListFollowing(memberID string) ([]string, error) {
+	args := m.Called(memberID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockShortsSeenCache 已看過短影音紀錄的 Mock
+type MockShortsSeenCache struct {
+	mock.Mock
+}
+
+func (m *MockShortsSeenCache) Seen(ctx context.Context, viewer string, videoIDs []uint) (map[uint]bool, error) {
+	args := m.Called(ctx, viewer, videoIDs)
+	return args.Get(0).(map[uint]bool), args.Error(1)
+}
+
+func (m *MockShortsSeenCache) Add(ctx context.Context, viewer string, videoIDs []uint, ttl time.Duration) error {
+	args := m.Called(ctx, viewer, videoIDs, ttl)
+	return args.Error(0)
+}
+
+func (m *MockShortsSeenCache) Reset(ctx context.Context, viewer string) error {
+	args := m.Called(ctx, viewer)
+	return args.Error(0)
+}
+
+func short(id uint, memberID string) domain.Video {
+	return domain.Video{ID: id, MemberID: memberID, Type: domain.VideoTypeShort,
+		Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)}
+}
+
+func TestGetShortsFeed(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	trendingKey := domain.TrendingKey(0, domain.VideoTypeShort)
+
+	// **情境 1: 依追蹤、熱門、最新輪流穿插，排除已看過、重複與超過觀看者分級的影片**
+	t.Run("穿插三種來源", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockFollow := new(MockFollowRepo)
+		mockTrending := new(MockTrendingCache)
+		mockSeen := new(MockShortsSeenCache)
+		usecase := NewShortsUseCase(mockVideo, mockFollow, mockTrending, mockSeen, nil)
+
+		mockFollow.On("ListFollowing", "member").Return([]string{"creator"}, nil).Once()
+		mockVideo.On("ListShorts", domain.ShortsQuery{MemberIDs: []string{"creator"}, MaxRating: domain.RatingTeen, Limit: 3}).
+			Return([]domain.Video{short(9, "creator")}, nil).Once()
+		adult := short(6, "other")
+		adult.ContentRating = string(domain.RatingAdult)
+		mockTrending.On("Top", ctx, trendingKey, 0, 3).Return([]uint{9, 6, 5}, int64(3), nil).Once()
+		mockVideo.On("GetByIDs", []uint{9, 6, 5}).Return([]domain.Video{short(9, "creator"), adult, short(5, "other")}, nil).Once()
+		mockVideo.On("ListShorts", domain.ShortsQuery{MaxRating: domain.RatingTeen, Limit: 3}).
+			Return([]domain.Video{short(9, "creator"), short(8, "other"), short(7, "other")}, nil).Once()
+		mockSeen.On("Seen", ctx, "member", []uint{9}).Return(map[uint]bool{}, nil).Once()
+		mockSeen.On("Seen", ctx, "member", []uint{9, 5}).Return(map[uint]bool{}, nil).Once()
+		mockSeen.On("Seen", ctx, "member", []uint{9, 8, 7}).Return(map[uint]bool{8: true}, nil).Once()
+		mockSeen.On("Add", ctx, "member", []uint{9, 5, 7}, domain.ShortsSeenTTL).Return(nil).Once()
+
+		feed, err := usecase.GetShortsFeed(ctx, "member", "", domain.RatingTeen, 3)
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Items, 3)
+		assert.Equal(t, uint(9), feed.Items[0].Video.ID)
+		assert.Equal(t, domain.ShortsFollowed, feed.Items[0].Source)
+		assert.Equal(t, uint(5), feed.Items[1].Video.ID)
+		assert.Equal(t, domain.ShortsTrending, feed.Items[1].Source)
+		assert.Equal(t, uint(7), feed.Items[2].Video.ID)
+		assert.Equal(t, domain.ShortsFresh, feed.Items[2].Source)
+		assert.Equal(t, domain.DefaultGatewayURL+"/video/hls/9/poster.jpg", feed.Items[0].PosterURL)
+		assert.Equal(t, domain.DefaultGatewayURL+"/video/hls/9/index0.ts", feed.Items[0].FirstSegmentURL)
+
+		cursor, err := domain.DecodeShortsFeedCursor(feed.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ShortsFeedCursor{FollowedBefore: 9, TrendingOffset: 3, FreshBefore: 7}, cursor)
+		mockVideo.AssertExpectations(t)
+		mockSeen.AssertExpectations(t)
+	})
+
+	// **情境 2: 訪客以游標中的 session 記錄已看過的影片，全部看完後從頭開始**
+	t.Run("訪客看完後從頭開始", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockTrending := new(MockTrendingCache)
+		mockSeen := new(MockShortsSeenCache)
+		usecase := NewShortsUseCase(mockVideo, new(MockFollowRepo), mockTrending, mockSeen, nil)
+
+		cursor := domain.ShortsFeedCursor{Session: "abc", TrendingOffset: 1, FreshBefore: 3}.Encode()
+		mockTrending.On("Top", ctx, trendingKey, 1, 2).Return([]uint{}, int64(1), nil).Once()
+		mockVideo.On("ListShorts", domain.ShortsQuery{BeforeID: 3, MaxRating: domain.RatingAll, Limit: 2}).Return([]domain.Video{}, nil).Once()
+		mockSeen.On("Seen", ctx, "guest:abc", []uint{}).Return(map[uint]bool{}, nil)
+		mockSeen.On("Reset", ctx, "guest:abc").Return(nil).Once()
+		mockTrending.On("Top", ctx, trendingKey, 0, 2).Return([]uint{}, int64(0), nil).Once()
+		mockVideo.On("ListShorts", domain.ShortsQuery{MaxRating: domain.RatingAll, Limit: 2}).Return([]domain.Video{short(3, "other")}, nil).Once()
+		mockSeen.On("Seen", ctx, "guest:abc", []uint{3}).Return(map[uint]bool{}, nil).Once()
+		mockSeen.On("Add", ctx, "guest:abc", []uint{3}, domain.ShortsSeenTTL).Return(nil).Once()
+
+		feed, err := usecase.GetShortsFeed(ctx, "", cursor, domain.RatingAll, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Items, 1)
+		assert.Equal(t, uint(3), feed.Items[0].Video.ID)
+		next, _ := domain.DecodeShortsFeedCursor(feed.NextCursor)
+		assert.Equal(t, "abc", next.Session)
+		assert.Equal(t, uint(3), next.FreshBefore)
+		mockSeen.AssertExpectations(t)
+	})
+
+	// **情境 3: 熱門排行榜異常時仍由其他來源提供影片**
+	t.Run("排行榜異常", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockTrending := new(MockTrendingCache)
+		mockSeen := new(MockShortsSeenCache)
+		usecase := NewShortsUseCase(mockVideo, new(MockFollowRepo), mockTrending, mockSeen, nil)
+
+		mockTrending.On("Top", ctx, trendingKey, 0, 1).Return([]uint(nil), int64(0), errors.New("redis down")).Once()
+		mockVideo.On("ListShorts", domain.ShortsQuery{MaxRating: domain.RatingAll, Limit: 1}).Return([]domain.Video{short(4, "other")}, nil).Once()
+		mockSeen.On("Seen", ctx, mock.Anything, []uint{4}).Return(map[uint]bool{}, nil).Once()
+		mockSeen.On("Add", ctx, mock.Anything, []uint{4}, domain.ShortsSeenTTL).Return(nil).Once()
+
+		feed, err := usecase.GetShortsFeed(ctx, "", "", domain.RatingAll, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, feed.Items, 1)
+		next, _ := domain.DecodeShortsFeedCursor(feed.NextCursor)
+		assert.NotEmpty(t, next.Session)
+	})
+
+	// **情境 4: 游標格式錯誤**
+	t.Run("游標格式錯誤", func(t *testing.T) {
+		usecase := NewShortsUseCase(new(MockVideoRepo), new(MockFollowRepo), new(MockTrendingCache), new(MockShortsSeenCache), nil)
+
+		_, err := usecase.GetShortsFeed(ctx, "member", "%%%", domain.RatingAll, 10)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFollowChannel(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 追蹤頻道**
+	t.Run("追蹤頻道", func(t *testing.T) {
+		mockFollow := new(MockFollowRepo)
+		usecase := NewFollowUseCase(mockFollow)
+		mockFollow.On("Follow", "member", "creator").Return(nil).Once()
+
+		err := usecase.FollowChannel(ctx, "member", "creator")
+
+		assert.NoError(t, err)
+		mockFollow.AssertExpectations(t)
+	})
+
+	// **情境 2: 不能追蹤自己**
+	t.Run("不能追蹤自己", func(t *testing.T) {
+		mockFollow := new(MockFollowRepo)
+		usecase := NewFollowUseCase(mockFollow)
+
+		err := usecase.FollowChannel(ctx, "member", "member")
+
+		assert.EqualError(t, err, "memberID[member] 無法追蹤頻道: member")
+		mockFollow.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything)
+	})
+}
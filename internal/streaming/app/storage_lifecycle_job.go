@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// StorageLifecycleJob 定期套用儲存生命週期規則
+//   - ready 超過 OriginalAfter 的影片，依 OriginalAction 刪除原始檔或移到 archive/original/{videoID}/
+//   - ready 超過 ColdAfter 且期間沒有瀏覽的影片，轉碼後檔案移到 archive/processed/{videoID}/，播放時改由 archive/ 讀取
+//
+// 搬移時先複製、再更新影片記錄、最後刪除舊物件，任何一步失敗都不會讓影片找不到檔案
+type StorageLifecycleJob struct {
+	videoRepo   repository.VideoRepo
+	minioClient database.MinIOClientRepo
+	lock        repository.LeaderLock
+	owner       string // leader lock 的持有者，每個 replica 不同
+	interval    time.Duration
+	rules       domain.LifecycleRules
+}
+
+// NewStorageLifecycleJob 建構 StorageLifecycleJob 實例
+func NewStorageLifecycleJob(videoRepo repository.VideoRepo, minioClient database.MinIOClientRepo,
+	lock repository.LeaderLock, interval time.Duration, rules domain.LifecycleRules) *StorageLifecycleJob {
+	return &StorageLifecycleJob{
+		videoRepo:   videoRepo,
+		minioClient: minioClient,
+		lock:        lock,
+		owner:       uuid.NewString(),
+		interval:    interval,
+		rules:       rules,
+	}
+}
+
+// Start 定期套用生命週期規則，直到 ctx 結束
+func (j *StorageLifecycleJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("StorageLifecycleJob 已啟動，間隔 %s，原始檔 %s（%s 後），冷儲存 %s 後",
+		j.interval, j.rules.OriginalAction, j.rules.OriginalAfter, j.rules.ColdAfter))
+	for {
+		select {
+		case now := <-ticker.C:
+			j.run(ctx, now)
+		case <-ctx.Done():
+			logger.Log.Info("StorageLifecycleJob 收到停止訊號")
+			if err := j.lock.Release(context.Background(), domain.StorageLifecycleLockKey, j.owner); err != nil {
+				logger.Log.Errorf("StorageLifecycleJob 釋放 leader lock 失敗:", err)
+			}
+			return
+		}
+	}
+}
+
+func (j *StorageLifecycleJob) run(ctx context.Context, now time.Time) {
+	leader, err := j.lock.Acquire(ctx, domain.StorageLifecycleLockKey, j.owner, 2*j.interval)
+	if err != nil {
+		logger.Log.Errorf("StorageLifecycleJob 取得 leader lock 失敗:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	result, err := j.Apply(ctx, now)
+	if err != nil {
+		logger.Log.Errorf("StorageLifecycleJob 套用生命週期規則失敗:", err)
+	}
+	if result.OriginalsDeleted > 0 || result.OriginalsArchived > 0 || result.MovedToCold > 0 {
+		logger.Log.Info(fmt.Sprintf("StorageLifecycleJob 刪除 %d 部、封存 %d 部影片的原始檔，%d 部影片移到冷儲存",
+			result.OriginalsDeleted, result.OriginalsArchived, result.MovedToCold))
+	}
+}
+
+// Apply 套用所有規則，單部影片處理失敗時記錄錯誤並繼續下一部
+func (j *StorageLifecycleJob) Apply(ctx context.Context, now time.Time) (domain.LifecycleResult, error) {
+	var result domain.LifecycleResult
+	if j.rules.OriginalAction != domain.OriginalKeep && j.rules.OriginalAfter > 0 {
+		videos, err := j.videoRepo.FindOriginalsReadyBefore(now.Add(-j.rules.OriginalAfter))
+		if err != nil {
+			return result, fmt.Errorf("取得原始檔到期的影片失敗: %w", err)
+		}
+		for index := range videos {
+			video := &videos[index]
+			if err := j.applyOriginal(ctx, video); err != nil {
+				logger.Log.Errorf(fmt.Sprintf("videoID[%d] 處理原始檔失敗:", video.ID), err)
+				continue
+			}
+			if j.rules.OriginalAction == domain.OriginalArchive {
+				result.OriginalsArchived++
+			} else {
+				result.OriginalsDeleted++
+			}
+		}
+	}
+
+	if j.rules.ColdAfter > 0 {
+		videos, err := j.videoRepo.FindColdCandidates(now.Add(-j.rules.ColdAfter))
+		if err != nil {
+			return result, fmt.Errorf("取得長期沒有瀏覽的影片失敗: %w", err)
+		}
+		for index := range videos {
+			video := &videos[index]
+			if err := j.moveToCold(ctx, video); err != nil {
+				logger.Log.Errorf(fmt.Sprintf("videoID[%d] 移到冷儲存失敗:", video.ID), err)
+				continue
+			}
+			result.MovedToCold++
+		}
+	}
+	return result, nil
+}
+
+// applyOriginal 刪除或封存原始檔
+// 封存後 FileName 指向封存的 key，重新轉碼時仍可下載
+func (j *StorageLifecycleJob) applyOriginal(ctx context.Context, video *domain.Video) error {
+	objects, err := j.minioClient.ListObjects(ctx, video.OriginalPrefix())
+	if err != nil {
+		return err
+	}
+
+	if j.rules.OriginalAction == domain.OriginalArchive {
+		if err := j.copyToArchive(ctx, objects); err != nil {
+			return err
+		}
+		video.OriginalState = string(domain.OriginalArchived)
+		video.FileName = domain.ArchivedKey(video.FileName)
+		if err := j.videoRepo.UpdateStorageState(video); err != nil {
+			return fmt.Errorf("更新影片記錄失敗: %w", err)
+		}
+		return j.removeObjects(ctx, objects)
+	}
+
+	if err := j.removeObjects(ctx, objects); err != nil {
+		return err
+	}
+	video.OriginalState = string(domain.OriginalDeleted)
+	if err := j.videoRepo.UpdateStorageState(video); err != nil {
+		return fmt.Errorf("更新影片記錄失敗: %w", err)
+	}
+	return nil
+}
+
+// moveToCold 將轉碼後檔案移到 archive/processed/{videoID}/，更新後播放改由封存的 key 讀取
+func (j *StorageLifecycleJob) moveToCold(ctx context.Context, video *domain.Video) error {
+	objects, err := j.minioClient.ListObjects(ctx, video.ProcessedKey(""))
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return fmt.Errorf("找不到轉碼後檔案 %s", video.ProcessedKey(""))
+	}
+	if err := j.copyToArchive(ctx, objects); err != nil {
+		return err
+	}
+	video.StorageTier = string(domain.StorageCold)
+	if err := j.videoRepo.UpdateStorageState(video); err != nil {
+		return fmt.Errorf("更新影片記錄失敗: %w", err)
+	}
+	// 冷儲存的影片只由 gateway 播放
+	revokePublicCopy(ctx, j.minioClient, j.videoRepo, video)
+	return j.removeObjects(ctx, objects)
+}
+
+func (j *StorageLifecycleJob) copyToArchive(ctx context.Context, objects []database.ObjectInfo) error {
+	for _, object := range objects {
+		if err := j.minioClient.CopyObject(ctx, object.Key, domain.ArchivedKey(object.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeObjects 刪除所有物件，個別失敗時繼續刪除其餘物件，回傳第一個錯誤
+// 留下的物件已有封存的副本或屬於已處理的影片，不影響播放
+func (j *StorageLifecycleJob) removeObjects(ctx context.Context, objects []database.ObjectInfo) error {
+	var firstErr error
+	for _, object := range objects {
+		if err := j.minioClient.RemoveObject(ctx, object.Key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStorageLifecycleJob(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	// **情境 1: 封存原始檔，先複製再更新影片記錄，最後刪除原本的物件**
+	t.Run("封存原始檔", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		job := NewStorageLifecycleJob(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour,
+			domain.LifecycleRules{OriginalAction: domain.OriginalArchive, OriginalAfter: 30 * day})
+
+		mockRepo.On("FindOriginalsReadyBefore", now.Add(-30*day)).Return([]domain.Video{
+			{ID: 1, FileName: "original/1/a.mp4", Status: string(domain.VideoReady), OriginalState: string(domain.OriginalStored)},
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "original/1/").Return([]database.ObjectInfo{{Key: "original/1/a.mp4"}}, nil).Once()
+		copied := mockMinIO.On("CopyObject", ctx, "original/1/a.mp4", "archive/original/1/a.mp4").Return(nil).Once()
+		updated := mockRepo.On("UpdateStorageState", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.OriginalState == string(domain.OriginalArchived) && v.FileName == "archive/original/1/a.mp4"
+		})).Return(nil).Once().NotBefore(copied)
+		mockMinIO.On("RemoveObject", ctx, "original/1/a.mp4").Return(nil).Once().NotBefore(updated)
+
+		result, err := job.Apply(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LifecycleResult{OriginalsArchived: 1}, result)
+		mockRepo.AssertExpectations(t)
+		mockMinIO.AssertExpectations(t)
+	})
+
+	// **情境 2: 刪除原始檔，刪除失敗時不更新影片記錄，下一輪重試**
+	t.Run("刪除原始檔", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		job := NewStorageLifecycleJob(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour,
+			domain.LifecycleRules{OriginalAction: domain.OriginalDelete, OriginalAfter: 7 * day})
+
+		mockRepo.On("FindOriginalsReadyBefore", now.Add(-7*day)).Return([]domain.Video{
+			{ID: 2, FileName: "original/2/b.mp4"},
+			{ID: 3, FileName: "original/3/c.mp4"},
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "original/2/").Return([]database.ObjectInfo{{Key: "original/2/b.mp4"}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "original/2/b.mp4").Return(nil).Once()
+		mockRepo.On("UpdateStorageState", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.ID == 2 && v.OriginalState == string(domain.OriginalDeleted) && v.FileName == "original/2/b.mp4"
+		})).Return(nil).Once()
+		mockMinIO.On("ListObjects", ctx, "original/3/").Return([]database.ObjectInfo{{Key: "original/3/c.mp4"}}, nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "original/3/c.mp4").Return(errors.New("minio down")).Once()
+
+		result, err := job.Apply(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LifecycleResult{OriginalsDeleted: 1}, result)
+		mockRepo.AssertExpectations(t)
+		mockMinIO.AssertExpectations(t)
+	})
+
+	// **情境 3: 長期沒有瀏覽的影片移到冷儲存，播放改由 archive/ 讀取**
+	t.Run("移到冷儲存", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		job := NewStorageLifecycleJob(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour,
+			domain.LifecycleRules{OriginalAction: domain.OriginalKeep, OriginalAfter: 30 * day, ColdAfter: 180 * day})
+
+		mockRepo.On("FindColdCandidates", now.Add(-180*day)).Return([]domain.Video{
+			{ID: 4, Status: string(domain.VideoReady), StorageTier: string(domain.StorageHot)},
+		}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "processed/4/").Return([]database.ObjectInfo{
+			{Key: "processed/4/index.m3u8"}, {Key: "processed/4/index0.ts"},
+		}, nil).Once()
+		mockMinIO.On("CopyObject", ctx, "processed/4/index.m3u8", "archive/processed/4/index.m3u8").Return(nil).Once()
+		mockMinIO.On("CopyObject", ctx, "processed/4/index0.ts", "archive/processed/4/index0.ts").Return(nil).Once()
+		var cold *domain.Video
+		mockRepo.On("UpdateStorageState", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.StorageTier == string(domain.StorageCold)
+		})).Run(func(args mock.Arguments) {
+			cold = args.Get(0).(*domain.Video)
+		}).Return(nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "processed/4/index.m3u8").Return(nil).Once()
+		mockMinIO.On("RemoveObject", ctx, "processed/4/index0.ts").Return(nil).Once()
+
+		result, err := job.Apply(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LifecycleResult{MovedToCold: 1}, result)
+		mockRepo.AssertNotCalled(t, "FindOriginalsReadyBefore", mock.Anything)
+		mockMinIO.AssertExpectations(t)
+		assert.Equal(t, "archive/processed/4/index0.ts", cold.ProcessedKey("index0.ts"))
+	})
+
+	// **情境 4: 複製失敗時不更新影片記錄也不刪除**
+	t.Run("複製失敗", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		job := NewStorageLifecycleJob(mockRepo, mockMinIO, new(MockLeaderLock), time.Hour,
+			domain.LifecycleRules{OriginalAction: domain.OriginalKeep, ColdAfter: 180 * day})
+
+		mockRepo.On("FindColdCandidates", now.Add(-180*day)).Return([]domain.Video{{ID: 5}}, nil).Once()
+		mockMinIO.On("ListObjects", ctx, "processed/5/").Return([]database.ObjectInfo{{Key: "processed/5/index.m3u8"}}, nil).Once()
+		mockMinIO.On("CopyObject", ctx, "processed/5/index.m3u8", "archive/processed/5/index.m3u8").Return(errors.New("minio down")).Once()
+
+		result, err := job.Apply(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LifecycleResult{}, result)
+		mockRepo.AssertNotCalled(t, "UpdateStorageState", mock.Anything)
+		mockMinIO.AssertNotCalled(t, "RemoveObject", mock.Anything, mock.Anything)
+	})
+
+	// **情境 5: 非 leader 不執行**
+	t.Run("非 leader", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockLock := new(MockLeaderLock)
+		job := NewStorageLifecycleJob(mockRepo, new(MockMinIOClient), mockLock, time.Hour,
+			domain.LifecycleRules{OriginalAction: domain.OriginalDelete, OriginalAfter: day})
+
+		mockLock.On("Acquire", ctx, domain.StorageLifecycleLockKey, mock.Anything, 2*time.Hour).Return(false, nil).Once()
+
+		job.run(ctx, now)
+
+		mockRepo.AssertNotCalled(t, "FindOriginalsReadyBefore", mock.Anything)
+	})
+}
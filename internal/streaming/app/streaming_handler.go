@@ -3,9 +3,12 @@ package app
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"time"
 
 	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
 	streaming_pb "streaming_video_service/pkg/proto/streaming"
 
 	"google.golang.org/grpc/codes"
@@ -15,7 +18,19 @@ import (
 // StreamingGRPCServer 用來實作 StreamingGRPCServer
 type StreamingGRPCServer struct {
 	streaming_pb.UnimplementedStreamingServiceServer
-	Usecase StreamingUseCase
+	Usecase           StreamingUseCase
+	PlaylistUsecase   PlaylistUseCase
+	ReactionUsecase   ReactionUseCase
+	CommentUsecase    CommentUseCase
+	TrendingUsecase   TrendingUseCase
+	ShortsUsecase     ShortsUseCase
+	FollowUsecase     FollowUseCase
+	WatermarkUsecase  WatermarkUseCase
+	QoEUsecase        QoEUseCase
+	ModerationUsecase ModerationUseCase
+	ThumbnailUsecase  ThumbnailUseCase
+	DownloadUsecase   DownloadUseCase
+	QuotaUsecase      QuotaUseCase
 }
 
 // UploadVideo 實作 上傳影片
@@ -23,6 +38,9 @@ type StreamingGRPCServer struct {
 func (s *StreamingGRPCServer) UploadVideo(stream streaming_pb.StreamingService_UploadVideoServer) error {
 	var metadata *streaming_pb.VideoMetadata
 	var fileBuffer bytes.Buffer
+	var reservation *domain.UploadRecord
+	// 任何一步失敗而沒有建立影片時，歸還接收檔案前預留的配額
+	defer func() { s.releaseUploadQuota(stream.Context(), reservation) }()
 
 	// 循環接收來自客戶端的流
 	for {
@@ -38,8 +56,14 @@ func (s *StreamingGRPCServer) UploadVideo(stream streaming_pb.StreamingService_U
 		// 根據 oneof 欄位判斷數據類型
 		switch data := req.Data.(type) {
 		case *streaming_pb.UploadVideoReq_Metadata:
-			// 第一次應傳送元資料
+			// 第一次應傳送元資料，接收檔案前先以宣告的大小預留上傳配額
 			metadata = data.Metadata
+			if reservation == nil {
+				var res *streaming_pb.UploadVideoRes
+				if reservation, res = s.reserveUploadQuota(stream, metadata); res != nil {
+					return stream.SendAndClose(res)
+				}
+			}
 		case *streaming_pb.UploadVideoReq_Chunk:
 			// 後續傳送檔案區塊
 			_, err := fileBuffer.Write(data.Chunk.Content)
@@ -72,13 +96,26 @@ func (s *StreamingGRPCServer) UploadVideo(stream streaming_pb.StreamingService_U
 		return stream.SendAndClose(res)
 	}
 
+	// 以實際收到的大小調整預留的配額，未宣告或宣告不實的大小不會繞過儲存配額
+	if res := s.resizeUploadQuota(stream, metadata, reservation, int64(fileBuffer.Len())); res != nil {
+		return stream.SendAndClose(res)
+	}
+
 	// 調用 usecase 層進行上傳處理
 	upRes, err := s.Usecase.UploadVideo(domain.UploadVideoReq{
+		MemberID:    metadata.MemberId,
 		Title:       metadata.Title,
 		Description: metadata.Description,
 		Type:        metadata.Type,
 		FileName:    metadata.FileName, // 客戶端應提供檔案名稱
 		File:        bytes.NewReader(fileBuffer.Bytes()),
+		Visibility:  domain.VideoVisibility(metadata.Visibility),
+		PublishAt:   unixToTime(metadata.PublishAt),
+		Tags:        metadata.Tags,
+		CategoryID:  uint(metadata.CategoryId),
+		Watermark:   metadata.Watermark,
+		Role:        metadata.Role,
+		Rating:      domain.ContentRating(metadata.ContentRating),
 	})
 	if err != nil {
 		// 返回錯誤回應
@@ -89,6 +126,14 @@ func (s *StreamingGRPCServer) UploadVideo(stream streaming_pb.StreamingService_U
 		return stream.SendAndClose(res)
 	}
 
+	if reservation != nil {
+		// 影片已建立，用量已在預留時計入，關聯失敗只影響上傳記錄的 video_id，不讓上傳失敗也不歸還配額
+		if err := s.QuotaUsecase.CompleteUpload(stream.Context(), reservation, uint(upRes.VideoID)); err != nil {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 上傳記錄關聯影片失敗:", upRes.VideoID), err)
+		}
+		reservation.VideoID = uint(upRes.VideoID)
+	}
+
 	// 返回成功回應
 	res := &streaming_pb.UploadVideoRes{
 		Success: true,
@@ -100,24 +145,48 @@ func (s *StreamingGRPCServer) UploadVideo(stream streaming_pb.StreamingService_U
 
 // GetVideo 實作 依video id取得 video
 func (s *StreamingGRPCServer) GetVideo(ctx context.Context, req *streaming_pb.GetVideoReq) (*streaming_pb.GetVideoRes, error) {
-	video, err := s.Usecase.GetVideo(req.VideoId)
+	video, err := s.Usecase.GetVideo(req.VideoId, toViewer(req.MemberId, req.ViewerAge), req.Region)
 	if err != nil {
 		return &streaming_pb.GetVideoRes{
 			Success: false,
 			Error:   err.Error(),
 		}, err
 	}
-	return &streaming_pb.GetVideoRes{
-		Success: true,
-		VideoId: int64(video.VideoID),
-		Title:   video.Title,
-		HlsUrl:  video.HlsURL,
-	}, nil
+	res := &streaming_pb.GetVideoRes{
+		Success:         true,
+		VideoId:         int64(video.VideoID),
+		Title:           video.Title,
+		HlsUrl:          video.HlsURL,
+		Visibility:      video.Visibility,
+		Tags:            video.Tags,
+		CategoryId:      int64(video.CategoryID),
+		Chapters:        toChapterPb(video.Chapters),
+		ChaptersUrl:     video.ChaptersURL,
+		SourceVideoId:   int64(video.SourceVideoID),
+		PlaybackUrls:    toPlaybackURLPb(video.PlaybackURLs),
+		ContentRating:   video.ContentRating,
+		DownloadEnabled: video.DownloadEnabled,
+	}
+	// 按讚數取得失敗不影響影片資訊，僅記錄錯誤
+	reactions, err := s.ReactionUsecase.GetVideoReactions(ctx, uint(video.VideoID), req.MemberId)
+	if err != nil {
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 取得按讚數失敗:", video.VideoID), err)
+		return res, nil
+	}
+	res.LikeCount = reactions.Likes
+	res.DislikeCount = reactions.Dislikes
+	res.MyReaction = string(reactions.MyReaction)
+	return res, nil
 }
 
 // Search 實作 Search
 func (s *StreamingGRPCServer) Search(ctx context.Context, req *streaming_pb.SearchReq) (*streaming_pb.SearchRes, error) {
-	videos, err := s.Usecase.Search(req.KeyWord)
+	videos, err := s.Usecase.Search(domain.SearchFilter{
+		Keyword:    req.KeyWord,
+		Tag:        req.Tag,
+		CategoryID: uint(req.CategoryId),
+		MaxRating:  toViewer(req.MemberId, req.ViewerAge).MaxRating(time.Now()),
+	})
 	if err != nil {
 		return &streaming_pb.SearchRes{
 			Success: false,
@@ -125,27 +194,19 @@ func (s *StreamingGRPCServer) Search(ctx context.Context, req *streaming_pb.Sear
 		}, err
 	}
 
-	videoRes := make([]*streaming_pb.SearchFeedBack, len(videos))
-	for index, video := range videos {
-		videoRes[index] = &streaming_pb.SearchFeedBack{
-			VideoId:     int64(video.ID),
-			Title:       video.Title,
-			Description: video.Description,
-			FileName:    video.FileName, // 存於 MinIO 上的 object key
-			Type:        video.Type,
-			Status:      video.Status, // "uploaded", "processing", "ready"
-			ViewCCount:  int64(video.ViewCount),
-		}
-	}
 	return &streaming_pb.SearchRes{
 		Success: true,
-		Video:   videoRes,
+		Video:   toSearchFeedBack(videos),
 	}, nil
 }
 
 // GetRecommendations 實作 取得最熱門video
 func (s *StreamingGRPCServer) GetRecommendations(ctx context.Context, req *streaming_pb.GetRecommendationsReq) (*streaming_pb.GetRecommendationsRes, error) {
-	videos, err := s.Usecase.GetRecommendations(int(req.Limit))
+	videos, err := s.Usecase.GetRecommendations(domain.RecommendFilter{
+		Limit:     int(req.Limit),
+		Tags:      req.Tags,
+		MaxRating: toViewer(req.MemberId, req.ViewerAge).MaxRating(time.Now()),
+	})
 	if err != nil {
 		return &streaming_pb.GetRecommendationsRes{
 			Success: false,
@@ -153,27 +214,15 @@ func (s *StreamingGRPCServer) GetRecommendations(ctx context.Context, req *strea
 		}, err
 	}
 
-	videoRes := make([]*streaming_pb.SearchFeedBack, len(videos))
-	for index, video := range videos {
-		videoRes[index] = &streaming_pb.SearchFeedBack{
-			VideoId:     int64(video.ID),
-			Title:       video.Title,
-			Description: video.Description,
-			FileName:    video.FileName, // 存於 MinIO 上的 object key
-			Type:        video.Type,
-			Status:      video.Status, // "uploaded", "processing", "ready"
-			ViewCCount:  int64(video.ViewCount),
-		}
-	}
 	return &streaming_pb.GetRecommendationsRes{
 		Success: true,
-		Video:   videoRes,
+		Video:   toSearchFeedBack(videos),
 	}, nil
 }
 
 // GetIndexM3U8 實作 取得m3u8
 func (s *StreamingGRPCServer) GetIndexM3U8(ctx context.Context, req *streaming_pb.GetIndexM3U8Req) (*streaming_pb.GetIndexM3U8Res, error) {
-	m3u8, err := s.Usecase.GetIndexM3U8(ctx, req.VideoId)
+	m3u8, err := s.Usecase.GetIndexM3U8(ctx, req.VideoId, toViewer(req.MemberId, req.ViewerAge))
 	if err != nil {
 		return &streaming_pb.GetIndexM3U8Res{
 			Success: false,
@@ -188,7 +237,7 @@ func (s *StreamingGRPCServer) GetIndexM3U8(ctx context.Context, req *streaming_p
 
 // GetHlsSegment 實作 依video id & segment 讀取 ts
 func (s *StreamingGRPCServer) GetHlsSegment(ctx context.Context, req *streaming_pb.GetHlsSegmentReq) (*streaming_pb.GetHlsSegmentRes, error) {
-	ts, err := s.Usecase.GetHlsSegment(ctx, req.VideoId, req.Segment)
+	ts, err := s.Usecase.GetHlsSegment(ctx, req.VideoId, req.Segment, toViewer(req.MemberId, req.ViewerAge))
 	if err != nil {
 		return &streaming_pb.GetHlsSegmentRes{
 			Success: false,
@@ -200,3 +249,180 @@ func (s *StreamingGRPCServer) GetHlsSegment(ctx context.Context, req *streaming_
 		Content: ts,
 	}, nil
 }
+
+// UpdateVisibility 實作 設定影片可見度與排程公開時間
+func (s *StreamingGRPCServer) UpdateVisibility(ctx context.Context, req *streaming_pb.UpdateVisibilityReq) (*streaming_pb.UpdateVisibilityRes, error) {
+	err := s.Usecase.UpdateVisibility(ctx, domain.UpdateVisibilityReq{
+		VideoID:    req.VideoId,
+		MemberID:   req.MemberId,
+		Visibility: domain.VideoVisibility(req.Visibility),
+		PublishAt:  unixToTime(req.PublishAt),
+	})
+	if err != nil {
+		return &streaming_pb.UpdateVisibilityRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.UpdateVisibilityRes{Success: true}, nil
+}
+
+// DeleteVideo 實作 刪除影片並釋放上傳者的用量
+func (s *StreamingGRPCServer) DeleteVideo(ctx context.Context, req *streaming_pb.DeleteVideoReq) (*streaming_pb.DeleteVideoRes, error) {
+	err := s.Usecase.DeleteVideo(ctx, domain.DeleteVideoReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Role:     req.Role,
+	})
+	if err != nil {
+		return &streaming_pb.DeleteVideoRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.DeleteVideoRes{Success: true}, nil
+}
+
+// ShareVideo 實作 將會員加入私人影片分享名單
+func (s *StreamingGRPCServer) ShareVideo(ctx context.Context, req *streaming_pb.ShareVideoReq) (*streaming_pb.ShareVideoRes, error) {
+	err := s.Usecase.ShareVideo(ctx, req.VideoId, req.MemberId, req.TargetMemberIds)
+	if err != nil {
+		return &streaming_pb.ShareVideoRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.ShareVideoRes{Success: true}, nil
+}
+
+// UnshareVideo 實作 將會員從私人影片分享名單移除
+func (s *StreamingGRPCServer) UnshareVideo(ctx context.Context, req *streaming_pb.UnshareVideoReq) (*streaming_pb.UnshareVideoRes, error) {
+	err := s.Usecase.UnshareVideo(ctx, req.VideoId, req.MemberId, req.TargetMemberIds)
+	if err != nil {
+		return &streaming_pb.UnshareVideoRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.UnshareVideoRes{Success: true}, nil
+}
+
+// ListCategories 實作 分頁列出分類
+func (s *StreamingGRPCServer) ListCategories(ctx context.Context, req *streaming_pb.ListCategoriesReq) (*streaming_pb.ListCategoriesRes, error) {
+	categories, total, err := s.Usecase.ListCategories(domain.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return &streaming_pb.ListCategoriesRes{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	categoryRes := make([]*streaming_pb.Category, len(categories))
+	for index, category := range categories {
+		categoryRes[index] = &streaming_pb.Category{
+			CategoryId: int64(category.ID),
+			Slug:       category.Slug,
+			Name:       category.Name,
+		}
+	}
+	return &streaming_pb.ListCategoriesRes{
+		Success:    true,
+		Categories: categoryRes,
+		Total:      total,
+	}, nil
+}
+
+// BrowseCategory 實作 分頁瀏覽分類影片
+func (s *StreamingGRPCServer) BrowseCategory(ctx context.Context, req *streaming_pb.BrowseCategoryReq) (*streaming_pb.BrowseCategoryRes, error) {
+	maxRating := toViewer(req.MemberId, req.ViewerAge).MaxRating(time.Now())
+	videos, total, err := s.Usecase.BrowseCategory(uint(req.CategoryId), maxRating, domain.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return &streaming_pb.BrowseCategoryRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.BrowseCategoryRes{
+		Success: true,
+		Video:   toSearchFeedBack(videos),
+		Total:   total,
+	}, nil
+}
+
+// GetRelatedVideos 實作 取得相關影片
+func (s *StreamingGRPCServer) GetRelatedVideos(ctx context.Context, req *streaming_pb.GetRelatedVideosReq) (*streaming_pb.GetRelatedVideosRes, error) {
+	maxRating := toViewer(req.MemberId, req.ViewerAge).MaxRating(time.Now())
+	videos, err := s.Usecase.GetRelatedVideos(req.VideoId, req.MemberId, maxRating, int(req.Limit))
+	if err != nil {
+		return &streaming_pb.GetRelatedVideosRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.GetRelatedVideosRes{
+		Success: true,
+		Video:   toSearchFeedBack(videos),
+	}, nil
+}
+
+// toSearchFeedBack 將影片列表轉為 proto 回應格式
+func toSearchFeedBack(videos []domain.Video) []*streaming_pb.SearchFeedBack {
+	videoRes := make([]*streaming_pb.SearchFeedBack, len(videos))
+	for index, video := range videos {
+		var categoryID int64
+		if video.CategoryID != nil {
+			categoryID = int64(*video.CategoryID)
+		}
+		videoRes[index] = &streaming_pb.SearchFeedBack{
+			VideoId:       int64(video.ID),
+			Title:         video.Title,
+			Description:   video.Description,
+			FileName:      video.FileName, // 存於 MinIO 上的 object key
+			Type:          video.Type,
+			Status:        video.Status, // "uploaded", "processing", "ready"
+			ViewCCount:    int64(video.ViewCount),
+			CategoryId:    categoryID,
+			LikeCount:     int64(video.LikeCount),
+			SourceVideoId: int64(video.ClipSource()),
+			ContentRating: string(video.Rating()),
+			PreviewUrl:    video.PreviewURL,
+		}
+	}
+	return videoRes
+}
+
+// toViewer 將 proto 帶入的觀看者與年齡資料轉為 domain.Viewer
+func toViewer(memberID string, age *streaming_pb.ViewerAge) domain.Viewer {
+	return domain.Viewer{
+		MemberID:    memberID,
+		Birthdate:   age.GetBirthdate(),
+		AgeVerified: age.GetAgeVerified(),
+	}
+}
+
+// unixToTime proto 以 unix 秒傳遞時間，0 表示未設定
+func unixToTime(sec int64) *time.Time {
+	if sec <= 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+// toPlaybackURLPb 將候選播放網址轉為 proto 回應格式
+func toPlaybackURLPb(urls []domain.PlaybackURL) []*streaming_pb.PlaybackURL {
+	urlRes := make([]*streaming_pb.PlaybackURL, len(urls))
+	for index, playbackURL := range urls {
+		urlRes[index] = &streaming_pb.PlaybackURL{
+			Source: string(playbackURL.Source),
+			Url:    playbackURL.URL,
+		}
+	}
+	return urlRes
+}
@@ -182,9 +182,12 @@ func TestMain(m *testing.M) {
 		log.Fatalf("資料表遷移失敗: %v", err)
 	}
 
-	rabbitRepo := database.NewRabbitRepository(rabbitChannel)
+	// 轉碼工作事件與影片記錄一起寫入 outbox
+	if err := repository.NewOutboxRepo(db).AutoMigrate(); err != nil {
+		log.Fatalf("資料表遷移失敗: %v", err)
+	}
 
-	usecase := NewStreamingUseCase(minioClient, videoRepo, rabbitRepo)
+	usecase := NewStreamingUseCase(minioClient, videoRepo, nil, nil)
 
 	// **初始化 Handler**
 	streamingHandler = new(StreamingGRPCServer)
@@ -627,7 +630,7 @@ func TestIntegrationGetIndexM3U8(t *testing.T) {
 		assert.NoError(t, err, "❌ 上傳測試影片失敗")
 
 		// **執行 `GetIndexM3U8`**
-		resp, err := streamingHandler.Usecase.GetIndexM3U8(ctx, videoID)
+		resp, err := streamingHandler.Usecase.GetIndexM3U8(ctx, videoID, domain.Viewer{})
 
 		// **確認回應**
 		assert.NoError(t, err, "❌ GetIndexM3U8 應該成功但發生錯誤")
@@ -641,7 +644,7 @@ func TestIntegrationGetIndexM3U8(t *testing.T) {
 		videoID := "999"
 
 		// **執行 `GetIndexM3U8`**
-		resp, err := streamingHandler.Usecase.GetIndexM3U8(ctx, videoID)
+		resp, err := streamingHandler.Usecase.GetIndexM3U8(ctx, videoID, domain.Viewer{})
 
 		// **確認錯誤**
 		assert.Error(t, err, "❌ m3u8 不存在時應該回傳錯誤")
@@ -671,7 +674,7 @@ func TestIntegrationGetHlsSegment(t *testing.T) {
 		segment := "segment_00001.ts"
 
 		// **執行 `GetHlsSegment`**
-		resp, err := streamingHandler.Usecase.GetHlsSegment(ctx, videoID, segment)
+		resp, err := streamingHandler.Usecase.GetHlsSegment(ctx, videoID, segment, domain.Viewer{})
 
 		// **確認回應**
 		assert.NoError(t, err, "❌ GetHlsSegment 應該成功但發生錯誤")
@@ -686,7 +689,7 @@ func TestIntegrationGetHlsSegment(t *testing.T) {
 		segment := "missing_segment.ts"
 
 		// **執行 `GetHlsSegment`**
-		resp, err := streamingHandler.Usecase.GetHlsSegment(ctx, videoID, segment)
+		resp, err := streamingHandler.Usecase.GetHlsSegment(ctx, videoID, segment, domain.Viewer{})
 
 		// **確認錯誤**
 		assert.Error(t, err, "❌ TS 段影片不存在時應該回傳錯誤")
@@ -2,47 +2,63 @@ package app
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"streaming_video_service/internal/streaming/domain"
 	"streaming_video_service/internal/streaming/repository"
 	"streaming_video_service/pkg/database"
 	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/logger"
+	"streaming_video_service/pkg/token"
 
 	"github.com/minio/minio-go/v7"
-	"github.com/streadway/amqp"
 )
 
 // StreamingUseCase 這裡封裝了對外提供的應用服務
 type StreamingUseCase interface {
 	UploadVideo(up domain.UploadVideoReq) (*domain.UploadVideoRes, error)
-	GetVideo(videoID string) (*domain.GetVideoRes, error)
-	Search(keyWord string) ([]domain.Video, error)
-	GetRecommendations(limit int) ([]domain.Video, error)
-	GetIndexM3U8(ctx context.Context, videoID string) ([]byte, error)
-	GetHlsSegment(ctx context.Context, videoID, segment string) ([]byte, error)
+	GetVideo(videoID string, viewer domain.Viewer, region string) (*domain.GetVideoRes, error)
+	Search(filter domain.SearchFilter) ([]domain.Video, error)
+	GetRecommendations(filter domain.RecommendFilter) ([]domain.Video, error)
+	GetRelatedVideos(videoID, memberID string, maxRating domain.ContentRating, limit int) ([]domain.Video, error)
+	ListCategories(page domain.Pagination) ([]domain.Category, int64, error)
+	BrowseCategory(categoryID uint, maxRating domain.ContentRating, page domain.Pagination) ([]domain.Video, int64, error)
+	GetIndexM3U8(ctx context.Context, videoID string, viewer domain.Viewer) ([]byte, error)
+	GetHlsSegment(ctx context.Context, videoID, segment string, viewer domain.Viewer) ([]byte, error)
+	UpdateVisibility(ctx context.Context, req domain.UpdateVisibilityReq) error
+	DeleteVideo(ctx context.Context, req domain.DeleteVideoReq) error
+	ShareVideo(ctx context.Context, videoID, memberID string, targetMemberIDs []string) error
+	UnshareVideo(ctx context.Context, videoID, memberID string, targetMemberIDs []string) error
+	SetChapters(ctx context.Context, videoID, memberID string, chapters []domain.Chapter) ([]domain.Chapter, error)
+	GetChaptersVTT(ctx context.Context, videoID, memberID string) ([]byte, error)
+	CreateClip(ctx context.Context, req domain.CreateClipReq) (*domain.UploadVideoRes, error)
+	ListUploads(ctx context.Context, memberID string, page domain.Pagination) ([]domain.Video, int64, error)
 }
 
+// 轉碼工作訊息由 OutboxRelay 發布，usecase 只將事件與影片記錄一起寫入 outbox
 type streamingUseCase struct {
-	MinioClient   database.MinIOClientRepo
-	VideoRepo     repository.VideoRepo
-	RabbitChannel database.RabbitRepo // 用於發布轉碼工作訊息的 RabbitMQ Channel
+	MinioClient  database.MinIOClientRepo
+	VideoRepo    repository.VideoRepo
+	ObjectCache  *HotObjectCache            // 熱門播放清單與分段，nil 表示停用
+	PlaybackURLs *domain.PlaybackURLBuilder // 播放網址，nil 使用預設的 gateway
 }
 
 // NewStreamingUseCase 建立一個新的 UserUseCase
 func NewStreamingUseCase(minIO database.MinIOClientRepo,
 	repo repository.VideoRepo,
-	rabbitChannel database.RabbitRepo,
+	objectCache *HotObjectCache,
+	playbackURLs *domain.PlaybackURLBuilder,
 ) StreamingUseCase {
 	return &streamingUseCase{
-		MinioClient:   minIO,
-		VideoRepo:     repo,
-		RabbitChannel: rabbitChannel,
+		MinioClient:  minIO,
+		VideoRepo:    repo,
+		ObjectCache:  objectCache,
+		PlaybackURLs: playbackURLs,
 	}
 }
 
@@ -80,7 +96,7 @@ var (
 //   - 若影片未完整寫入 MinIO 或 RabbitMQ 發送失敗，使用暫存檔案可以提供「重試」機制，而不會因為檔案已經消失而失敗。
 //   - 解法：
 //   - 成功上傳 MinIO 後才刪除暫存檔案。
-//   - 若 RabbitMQ 發送失敗，可考慮「重試」或標記影片為 pending，稍後重新發送。
+//   - 轉碼工作事件寫入 outbox，RabbitMQ 暫時無法發送時由 OutboxRelay 稍後重新發送。
 //
 // 4. 支援本地快取（Cache）與日後 Debug
 //   - 若影片上傳過程失敗，開發者可以手動檢查 ./tmp 目錄，確保問題是發生在：
@@ -89,8 +105,29 @@ var (
 //     3.	RabbitMQ 無法發送？
 //   - 這對於除錯（Debug）非常有幫助。
 //
-// UploadVideo 接收上傳請求，完成上傳、資料庫寫入與發布轉碼工作訊息
+// UploadVideo 接收上傳請求，完成上傳、資料庫寫入與轉碼工作事件
 func (s *streamingUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.UploadVideoRes, error) {
+	if up.PublishAt != nil && !up.PublishAt.After(time.Now()) {
+		errMsg := fmt.Sprintf("fileName[%s] 排程公開時間必須晚於現在", up.FileName)
+		return nil, errprocess.Set(errMsg)
+	}
+	if up.Rating == "" {
+		up.Rating = domain.RatingAll
+	}
+	if !up.Rating.IsValid() {
+		errMsg := fmt.Sprintf("fileName[%s] 不支援的分級: %s", up.FileName, up.Rating)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	var categoryID *uint
+	if up.CategoryID > 0 {
+		if _, err := s.VideoRepo.GetCategory(up.CategoryID); err != nil {
+			errMsg := fmt.Sprintf("fileName[%s] 分類[%d]不存在 : %v", up.FileName, up.CategoryID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		categoryID = &up.CategoryID
+	}
+
 	tmpDir := "./tmp"
 	if err := createDir(tmpDir); err != nil {
 		errMsg := fmt.Sprintf("fileName[%s] 建立暫存目錄失敗 : %v", up.FileName, err)
@@ -106,19 +143,29 @@ func (s *streamingUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.Upload
 	defer tempFile.Close()
 
 	// 寫入檔案資料
-	if _, err := copyFile(tempFile, up.File); err != nil {
+	size, err := copyFile(tempFile, up.File)
+	if err != nil {
 		tempFile.Close()
 		errMsg := fmt.Sprintf("fileName[%s] 儲存檔案失敗 : %v", up.FileName, err)
 		return nil, errprocess.Set(errMsg)
 	}
 
 	// 4. 建立影片記錄（狀態預設為 "uploaded"）
+	visibility, publishAt := scheduleVisibility(up.Visibility, up.PublishAt)
 	video := domain.Video{
-		Title:       up.Title,
-		Description: up.Description,
-		FileName:    up.FileName, // 先暫存用，後續更新為 MinIO 的 object key
-		Type:        up.Type,
-		Status:      string(domain.VideoUpload),
+		MemberID:          up.MemberID,
+		Title:             up.Title,
+		Description:       up.Description,
+		FileName:          up.FileName, // 先暫存用，後續更新為 MinIO 的 object key
+		Type:              up.Type,
+		Status:            string(domain.VideoUpload),
+		Visibility:        string(visibility),
+		PublishAt:         publishAt,
+		CategoryID:        categoryID,
+		ContentRating:     string(up.Rating),
+		ChannelWatermark:  up.Watermark,
+		PlatformWatermark: isFreeTier(up.Role),
+		SizeBytes:         size,
 	}
 
 	if err := s.VideoRepo.Create(&video); err != nil {
@@ -126,6 +173,13 @@ func (s *streamingUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.Upload
 		return nil, errprocess.Set(errMsg)
 	}
 
+	if tags := domain.NormalizeTags(up.Tags); len(tags) > 0 {
+		if err := s.VideoRepo.SetVideoTags(video.ID, tags); err != nil {
+			errMsg := fmt.Sprintf("fileName[%s] 建立影片標籤失敗 : %v", up.FileName, err)
+			return nil, errprocess.Set(errMsg)
+		}
+	}
+
 	// 5. 定義 MinIO 儲存路徑，例如 "original/{videoID}/{filename}"
 	objectName := fmt.Sprintf("original/%d/%s", video.ID, up.FileName)
 	ctx := context.Background()
@@ -135,38 +189,13 @@ func (s *streamingUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.Upload
 	}
 
 	// 6. 更新影片記錄，將 FileName 更新為 MinIO 上的 objectName
+	// 7. 轉碼工作事件與影片記錄在同一個交易內寫入 outbox，由 OutboxRelay 發布到消息佇列
 	video.FileName = objectName
-	if err := s.VideoRepo.Update(&video); err != nil {
+	if err := s.VideoRepo.SaveWithEvents(&video, domain.TranscodingJobEvents); err != nil {
 		errMsg := fmt.Sprintf("fileName[%s] 更新影片記錄失敗 : %v", up.FileName, err)
 		return nil, errprocess.Set(errMsg)
 	}
 
-	// 7. 發布轉碼工作訊息到消息佇列 (Producer 動作)
-	job := domain.TranscodingJob{
-		VideoID:  video.ID,
-		FileName: video.FileName,
-		Type:     video.Type,
-	}
-	data, err := json.Marshal(job)
-	if err != nil {
-		errMsg := fmt.Sprintf("fileName[%s] Job JSON 訊息序列化失敗轉換失敗 : %v", up.FileName, err)
-		return nil, errprocess.Set(errMsg)
-	}
-	err = s.RabbitChannel.Publish(
-		"",               // 預設 exchange
-		domain.QueueName, // queue 名稱
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        data,
-		},
-	)
-	if err != nil {
-		errMsg := fmt.Sprintf("fileName[%s] 發送 RabbitMQ 訊息失敗 : %v", up.FileName, err)
-		return nil, errprocess.Set(errMsg)
-	}
-
 	// 8. 可選：清理本地暫存檔案
 	if err := os.Remove(tempPath); err != nil {
 		errMsg := fmt.Sprintf("fileName[%s] 清理暫存檔案失敗: %v", up.FileName, err)
@@ -180,8 +209,95 @@ func (s *streamingUseCase) UploadVideo(up domain.UploadVideoReq) (*domain.Upload
 
 }
 
-// GetVideo get video
-func (s *streamingUseCase) GetVideo(videoID string) (*domain.GetVideoRes, error) {
+// scheduleVisibility 決定影片可見度與排程公開時間：未指定為 public；
+// 只有要公開的影片才會排程，排程期間先以 private 保存，待 PublishScheduler 到期後改為 public；
+// 設為 unlisted 或 private 時清除排程，避免之後被 PublishScheduler 公開
+func scheduleVisibility(visibility domain.VideoVisibility, publishAt *time.Time) (domain.VideoVisibility, *time.Time) {
+	if !visibility.IsValid() {
+		visibility = domain.VisibilityPublic
+	}
+	if publishAt == nil || visibility != domain.VisibilityPublic {
+		return visibility, nil
+	}
+	return domain.VisibilityPrivate, publishAt
+}
+
+// isFreeTier 付費會員與管理員以外的上傳者皆為免費方案；角色由會員服務登入時依 member.role 寫入 token
+func isFreeTier(role string) bool {
+	return role != string(token.RolePremium) && role != string(token.RoleAdmin)
+}
+
+// getAccessibleVideo 取得影片並依可見度檢查 memberID 是否有觀看權限
+func (s *streamingUseCase) getAccessibleVideo(videoID, memberID string) (*domain.Video, error) {
+	id, _ := strconv.Atoi(videoID)
+	return getWatchableVideo(s.VideoRepo, uint(id), memberID)
+}
+
+// getWatchableVideo 取得影片並以 canWatch 檢查 memberID 是否有觀看權限，供各 usecase 共用
+func getWatchableVideo(videoRepo repository.VideoRepo, videoID uint, memberID string) (*domain.Video, error) {
+	video, err := videoRepo.GetByID(videoID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 找不到影片: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	ok, err := canWatch(videoRepo, video, memberID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%d] 查詢分享名單失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !ok {
+		errMsg := fmt.Sprintf("videoID[%d] memberID[%s] 無權限觀看此影片", videoID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+// canWatch 依審核狀態與可見度判斷 memberID 是否可觀看影片，分級的年齡限制另由 getPlayableVideo 檢查
+//   - blocked：任何人（含上傳者）皆無法播放
+//   - 管理員隱藏：僅上傳者；普遍級以外的分級：需登入
+//   - public / unlisted：任何人皆可播放（unlisted 只是不出現在列表）
+//   - private：僅上傳者與分享名單
+func canWatch(videoRepo repository.VideoRepo, video *domain.Video, memberID string) (bool, error) {
+	if video.IsBlocked() {
+		return false, nil
+	}
+	if video.Hidden {
+		return video.IsOwner(memberID), nil
+	}
+	if video.IsRestricted() && memberID == "" {
+		return false, nil
+	}
+	if domain.VideoVisibility(video.Visibility) != domain.VisibilityPrivate || video.IsOwner(memberID) {
+		return true, nil
+	}
+	if memberID == "" {
+		return false, nil
+	}
+	return videoRepo.IsSharedWith(video.ID, memberID)
+}
+
+// getPlayableVideo 取得可觀看的影片，並依分級檢查觀看者年齡，上傳者不受分級限制
+func (s *streamingUseCase) getPlayableVideo(videoID string, viewer domain.Viewer) (*domain.Video, error) {
+	return getViewerPlayableVideo(s.VideoRepo, videoID, viewer)
+}
+
+// getViewerPlayableVideo 取得影片並檢查 viewer 的觀看權限與分級年齡，供各 usecase 共用
+func getViewerPlayableVideo(videoRepo repository.VideoRepo, videoID string, viewer domain.Viewer) (*domain.Video, error) {
+	id, _ := strconv.Atoi(videoID)
+	video, err := getWatchableVideo(videoRepo, uint(id), viewer.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if !video.IsOwner(viewer.MemberID) && !viewer.CanView(video.Rating(), time.Now()) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 未達 %s 分級的觀看年齡", videoID, viewer.MemberID, video.Rating())
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+// getOwnedVideo 取得影片並確認 memberID 為上傳者
+func (s *streamingUseCase) getOwnedVideo(videoID, memberID string) (*domain.Video, error) {
 	id, _ := strconv.Atoi(videoID)
 
 	video, err := s.VideoRepo.GetByID(uint(id))
@@ -189,38 +305,85 @@ func (s *streamingUseCase) GetVideo(videoID string) (*domain.GetVideoRes, error)
 		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", videoID, err)
 		return nil, errprocess.Set(errMsg)
 	}
+	if !video.IsOwner(memberID) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", videoID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+// GetVideo get video，region 為客戶端所在地區，用於選擇 CDN
+func (s *streamingUseCase) GetVideo(videoID string, viewer domain.Viewer, region string) (*domain.GetVideoRes, error) {
+	video, err := s.getPlayableVideo(videoID, viewer)
+	if err != nil {
+		return nil, err
+	}
 	if video.Status != string(domain.VideoReady) {
 		errMsg := fmt.Sprintf("videoID[%s] 影片尚未處理完成", videoID)
 		return nil, errprocess.Set(errMsg)
 	}
 
-	hlsURL := fmt.Sprintf("http://%s/video/hls/%d/index.m3u8", "127.0.0.1:8083", video.ID)
+	tags, err := s.VideoRepo.GetVideoTags(video.ID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得影片標籤失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
 
+	var categoryID uint
+	if video.CategoryID != nil {
+		categoryID = *video.CategoryID
+	}
+
+	chapters, err := s.videoChapters(video)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得章節失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	var chaptersURL string
+	if len(chapters) > 0 {
+		chaptersURL = s.PlaybackURLs.ChaptersURL(video.ID)
+	}
+
+	playbackURLs := s.PlaybackURLs.Candidates(video, region)
 	return &domain.GetVideoRes{
-		VideoID: int(video.ID),
-		Title:   video.Title,
-		HlsURL:  hlsURL,
+		VideoID:         int(video.ID),
+		Title:           video.Title,
+		HlsURL:          playbackURLs[0].URL,
+		PlaybackURLs:    playbackURLs,
+		Visibility:      video.Visibility,
+		ContentRating:   string(video.Rating()),
+		Tags:            tags,
+		CategoryID:      categoryID,
+		Chapters:        chapters,
+		ChaptersURL:     chaptersURL,
+		SourceVideoID:   video.ClipSource(),
+		DownloadEnabled: !video.DownloadsDisabled,
 	}, nil
 }
 
 // Search Search video
-func (s *streamingUseCase) Search(keyWord string) ([]domain.Video, error) {
-	videos, err := s.VideoRepo.SearchVideos(keyWord)
+func (s *streamingUseCase) Search(filter domain.SearchFilter) ([]domain.Video, error) {
+	filter.Tag = domain.NormalizeTag(filter.Tag)
+	videos, err := s.VideoRepo.SearchVideos(filter)
 	if err != nil {
-		errMsg := fmt.Sprintf("keyword[%s] search err : %v", keyWord, err)
+		errMsg := fmt.Sprintf("keyword[%s] search err : %v", filter.Keyword, err)
 		return nil, errprocess.Set(errMsg)
 	}
 
 	videosRes := make([]domain.Video, len(videos))
 	for i, video := range videos {
 		videosRes[i] = domain.Video{
-			ID:          video.ID,
-			Title:       video.Title,
-			Description: video.Description,
-			FileName:    video.FileName,
-			Type:        video.Type,
-			Status:      video.Status,
-			ViewCount:   video.ViewCount,
+			ID:            video.ID,
+			Title:         video.Title,
+			Description:   video.Description,
+			FileName:      video.FileName,
+			Type:          video.Type,
+			Status:        video.Status,
+			ViewCount:     video.ViewCount,
+			CategoryID:    video.CategoryID,
+			ContentRating: string(video.Rating()),
+			HasPreview:    video.HasPreview,
+			PreviewURL:    s.PlaybackURLs.PreviewURL(&videos[i]),
 		}
 	}
 
@@ -228,70 +391,186 @@ func (s *streamingUseCase) Search(keyWord string) ([]domain.Video, error) {
 }
 
 // GetRecommendations get recommendations
-func (s *streamingUseCase) GetRecommendations(limit int) ([]domain.Video, error) {
-	videos, err := s.VideoRepo.RecommendVideos(limit)
+func (s *streamingUseCase) GetRecommendations(filter domain.RecommendFilter) ([]domain.Video, error) {
+	filter.Tags = domain.NormalizeTags(filter.Tags)
+	videos, err := s.VideoRepo.RecommendVideos(filter)
 	if err != nil {
-		errMsg := fmt.Sprintf("limit[%d] get recommendations err : %v", limit, err)
+		errMsg := fmt.Sprintf("limit[%d] get recommendations err : %v", filter.Limit, err)
 		return nil, errprocess.Set(errMsg)
 	}
 
 	videosRes := make([]domain.Video, len(videos))
 	for i, video := range videos {
 		videosRes[i] = domain.Video{
-			ID:          video.ID,
-			Title:       video.Title,
-			Description: video.Description,
-			FileName:    video.FileName,
-			Type:        video.Type,
-			Status:      video.Status,
-			ViewCount:   video.ViewCount,
+			ID:            video.ID,
+			Title:         video.Title,
+			Description:   video.Description,
+			FileName:      video.FileName,
+			Type:          video.Type,
+			Status:        video.Status,
+			ViewCount:     video.ViewCount,
+			CategoryID:    video.CategoryID,
+			ContentRating: string(video.Rating()),
+			HasPreview:    video.HasPreview,
+			PreviewURL:    s.PlaybackURLs.PreviewURL(&videos[i]),
 		}
 	}
 	return videosRes, nil
 }
 
 // GetIndexM3U8 實現取得 m3u8 播放清單
-func (s *streamingUseCase) GetIndexM3U8(ctx context.Context, videoID string) ([]byte, error) {
-	// 組合 object key，與原先 HTTP 版本保持一致
-	objectKey := "processed/" + videoID + "/index.m3u8"
+func (s *streamingUseCase) GetIndexM3U8(ctx context.Context, videoID string, viewer domain.Viewer) ([]byte, error) {
+	video, err := s.getPlayableVideo(videoID, viewer)
+	if err != nil {
+		return nil, err
+	}
 
-	// 对象存在后，再获取对象
-	obj, err := s.MinioClient.GetObject(ctx, objectKey, minio.GetObjectOptions{})
+	content, err := s.readPlaylist(ctx, video)
 	if err != nil {
-		errMsg := fmt.Sprintf("videoID[%s] 無法取得 m3u8 檔案 : %v", videoID, err)
-		return nil, errprocess.Set(errMsg)
+		return nil, err
 	}
-	// defer obj.Close()
 
-	// 读取全部内容
-	content, err := readFile(obj)
+	// 章節以 EXT-X-DATERANGE 標示，取得失敗時照常播放
+	chapters, err := s.videoChapters(video)
 	if err != nil {
-		errMsg := fmt.Sprintf("videoID[%s] 讀取 m3u8 檔案失敗 : %v", videoID, err)
-		return nil, errprocess.Set(errMsg)
+		logger.Log.Errorf(fmt.Sprintf("videoID[%s] 取得章節失敗:", videoID), err)
 	}
+	content = domain.AddChapterDateRanges(content, chapters)
 
+	// 播放器重新整理播放清單不計入瀏覽，瀏覽次數由 QoEAggregator 依起播 beacon 記錄
 	return content, nil
 }
 
+// readPlaylist 由 MinIO 讀取轉碼後的 m3u8 播放清單，cold 影片由 ArchivePrefix 讀取
+func (s *streamingUseCase) readPlaylist(ctx context.Context, video *domain.Video) ([]byte, error) {
+	videoID := strconv.Itoa(int(video.ID))
+	objectKey := video.ProcessedKey(domain.PlaylistFileName)
+
+	return s.ObjectCache.Get(ctx, domain.CachedPlaylist, objectKey, func(ctx context.Context) ([]byte, error) {
+		// 对象存在后，再获取对象
+		obj, err := s.MinioClient.GetObject(ctx, objectKey, minio.GetObjectOptions{})
+		if err != nil {
+			errMsg := fmt.Sprintf("videoID[%s] 無法取得 m3u8 檔案 : %v", videoID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		// defer obj.Close()
+
+		// 读取全部内容
+		content, err := readFile(obj)
+		if err != nil {
+			errMsg := fmt.Sprintf("videoID[%s] 讀取 m3u8 檔案失敗 : %v", videoID, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		return content, nil
+	})
+}
+
 // GetHlsSegment 實現取得 TS 分段檔案
-func (s *streamingUseCase) GetHlsSegment(ctx context.Context, videoID, segment string) ([]byte, error) {
-	// 組合 object key，例如 "processed/{videoID}/{segment}"
-	objectKey := "processed/" + videoID + "/" + segment
+func (s *streamingUseCase) GetHlsSegment(ctx context.Context, videoID, segment string, viewer domain.Viewer) ([]byte, error) {
+	video, err := s.getPlayableVideo(videoID, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 組合 object key，例如 "processed/{videoID}/{segment}"，cold 影片位於 archive/ 下
+	objectKey := video.ProcessedKey(segment)
+
+	return s.ObjectCache.Get(ctx, domain.CachedSegment, objectKey, func(ctx context.Context) ([]byte, error) {
+		// 对象存在后，再获取对象
+		obj, err := s.MinioClient.GetObject(ctx, objectKey, minio.GetObjectOptions{})
+		if err != nil {
+			errMsg := fmt.Sprintf("videoID_segment[%s_%s] 無法取得 segment 檔案 : %v", videoID, segment, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		// defer obj.Close()
 
-	// 对象存在后，再获取对象
-	obj, err := s.MinioClient.GetObject(ctx, objectKey, minio.GetObjectOptions{})
+		// 读取全部内容
+		content, err := readFile(obj)
+		if err != nil {
+			errMsg := fmt.Sprintf("videoID_segment[%s_%s] 讀取 segment 檔案失敗 : %v", videoID, segment, err)
+			return nil, errprocess.Set(errMsg)
+		}
+		return content, nil
+	})
+}
+
+// UpdateVisibility 由上傳者設定影片可見度與排程公開時間
+func (s *streamingUseCase) UpdateVisibility(ctx context.Context, req domain.UpdateVisibilityReq) error {
+	if !req.Visibility.IsValid() {
+		errMsg := fmt.Sprintf("videoID[%s] 不支援的可見度: %s", req.VideoID, req.Visibility)
+		return errprocess.Set(errMsg)
+	}
+
+	if req.PublishAt != nil && !req.PublishAt.After(time.Now()) {
+		errMsg := fmt.Sprintf("videoID[%s] 排程公開時間必須晚於現在", req.VideoID)
+		return errprocess.Set(errMsg)
+	}
+
+	video, err := s.getOwnedVideo(req.VideoID, req.MemberID)
 	if err != nil {
-		errMsg := fmt.Sprintf("videoID_segment[%s_%s] 無法取得 segment 檔案 : %v", videoID, segment, err)
-		return nil, errprocess.Set(errMsg)
+		return err
 	}
-	// defer obj.Close()
 
-	// 读取全部内容
-	content, err := readFile(obj)
+	visibility, publishAt := scheduleVisibility(req.Visibility, req.PublishAt)
+	video.Visibility = string(visibility)
+	video.PublishAt = publishAt
+
+	if err := s.VideoRepo.Update(video); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 更新可見度失敗: %v", req.VideoID, err)
+		return errprocess.Set(errMsg)
+	}
+	revokePublicCopy(ctx, s.MinioClient, s.VideoRepo, video)
+	return nil
+}
+
+// DeleteVideo 上傳者或管理員刪除影片，並釋放上傳者的儲存量與影片數；當日上傳次數不會減少
+// 公開副本立即刪除，其餘 MinIO 物件由 OrphanGC 回收
+func (s *streamingUseCase) DeleteVideo(ctx context.Context, req domain.DeleteVideoReq) error {
+	id, _ := strconv.Atoi(req.VideoID)
+	video, err := s.VideoRepo.GetByID(uint(id))
 	if err != nil {
-		errMsg := fmt.Sprintf("videoID_segment[%s_%s] 讀取 segment 檔案失敗 : %v", videoID, segment, err)
-		return nil, errprocess.Set(errMsg)
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", req.VideoID, err)
+		return errprocess.Set(errMsg)
+	}
+	if !video.IsOwner(req.MemberID) && req.Role != string(token.RoleAdmin) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", req.VideoID, req.MemberID)
+		return errprocess.Set(errMsg)
 	}
+	if err := s.VideoRepo.Delete(video); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 刪除影片失敗: %v", req.VideoID, err)
+		return errprocess.Set(errMsg)
+	}
+	if err := removePublicCopy(ctx, s.MinioClient, s.VideoRepo, video); err != nil {
+		// 影片記錄已刪除，留下的副本由 OrphanGC 回收
+		logger.Log.Errorf(fmt.Sprintf("videoID[%d] 刪除公開副本失敗:", video.ID), err)
+	}
+	return nil
+}
 
-	return content, nil
+// ShareVideo 將 targetMemberIDs 加入私人影片的分享名單
+func (s *streamingUseCase) ShareVideo(ctx context.Context, videoID, memberID string, targetMemberIDs []string) error {
+	video, err := s.getOwnedVideo(videoID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.VideoRepo.AddShares(video.ID, targetMemberIDs); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 新增分享名單失敗: %v", videoID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
+}
+
+// UnshareVideo 將 targetMemberIDs 從私人影片的分享名單移除
+func (s *streamingUseCase) UnshareVideo(ctx context.Context, videoID, memberID string, targetMemberIDs []string) error {
+	video, err := s.getOwnedVideo(videoID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.VideoRepo.RemoveShares(video.ID, targetMemberIDs); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 移除分享名單失敗: %v", videoID, err)
+		return errprocess.Set(errMsg)
+	}
+	return nil
 }
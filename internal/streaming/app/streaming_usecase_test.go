@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
 	"streaming_video_service/pkg/logger"
 
 	"github.com/minio/minio-go/v7"
@@ -51,6 +52,30 @@ func (m *MockMinIOClient) GetObject(ctx context.Context, objectName string, opts
 	return args.Get(0).(io.Reader), args.Error(1)
 }
 
+// ObjectExists 模擬 MinIO 確認物件是否存在
+func (m *MockMinIOClient) ObjectExists(ctx context.Context, objectName string) (bool, error) {
+	args := m.Called(ctx, objectName)
+	return args.Bool(0), args.Error(1)
+}
+
+// ListObjects 模擬 MinIO 列出 prefix 底下的物件
+func (m *MockMinIOClient) ListObjects(ctx context.Context, prefix string) ([]database.ObjectInfo, error) {
+	args := m.Called(ctx, prefix)
+	return args.Get(0).([]database.ObjectInfo), args.Error(1)
+}
+
+// CopyObject 模擬 MinIO 複製物件
+func (m *MockMinIOClient) CopyObject(ctx context.Context, srcObject, destObject string) error {
+	args := m.Called(ctx, srcObject, destObject)
+	return args.Error(0)
+}
+
+// RemoveObject 模擬 MinIO 刪除物件
+func (m *MockMinIOClient) RemoveObject(ctx context.Context, objectName string) error {
+	args := m.Called(ctx, objectName)
+	return args.Error(0)
+}
+
 // MockVideoRepo 是 VideoRepo 的 Mock
 type MockVideoRepo struct {
 	mock.Mock
@@ -72,12 +97,96 @@ func (m *MockVideoRepo) GetByID(id uint) (*domain.Video, error) {
 	return args.Get(0).(*domain.Video), args.Error(1)
 }
 
+// GetByIDs 模擬批次取得影片
+func (m *MockVideoRepo) GetByIDs(ids []uint) ([]domain.Video, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
 // Update 模擬更新影片記錄
 func (m *MockVideoRepo) Update(video *domain.Video) error {
 	args := m.Called(video)
 	return args.Error(0)
 }
 
+// Delete 模擬刪除影片與關聯資料並釋放用量
+func (m *MockVideoRepo) Delete(video *domain.Video) error {
+	args := m.Called(video)
+	return args.Error(0)
+}
+
+// SaveReadyWithEvents 模擬 Worker 以條件更新將影片改為 ready，更新成功後才建立事件
+func (m *MockVideoRepo) SaveReadyWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	args := m.Called(video)
+	if args.Bool(0) && args.Error(1) == nil {
+		if _, err := events(video); err != nil {
+			return false, err
+		}
+	}
+	return args.Bool(0), args.Error(1)
+}
+
+// SaveWithEvents 模擬在同一個交易內寫入影片與 outbox 事件，寫入成功後才建立事件
+func (m *MockVideoRepo) SaveWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) error {
+	args := m.Called(video)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	_, err := events(video)
+	return err
+}
+
+// UpdateStorageState 模擬更新儲存狀態
+func (m *MockVideoRepo) UpdateStorageState(video *domain.Video) error {
+	args := m.Called(video)
+	return args.Error(0)
+}
+
+// SetPublicCopy 模擬記錄公開副本
+func (m *MockVideoRepo) SetPublicCopy(videoID uint, published bool) error {
+	args := m.Called(videoID, published)
+	return args.Error(0)
+}
+
+// FindPublicCopyMismatches 模擬找出公開副本不一致的影片
+func (m *MockVideoRepo) FindPublicCopyMismatches(limit int) ([]domain.Video, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
+// ClaimTranscode 模擬認領轉碼工作
+func (m *MockVideoRepo) ClaimTranscode(videoID uint) (bool, error) {
+	args := m.Called(videoID)
+	return args.Bool(0), args.Error(1)
+}
+
+// TouchTranscode 模擬更新轉碼心跳
+func (m *MockVideoRepo) TouchTranscode(videoID uint) error {
+	args := m.Called(videoID)
+	return args.Error(0)
+}
+
+// ReleaseTranscode 模擬釋放轉碼工作，回傳 true 時以 failed 狀態建立事件
+func (m *MockVideoRepo) ReleaseTranscode(videoID uint, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	args := m.Called(videoID)
+	if !args.Bool(0) || args.Error(1) != nil {
+		return args.Bool(0), args.Error(1)
+	}
+	_, err := events(&domain.Video{ID: videoID, Status: string(domain.VideoFailed)})
+	return true, err
+}
+
+// SaveStuckWithEvents 模擬以條件更新處理卡住的影片，回傳 true 時才建立事件
+func (m *MockVideoRepo) SaveStuckWithEvents(video *domain.Video, status string, stuckBefore time.Time,
+	events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	args := m.Called(video, status)
+	if !args.Bool(0) || args.Error(1) != nil {
+		return args.Bool(0), args.Error(1)
+	}
+	_, err := events(video)
+	return true, err
+}
+
 // Update 模擬更新影片記錄
 func (m *MockVideoRepo) FindByStatus(status string) ([]domain.Video, error) {
 	args := m.Called(status)
@@ -85,14 +194,122 @@ func (m *MockVideoRepo) FindByStatus(status string) ([]domain.Video, error) {
 }
 
 // Update 模擬更新影片記錄
-func (m *MockVideoRepo) SearchVideos(keyword string) ([]domain.Video, error) {
-	args := m.Called(keyword)
+func (m *MockVideoRepo) SearchVideos(filter domain.SearchFilter) ([]domain.Video, error) {
+	args := m.Called(filter)
 	return args.Get(0).([]domain.Video), args.Error(1)
 }
 
 // Update 模擬更新影片記錄
-func (m *MockVideoRepo) RecommendVideos(limit int) ([]domain.Video, error) {
-	args := m.Called(limit)
+func (m *MockVideoRepo) RecommendVideos(filter domain.RecommendFilter) ([]domain.Video, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
+// RelatedVideos 模擬取得相關影片
+func (m *MockVideoRepo) RelatedVideos(videoID uint, maxRating domain.ContentRating, limit int) ([]domain.Video, error) {
+	args := m.Called(videoID, maxRating, limit)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
+// AddShares 模擬新增分享名單
+func (m *MockVideoRepo) AddShares(videoID uint, memberIDs []string) error {
+	args := m.Called(videoID, memberIDs)
+	return args.Error(0)
+}
+
+// RemoveShares 模擬移除分享名單
+func (m *MockVideoRepo) RemoveShares(videoID uint, memberIDs []string) error {
+	args := m.Called(videoID, memberIDs)
+	return args.Error(0)
+}
+
+// IsSharedWith 模擬查詢分享名單
+func (m *MockVideoRepo) IsSharedWith(videoID uint, memberID string) (bool, error) {
+	args := m.Called(videoID, memberID)
+	return args.Bool(0), args.Error(1)
+}
+
+// PublishDue 模擬發布排程影片
+func (m *MockVideoRepo) PublishDue(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ListCategories 模擬分頁列出分類
+func (m *MockVideoRepo) ListCategories(offset, limit int) ([]domain.Category, int64, error) {
+	args := m.Called(offset, limit)
+	return args.Get(0).([]domain.Category), args.Get(1).(int64), args.Error(2)
+}
+
+// GetCategory 模擬取得分類
+func (m *MockVideoRepo) GetCategory(id uint) (*domain.Category, error) {
+	args := m.Called(id)
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+// ListByCategory 模擬分頁列出分類影片
+func (m *MockVideoRepo) ListByCategory(categoryID uint, maxRating domain.ContentRating, offset, limit int) ([]domain.Video, int64, error) {
+	args := m.Called(categoryID, maxRating, offset, limit)
+	return args.Get(0).([]domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+// SetVideoTags 模擬設定影片標籤
+func (m *MockVideoRepo) SetVideoTags(videoID uint, tags []string) error {
+	args := m.Called(videoID, tags)
+	return args.Error(0)
+}
+
+// GetVideoTags 模擬取得影片標籤
+func (m *MockVideoRepo) GetVideoTags(videoID uint) ([]string, error) {
+	args := m.Called(videoID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// RecordView 模擬記錄瀏覽
+func (m *MockVideoRepo) RecordView(videoID uint, at time.Time) error {
+	args := m.Called(videoID, at)
+	return args.Error(0)
+}
+
+// HourlyViews 模擬取得每小時瀏覽數
+func (m *MockVideoRepo) HourlyViews(since time.Time) ([]domain.HourlyActivity, error) {
+	args := m.Called(since)
+	return args.Get(0).([]domain.HourlyActivity), args.Error(1)
+}
+
+// ListShorts 模擬列出短影音
+func (m *MockVideoRepo) ListShorts(query domain.ShortsQuery) ([]domain.Video, error) {
+	args := m.Called(query)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
+// SetChapters 模擬設定章節
+func (m *MockVideoRepo) SetChapters(videoID uint, chapters []domain.Chapter) error {
+	args := m.Called(videoID, chapters)
+	return args.Error(0)
+}
+
+// GetChapters 模擬取得章節
+func (m *MockVideoRepo) GetChapters(videoID uint) ([]domain.Chapter, error) {
+	args := m.Called(videoID)
+	return args.Get(0).([]domain.Chapter), args.Error(1)
+}
+
+// ListByMember 模擬列出會員上傳的影片
+func (m *MockVideoRepo) ListByMember(memberID string, offset, limit int) ([]domain.Video, int64, error) {
+	args := m.Called(memberID, offset, limit)
+	return args.Get(0).([]domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+// FindOriginalsReadyBefore 模擬找出原始檔可處理的影片
+func (m *MockVideoRepo) FindOriginalsReadyBefore(before time.Time) ([]domain.Video, error) {
+	args := m.Called(before)
+	return args.Get(0).([]domain.Video), args.Error(1)
+}
+
+// FindColdCandidates 模擬找出長期沒有瀏覽的影片
+func (m *MockVideoRepo) FindColdCandidates(before time.Time) ([]domain.Video, error) {
+	args := m.Called(before)
 	return args.Get(0).([]domain.Video), args.Error(1)
 }
 
@@ -130,9 +347,8 @@ func (m *mockFileSystemHelper) Create(name string) (*os.File, error) {
 func TestUploadVideo(t *testing.T) {
 	mockMinIO := new(MockMinIOClient)
 	mockRepo := new(MockVideoRepo)
-	mockRabbit := new(MockRabbitChannel)
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 
 	req := domain.UploadVideoReq{
 		Title:       "Test Video",
@@ -154,19 +370,10 @@ func TestUploadVideo(t *testing.T) {
 		mockMinIO.On("UploadFile", mock.Anything, "original/1/test.mp4", mock.Anything, "video/mp4").
 			Return(nil).Once()
 
-		// Mock 影片記錄更新
-		mockRepo.On("Update", mock.Anything).Return(nil).Once()
-
-		// Mock RabbitMQ 發布轉碼工作
-		mockRabbit.On("Publish",
-			"",               // exchange
-			domain.QueueName, // queue
-			false,            // mandatory
-			false,            // immediate
-			mock.MatchedBy(func(p amqp.Publishing) bool {
-				return p.ContentType == "application/json" && len(p.Body) > 0
-			}),
-		).Return(nil).Once()
+		// Mock 影片記錄更新與轉碼工作事件寫入 outbox
+		mockRepo.On("SaveWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.ID == 1 && v.FileName == "original/1/test.mp4"
+		})).Return(nil).Once()
 
 		// 執行測試
 		resp, err := usecase.UploadVideo(req)
@@ -180,7 +387,6 @@ func TestUploadVideo(t *testing.T) {
 		// 驗證 Mock 方法是否被正確呼叫
 		mockRepo.AssertExpectations(t)
 		mockMinIO.AssertExpectations(t)
-		mockRabbit.AssertExpectations(t)
 	})
 
 	//**情境 2: 建立暫存目錄失敗**
@@ -260,7 +466,7 @@ func TestUploadVideo(t *testing.T) {
 		}).Once()
 
 		mockMinIO.On("UploadFile", mock.Anything, "original/1/test.mp4", mock.Anything, "video/mp4").Return(nil).Once()
-		mockRepo.On("Update", mock.Anything).Return(errors.New("update error")).Once()
+		mockRepo.On("SaveWithEvents", mock.Anything).Return(errors.New("update error")).Once()
 
 		resp, err := usecase.UploadVideo(req)
 		assert.Error(t, err)
@@ -268,42 +474,61 @@ func TestUploadVideo(t *testing.T) {
 		assert.Nil(t, resp)
 	})
 
-	//**情境 8: 發布轉碼訊息失敗**
-	t.Run("發布轉碼工作訊息失敗", func(t *testing.T) {
-		mockRepo.On("Create", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
-			video := args.Get(0).(*domain.Video)
-			video.ID = 1
-		})
-		mockMinIO.On("UploadFile", mock.Anything, "original/1/test.mp4", mock.Anything, "video/mp4").Return(nil)
-		mockRepo.On("Update", mock.Anything).Return(nil)
-		mockRabbit.On("Publish",
-			"",               // exchange
-			domain.QueueName, // key (queue 名稱)
-			false,            // mandatory
-			false,            // immediate
-			mock.MatchedBy(func(p amqp.Publishing) bool {
-				return p.ContentType == "application/json" && len(p.Body) > 0
-			}),
-		).Return(errors.New("rabbit error")).Once()
+	//**情境 8: 上傳時設定標籤與分類**
+	t.Run("上傳時設定標籤與分類", func(t *testing.T) {
+		tagRepo := new(MockVideoRepo)
+		tagMinIO := new(MockMinIOClient)
+		tagUsecase := NewStreamingUseCase(tagMinIO, tagRepo, nil, nil)
+
+		tagReq := req
+		tagReq.File = bytes.NewReader([]byte("dummy video content"))
+		tagReq.Tags = []string{" #Go ", "go", "Tutorial", ""}
+		tagReq.CategoryID = 3
+
+		tagRepo.On("GetCategory", uint(3)).Return(&domain.Category{ID: 3, Slug: "education"}, nil).Once()
+		tagRepo.On("Create", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.CategoryID != nil && *v.CategoryID == 3
+		})).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(0).(*domain.Video).ID = 2
+		}).Once()
+		tagRepo.On("SetVideoTags", uint(2), []string{"go", "tutorial"}).Return(nil).Once()
+		tagMinIO.On("UploadFile", mock.Anything, "original/2/test.mp4", mock.Anything, "video/mp4").Return(nil).Once()
+		tagRepo.On("SaveWithEvents", mock.Anything).Return(nil).Once()
+
+		resp, err := tagUsecase.UploadVideo(tagReq)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.VideoID)
+		tagRepo.AssertExpectations(t)
+		tagMinIO.AssertExpectations(t)
+	})
+
+	//**情境 9: 分類不存在**
+	t.Run("分類不存在", func(t *testing.T) {
+		badReq := req
+		badReq.CategoryID = 99
+
+		mockRepo.On("GetCategory", uint(99)).Return((*domain.Category)(nil), errors.New("record not found")).Once()
+
+		resp, err := usecase.UploadVideo(badReq)
 
-		resp, err := usecase.UploadVideo(req)
 		assert.Error(t, err)
-		assert.Equal(t, fmt.Sprintf("fileName[%s] 發送 RabbitMQ 訊息失敗 : rabbit error", req.FileName), err.Error())
+		assert.Equal(t, fmt.Sprintf("fileName[%s] 分類[%d]不存在 : record not found", req.FileName, 99), err.Error())
 		assert.Nil(t, resp)
+		mockRepo.AssertExpectations(t)
 	})
 }
 
 func TestGetVideo(t *testing.T) {
 	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
 	mockMinIO := new(MockMinIOClient)
-	mockRabbit := new(MockRabbitChannel)
 
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 
 	videoID := "1"
 	parsedID, _ := strconv.Atoi(videoID) // 轉換為 int
-	hlsURL := fmt.Sprintf("http://%s/video/hls/%d/index.m3u8", "127.0.0.1:8083", parsedID)
+	hlsURL := fmt.Sprintf("%s/video/hls/%d/index", domain.DefaultGatewayURL, parsedID)
 
 	// **情境 1: 成功取得影片**
 	t.Run("成功取得影片", func(t *testing.T) {
@@ -312,14 +537,17 @@ func TestGetVideo(t *testing.T) {
 			Title:  "Test Video",
 			Status: string(domain.VideoReady),
 		}, nil).Once()
+		mockRepo.On("GetVideoTags", uint(parsedID)).Return([]string{"go", "tutorial"}, nil).Once()
+		mockRepo.On("GetChapters", uint(parsedID)).Return([]domain.Chapter{}, nil).Once()
 
-		resp, err := usecase.GetVideo(videoID)
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{}, "")
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, parsedID, resp.VideoID)
 		assert.Equal(t, "Test Video", resp.Title)
 		assert.Equal(t, hlsURL, resp.HlsURL)
+		assert.Equal(t, []string{"go", "tutorial"}, resp.Tags)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -328,7 +556,7 @@ func TestGetVideo(t *testing.T) {
 	t.Run("影片不存在", func(t *testing.T) {
 		mockRepo.On("GetByID", uint(parsedID)).Return(&domain.Video{ID: uint(parsedID)}, errors.New("影片不存在")).Once()
 
-		resp, err := usecase.GetVideo(videoID)
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{}, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -345,7 +573,7 @@ func TestGetVideo(t *testing.T) {
 			Status: string(domain.VideoProcessing),
 		}, nil).Once()
 
-		resp, err := usecase.GetVideo(videoID)
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{}, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -355,18 +583,360 @@ func TestGetVideo(t *testing.T) {
 	})
 }
 
+func TestGetVideoVisibility(t *testing.T) {
+	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
+	mockMinIO := new(MockMinIOClient)
+
+	logger.SetNewNop()
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+	videoID := "1"
+	privateVideo := func() *domain.Video {
+		return &domain.Video{
+			ID:         1,
+			MemberID:   "owner",
+			Title:      "Private Video",
+			Status:     string(domain.VideoReady),
+			Visibility: string(domain.VisibilityPrivate),
+		}
+	}
+
+	// **情境 1: 上傳者可觀看私人影片**
+	t.Run("上傳者可觀看私人影片", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(privateVideo(), nil).Once()
+		mockRepo.On("GetVideoTags", uint(1)).Return([]string{}, nil).Once()
+		mockRepo.On("GetChapters", uint(1)).Return([]domain.Chapter{}, nil).Once()
+
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{MemberID: "owner"}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, string(domain.VisibilityPrivate), resp.Visibility)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 分享名單可觀看私人影片**
+	t.Run("分享名單可觀看私人影片", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(privateVideo(), nil).Once()
+		mockRepo.On("IsSharedWith", uint(1), "friend").Return(true, nil).Once()
+		mockRepo.On("GetVideoTags", uint(1)).Return([]string{}, nil).Once()
+		mockRepo.On("GetChapters", uint(1)).Return([]domain.Chapter{}, nil).Once()
+
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{MemberID: "friend"}, "")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 非分享名單無法觀看私人影片**
+	t.Run("非分享名單無法觀看私人影片", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(privateVideo(), nil).Once()
+		mockRepo.On("IsSharedWith", uint(1), "stranger").Return(false, nil).Once()
+
+		resp, err := usecase.GetVideo(videoID, domain.Viewer{MemberID: "stranger"}, "")
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fmt.Sprintf("videoID[%s] memberID[%s] 無權限觀看此影片", videoID, "stranger"), err.Error())
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 私人影片的 HLS 同樣受限**
+	t.Run("私人影片的 HLS 同樣受限", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(privateVideo(), nil).Once()
+
+		resp, err := usecase.GetIndexM3U8(context.Background(), videoID, domain.Viewer{})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		mockMinIO.AssertNotCalled(t, "GetObject", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUpdateVisibility(t *testing.T) {
+	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
+	mockMinIO := new(MockMinIOClient)
+
+	logger.SetNewNop()
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+	videoID := "1"
+
+	// **情境 1: 上傳者設為不公開**
+	t.Run("上傳者設為不公開", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("Update", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Visibility == string(domain.VisibilityUnlisted) && v.PublishAt == nil
+		})).Return(nil).Once()
+
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "owner",
+			Visibility: domain.VisibilityUnlisted,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 排程公開時先保持私人**
+	t.Run("排程公開時先保持私人", func(t *testing.T) {
+		publishAt := time.Now().Add(time.Hour)
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("Update", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Visibility == string(domain.VisibilityPrivate) && v.PublishAt.Equal(publishAt)
+		})).Return(nil).Once()
+
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "owner",
+			Visibility: domain.VisibilityPublic,
+			PublishAt:  &publishAt,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 非上傳者無法修改**
+	t.Run("非上傳者無法修改", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "other",
+			Visibility: domain.VisibilityPrivate,
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", videoID, "other"), err.Error())
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 不支援的可見度**
+	t.Run("不支援的可見度", func(t *testing.T) {
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "owner",
+			Visibility: "secret",
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("videoID[%s] 不支援的可見度: %s", videoID, "secret"), err.Error())
+	})
+
+	// **情境 5: 改為不公開時清除排程，避免之後被 PublishScheduler 公開**
+	t.Run("改為不公開時清除排程", func(t *testing.T) {
+		scheduled := time.Now().Add(time.Hour)
+		publishAt := time.Now().Add(2 * time.Hour)
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner", PublishAt: &scheduled}, nil).Once()
+		mockRepo.On("Update", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Visibility == string(domain.VisibilityUnlisted) && v.PublishAt == nil
+		})).Return(nil).Once()
+
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "owner",
+			Visibility: domain.VisibilityUnlisted,
+			PublishAt:  &publishAt,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 6: 排程時間已過**
+	t.Run("排程時間已過", func(t *testing.T) {
+		publishAt := time.Now().Add(-time.Minute)
+
+		err := usecase.UpdateVisibility(context.Background(), domain.UpdateVisibilityReq{
+			VideoID:    videoID,
+			MemberID:   "owner",
+			Visibility: domain.VisibilityPublic,
+			PublishAt:  &publishAt,
+		})
+
+		assert.EqualError(t, err, fmt.Sprintf("videoID[%s] 排程公開時間必須晚於現在", videoID))
+	})
+}
+
+func TestDeleteVideo(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 上傳者與管理員可刪除**
+	t.Run("可刪除", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+		video := &domain.Video{ID: 1, MemberID: "owner", SizeBytes: 300}
+		mockRepo.On("GetByID", uint(1)).Return(video, nil).Twice()
+		mockRepo.On("Delete", video).Return(nil).Twice()
+
+		assert.NoError(t, usecase.DeleteVideo(ctx, domain.DeleteVideoReq{VideoID: "1", MemberID: "owner"}))
+		assert.NoError(t, usecase.DeleteVideo(ctx, domain.DeleteVideoReq{VideoID: "1", MemberID: "a1", Role: "admin"}))
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 有公開副本時刪除記錄後立即刪除副本，刪除失敗不影響結果**
+	t.Run("刪除公開副本", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+		video := &domain.Video{ID: 1, MemberID: "owner", PublicCopy: true}
+		mockRepo.On("GetByID", uint(1)).Return(video, nil).Once()
+		deleted := mockRepo.On("Delete", video).Return(nil).Once()
+		mockMinIO.On("ListObjects", ctx, "public/1/").Return([]database.ObjectInfo{{Key: "public/1/index.m3u8"}}, nil).Once().NotBefore(deleted)
+		mockMinIO.On("RemoveObject", ctx, "public/1/index.m3u8").Return(errors.New("minio down")).Once()
+
+		assert.NoError(t, usecase.DeleteVideo(ctx, domain.DeleteVideoReq{VideoID: "1", MemberID: "owner"}))
+		mockRepo.AssertExpectations(t)
+		mockMinIO.AssertExpectations(t)
+	})
+
+	// **情境 3: 非上傳者無法刪除**
+	t.Run("非上傳者", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		usecase := NewStreamingUseCase(new(MockMinIOClient), mockRepo, nil, nil)
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+
+		err := usecase.DeleteVideo(ctx, domain.DeleteVideoReq{VideoID: "1", MemberID: "other", Role: "premium"})
+
+		assert.EqualError(t, err, "videoID[1] memberID[other] 非影片擁有者")
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
+
+func TestShareVideo(t *testing.T) {
+	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
+	mockMinIO := new(MockMinIOClient)
+
+	logger.SetNewNop()
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+	videoID := "1"
+	targets := []string{"friend1", "friend2"}
+
+	// **情境 1: 上傳者新增分享名單**
+	t.Run("上傳者新增分享名單", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("AddShares", uint(1), targets).Return(nil).Once()
+
+		err := usecase.ShareVideo(context.Background(), videoID, "owner", targets)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 上傳者移除分享名單失敗**
+	t.Run("上傳者移除分享名單失敗", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("RemoveShares", uint(1), targets).Return(errors.New("db error")).Once()
+
+		err := usecase.UnshareVideo(context.Background(), videoID, "owner", targets)
+
+		assert.Error(t, err)
+		assert.Equal(t, fmt.Sprintf("videoID[%s] 移除分享名單失敗: db error", videoID), err.Error())
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestBrowseCategory(t *testing.T) {
+	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
+	mockMinIO := new(MockMinIOClient)
+
+	logger.SetNewNop()
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+	// **情境 1: 分頁參數修正後查詢**
+	t.Run("分頁參數修正後查詢", func(t *testing.T) {
+		mockRepo.On("GetCategory", uint(1)).Return(&domain.Category{ID: 1, Slug: "music"}, nil).Once()
+		mockRepo.On("ListByCategory", uint(1), domain.RatingAll, 0, domain.DefaultPageSize).Return([]domain.Video{{ID: 1}, {ID: 2}}, int64(2), nil).Once()
+
+		videos, total, err := usecase.BrowseCategory(1, domain.RatingAll, domain.Pagination{Page: 0, PageSize: 0})
+
+		assert.NoError(t, err)
+		assert.Len(t, videos, 2)
+		assert.Equal(t, int64(2), total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 第二頁**
+	t.Run("第二頁", func(t *testing.T) {
+		mockRepo.On("GetCategory", uint(1)).Return(&domain.Category{ID: 1, Slug: "music"}, nil).Once()
+		mockRepo.On("ListByCategory", uint(1), domain.RatingTeen, 10, 10).Return([]domain.Video{}, int64(12), nil).Once()
+
+		_, total, err := usecase.BrowseCategory(1, domain.RatingTeen, domain.Pagination{Page: 2, PageSize: 10})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 3: 分類不存在**
+	t.Run("分類不存在", func(t *testing.T) {
+		mockRepo.On("GetCategory", uint(99)).Return((*domain.Category)(nil), errors.New("record not found")).Once()
+
+		videos, _, err := usecase.BrowseCategory(99, domain.RatingAll, domain.Pagination{})
+
+		assert.Error(t, err)
+		assert.Nil(t, videos)
+		assert.Equal(t, "categoryID[99] 找不到分類 : record not found", err.Error())
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGetRelatedVideos(t *testing.T) {
+	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
+	mockMinIO := new(MockMinIOClient)
+
+	logger.SetNewNop()
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
+
+	categoryID := uint(2)
+	source := &domain.Video{ID: 1, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic), CategoryID: &categoryID}
+
+	// **情境 1: 標籤相關影片足夠**
+	t.Run("標籤相關影片足夠", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(source, nil).Once()
+		mockRepo.On("RelatedVideos", uint(1), domain.RatingAll, 2).Return([]domain.Video{{ID: 5}, {ID: 6}}, nil).Once()
+
+		videos, err := usecase.GetRelatedVideos("1", "", domain.RatingAll, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, videos, 2)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 以同分類影片補足並排除重複**
+	t.Run("以同分類影片補足並排除重複", func(t *testing.T) {
+		mockRepo.On("GetByID", uint(1)).Return(source, nil).Once()
+		mockRepo.On("RelatedVideos", uint(1), domain.RatingAll, 3).Return([]domain.Video{{ID: 5}}, nil).Once()
+		mockRepo.On("ListByCategory", categoryID, domain.RatingAll, 0, 5).Return([]domain.Video{{ID: 1}, {ID: 5}, {ID: 7}, {ID: 8}, {ID: 9}}, int64(5), nil).Once()
+
+		videos, err := usecase.GetRelatedVideos("1", "", domain.RatingAll, 3)
+
+		assert.NoError(t, err)
+		ids := make([]uint, len(videos))
+		for i, v := range videos {
+			ids[i] = v.ID
+		}
+		assert.Equal(t, []uint{5, 7, 8}, ids)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestSearch(t *testing.T) {
 	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
 	mockMinIO := new(MockMinIOClient)
-	mockRabbit := new(MockRabbitChannel)
 
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 
 	keyWord := "test"
 	// **情境 1: 成功取得影片**
 	t.Run("成功取得影片", func(t *testing.T) {
-		mockRepo.On("SearchVideos", keyWord).Return([]domain.Video{
+		mockRepo.On("SearchVideos", domain.SearchFilter{Keyword: keyWord}).Return([]domain.Video{
 			{ID: 1,
 				Title:       "title1",
 				Description: "desc1",
@@ -383,7 +953,7 @@ func TestSearch(t *testing.T) {
 				ViewCount:   200},
 		}, nil).Once()
 
-		resp, err := usecase.Search(keyWord)
+		resp, err := usecase.Search(domain.SearchFilter{Keyword: keyWord})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -392,8 +962,8 @@ func TestSearch(t *testing.T) {
 
 	// **情境 2: 找不到影片**
 	t.Run("找不到影片", func(t *testing.T) {
-		mockRepo.On("SearchVideos", keyWord).Return([]domain.Video{}, errors.New("找不到影片")).Once()
-		resp, err := usecase.Search(keyWord)
+		mockRepo.On("SearchVideos", domain.SearchFilter{Keyword: keyWord}).Return([]domain.Video{}, errors.New("找不到影片")).Once()
+		resp, err := usecase.Search(domain.SearchFilter{Keyword: keyWord})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -405,15 +975,14 @@ func TestSearch(t *testing.T) {
 func TestGetRecommendations(t *testing.T) {
 	mockRepo := new(MockVideoRepo) // Mock 影片儲存庫
 	mockMinIO := new(MockMinIOClient)
-	mockRabbit := new(MockRabbitChannel)
 
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 
 	limit := 10
 	// **情境 1: 成功取得影片**
 	t.Run("成功取得影片", func(t *testing.T) {
-		mockRepo.On("RecommendVideos", limit).Return([]domain.Video{
+		mockRepo.On("RecommendVideos", domain.RecommendFilter{Limit: limit, Tags: []string{}}).Return([]domain.Video{
 			{ID: 1,
 				Title:       "title1",
 				Description: "desc1",
@@ -430,7 +999,7 @@ func TestGetRecommendations(t *testing.T) {
 				ViewCount:   200},
 		}, nil).Once()
 
-		resp, err := usecase.GetRecommendations(limit)
+		resp, err := usecase.GetRecommendations(domain.RecommendFilter{Limit: limit})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -439,8 +1008,8 @@ func TestGetRecommendations(t *testing.T) {
 
 	// **情境 2: 找不到影片**
 	t.Run("找不到影片", func(t *testing.T) {
-		mockRepo.On("RecommendVideos", limit).Return([]domain.Video{}, errors.New("找不到影片")).Once()
-		resp, err := usecase.GetRecommendations(limit)
+		mockRepo.On("RecommendVideos", domain.RecommendFilter{Limit: limit, Tags: []string{}}).Return([]domain.Video{}, errors.New("找不到影片")).Once()
+		resp, err := usecase.GetRecommendations(domain.RecommendFilter{Limit: limit})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -468,13 +1037,17 @@ func mockMinioObject() *minio.Object {
 func TestGetIndexM3U8(t *testing.T) {
 	mockMinIO := new(MockMinIOClient)
 	mockRepo := new(MockVideoRepo)
-	mockRabbit := new(MockRabbitChannel)
 
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 	ctx := context.Background()
 	videoID := "1"
 	objectKey := "processed/" + videoID + "/index.m3u8"
+	mockRepo.On("GetByID", uint(1)).Return(&domain.Video{
+		ID:         1,
+		Status:     string(domain.VideoReady),
+		Visibility: string(domain.VisibilityPublic),
+	}, nil)
 
 	//  正確的 Mock MinIO 回傳
 	mockContent := []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1280000\nindex.m3u8")
@@ -491,20 +1064,25 @@ func TestGetIndexM3U8(t *testing.T) {
 			return mockContent, nil
 		}
 
-		resp, err := usecase.GetIndexM3U8(ctx, videoID)
+		mockRepo.On("GetChapters", uint(1)).Return([]domain.Chapter{}, nil).Once()
+
+		resp, err := usecase.GetIndexM3U8(ctx, videoID, domain.Viewer{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 		assert.Equal(t, mockContent, resp) // 確保內容一致
+		// 瀏覽次數由起播 beacon 記錄，取得播放清單不計入
+		mockRepo.AssertNotCalled(t, "RecordView", mock.Anything, mock.Anything)
 
 		mockMinIO.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
 	})
 	// **情境 2: 無法取得m3u8檔案**
 	t.Run("無法取得 m3u8 檔案", func(t *testing.T) {
 		mockMinIO.On("GetObject", ctx, objectKey, mock.Anything).
 			Return(bytes.NewReader(nil), errors.New("minio error")).Once()
 
-		resp, err := usecase.GetIndexM3U8(ctx, videoID)
+		resp, err := usecase.GetIndexM3U8(ctx, videoID, domain.Viewer{})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -523,7 +1101,7 @@ func TestGetIndexM3U8(t *testing.T) {
 			return nil, errors.New("read error")
 		}
 
-		resp, err := usecase.GetIndexM3U8(ctx, videoID)
+		resp, err := usecase.GetIndexM3U8(ctx, videoID, domain.Viewer{})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -536,14 +1114,18 @@ func TestGetIndexM3U8(t *testing.T) {
 func TestGetHlsSegment(t *testing.T) {
 	mockMinIO := new(MockMinIOClient)
 	mockRepo := new(MockVideoRepo)
-	mockRabbit := new(MockRabbitChannel)
 
 	logger.SetNewNop()
-	usecase := NewStreamingUseCase(mockMinIO, mockRepo, mockRabbit)
+	usecase := NewStreamingUseCase(mockMinIO, mockRepo, nil, nil)
 	ctx := context.Background()
 	videoID := "1"
 	segment := "segment"
 	objectKey := "processed/" + videoID + "/" + segment
+	mockRepo.On("GetByID", uint(1)).Return(&domain.Video{
+		ID:         1,
+		Status:     string(domain.VideoReady),
+		Visibility: string(domain.VisibilityPublic),
+	}, nil)
 
 	//  正確的 Mock MinIO 回傳
 	mockContent := []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1280000\nindex.m3u8")
@@ -560,7 +1142,7 @@ func TestGetHlsSegment(t *testing.T) {
 			return mockContent, nil
 		}
 
-		resp, err := usecase.GetHlsSegment(ctx, videoID, segment)
+		resp, err := usecase.GetHlsSegment(ctx, videoID, segment, domain.Viewer{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -573,7 +1155,7 @@ func TestGetHlsSegment(t *testing.T) {
 		mockMinIO.On("GetObject", ctx, objectKey, mock.Anything).
 			Return(bytes.NewReader(nil), errors.New("minio error")).Once()
 
-		resp, err := usecase.GetHlsSegment(ctx, videoID, segment)
+		resp, err := usecase.GetHlsSegment(ctx, videoID, segment, domain.Viewer{})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -592,7 +1174,7 @@ func TestGetHlsSegment(t *testing.T) {
 			return nil, errors.New("read error")
 		}
 
-		resp, err := usecase.GetHlsSegment(ctx, videoID, segment)
+		resp, err := usecase.GetHlsSegment(ctx, videoID, segment, domain.Viewer{})
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// StuckJobReconciler 定期找出停留在 upload / processing 超過 timeout 的影片
+//   - 上傳後發送轉碼工作前失敗：影片停在 upload
+//   - Worker 轉碼到一半當機：影片停在 processing，Worker 轉碼期間每 TranscodeHeartbeatInterval 更新 updated_at，停止更新才會逾時
+//
+// 原始檔仍在 MinIO 時重新發布轉碼工作，原始檔不存在或重試次數用盡時標記為 failed
+// 狀態以條件更新寫入，檢查期間 Worker 更新了 updated_at 或完成轉碼時略過，不會覆寫 Worker 的結果
+type StuckJobReconciler struct {
+	videoRepo   repository.VideoRepo
+	minioClient database.MinIOClientRepo
+	lock        repository.LeaderLock
+	owner       string // leader lock 的持有者，每個 replica 不同
+	interval    time.Duration
+	timeout     time.Duration
+	statusURLs  *domain.PlaybackURLBuilder // 標記為 failed 時事件的封面網址
+}
+
+// NewStuckJobReconciler 建構 StuckJobReconciler 實例
+func NewStuckJobReconciler(videoRepo repository.VideoRepo, minioClient database.MinIOClientRepo,
+	lock repository.LeaderLock, interval, timeout time.Duration, playbackURLs *domain.PlaybackURLBuilder) *StuckJobReconciler {
+	return &StuckJobReconciler{
+		videoRepo:   videoRepo,
+		minioClient: minioClient,
+		lock:        lock,
+		owner:       uuid.NewString(),
+		interval:    interval,
+		timeout:     timeout,
+		statusURLs:  playbackURLs,
+	}
+}
+
+// Start 定期檢查卡住的影片，直到 ctx 結束
+// 以 leader lock 確保同一時間只有一個 replica 執行，避免重複發布轉碼工作
+func (r *StuckJobReconciler) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("StuckJobReconciler 已啟動，間隔 %s，逾時 %s", r.interval, r.timeout))
+	for {
+		select {
+		case now := <-ticker.C:
+			r.run(ctx, now)
+		case <-ctx.Done():
+			logger.Log.Info("StuckJobReconciler 收到停止訊號")
+			if err := r.lock.Release(context.Background(), domain.StuckJobLockKey, r.owner); err != nil {
+				logger.Log.Errorf("StuckJobReconciler 釋放 leader lock 失敗:", err)
+			}
+			return
+		}
+	}
+}
+
+func (r *StuckJobReconciler) run(ctx context.Context, now time.Time) {
+	// lock 的 TTL 為兩個間隔，leader 每次執行時續約，當機後最多兩個間隔由其他 replica 接手
+	leader, err := r.lock.Acquire(ctx, domain.StuckJobLockKey, r.owner, 2*r.interval)
+	if err != nil {
+		logger.Log.Errorf("StuckJobReconciler 取得 leader lock 失敗:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	result, err := r.Reconcile(ctx, now)
+	if err != nil {
+		logger.Log.Errorf("StuckJobReconciler 檢查卡住的影片失敗:", err)
+	}
+	if result.Republished > 0 || result.Failed > 0 {
+		logger.Log.Info(fmt.Sprintf("StuckJobReconciler 重新發布 %d 部、標記失敗 %d 部影片", result.Republished, result.Failed))
+	}
+}
+
+// Reconcile 處理所有卡住的影片，單部影片處理失敗時記錄錯誤並繼續下一部
+func (r *StuckJobReconciler) Reconcile(ctx context.Context, now time.Time) (domain.ReconcileResult, error) {
+	var result domain.ReconcileResult
+	for _, status := range []domain.VideoStatus{domain.VideoUpload, domain.VideoProcessing} {
+		videos, err := r.videoRepo.FindByStatus(string(status))
+		if err != nil {
+			return result, fmt.Errorf("取得 %s 狀態的影片失敗: %w", status, err)
+		}
+		for index := range videos {
+			video := &videos[index]
+			if !video.IsStuck(now, r.timeout) {
+				continue
+			}
+			if err := r.reconcileVideo(ctx, video, now.Add(-r.timeout), &result); err != nil {
+				logger.Log.Errorf(fmt.Sprintf("videoID[%d] 處理卡住的影片失敗:", video.ID), err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// reconcileVideo 原始檔存在且未超過重試次數時重新發布轉碼工作，否則標記為 failed，結果累加到 result
+// 剪輯的片段以來源影片的 m3u8 作為原始檔
+func (r *StuckJobReconciler) reconcileVideo(ctx context.Context, video *domain.Video, stuckBefore time.Time, result *domain.ReconcileResult) error {
+	exists, err := r.minioClient.ObjectExists(ctx, video.FileName)
+	if err != nil {
+		return fmt.Errorf("確認原始檔 %s 失敗: %w", video.FileName, err)
+	}
+	status := video.Status
+	if !exists || video.TranscodeAttempts >= domain.MaxTranscodeAttempts {
+		video.Status = string(domain.VideoFailed)
+		saved, err := r.videoRepo.SaveStuckWithEvents(video, status, stuckBefore, videoStatusEvents(r.statusURLs))
+		if err != nil {
+			return fmt.Errorf("更新影片狀態失敗: %w", err)
+		}
+		if !saved {
+			result.Skipped++
+			return nil
+		}
+		logger.Log.Info(fmt.Sprintf("videoID[%d] 原始檔存在: %t，已重試 %d 次，標記為 failed", video.ID, exists, video.TranscodeAttempts))
+		result.Failed++
+		return nil
+	}
+
+	// 狀態、updated_at 與轉碼工作事件在同一個交易內寫入 outbox
+	video.Status = string(domain.VideoUpload)
+	video.TranscodeAttempts++
+	saved, err := r.videoRepo.SaveStuckWithEvents(video, status, stuckBefore, domain.TranscodingJobEvents)
+	if err != nil {
+		return fmt.Errorf("重新發布轉碼工作失敗: %w", err)
+	}
+	if !saved {
+		result.Skipped++
+		return nil
+	}
+	result.Republished++
+	return nil
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLeaderLock 是 LeaderLock 的 Mock
+type MockLeaderLock struct {
+	mock.Mock
+}
+
+// Acquire 模擬取得 leader lock
+func (m *MockLeaderLock) Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	args := m.Called(ctx, key, owner, ttl)
+	return args.Bool(0), args.Error(1)
+}
+
+// Release 模擬釋放 leader lock
+func (m *MockLeaderLock) Release(ctx context.Context, key, owner string) error {
+	args := m.Called(ctx, key, owner)
+	return args.Error(0)
+}
+
+func TestStuckJobReconciler(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	timeout := 30 * time.Minute
+	sourceID := uint(1)
+
+	// **情境 1: 卡住的影片原始檔存在時重新發布，剪輯片段附上剪輯範圍**
+	t.Run("重新發布", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		reconciler := NewStuckJobReconciler(mockRepo, mockMinIO, new(MockLeaderLock), time.Minute, timeout, nil)
+
+		mockRepo.On("FindByStatus", string(domain.VideoUpload)).Return([]domain.Video{
+			{ID: 2, Status: string(domain.VideoUpload), FileName: "original/2/a.mp4", UpdatedAt: now.Add(-time.Hour)},
+			{ID: 3, Status: string(domain.VideoUpload), FileName: "original/3/b.mp4", UpdatedAt: now.Add(-time.Minute)},
+		}, nil).Once()
+		mockRepo.On("FindByStatus", string(domain.VideoProcessing)).Return([]domain.Video{
+			{ID: 4, Status: string(domain.VideoProcessing), FileName: "processed/1/index.m3u8", UpdatedAt: now.Add(-time.Hour),
+				SourceVideoID: &sourceID, ClipStartMs: 1000, ClipEndMs: 20000, TranscodeAttempts: 1},
+		}, nil).Once()
+		mockMinIO.On("ObjectExists", ctx, "original/2/a.mp4").Return(true, nil).Once()
+		mockMinIO.On("ObjectExists", ctx, "processed/1/index.m3u8").Return(true, nil).Once()
+		var jobs []domain.TranscodingJob
+		mockRepo.On("SaveStuckWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == string(domain.VideoUpload) && v.ID == 2 && v.TranscodeAttempts == 1
+		}), string(domain.VideoUpload)).Run(func(args mock.Arguments) {
+			jobs = append(jobs, domain.TranscodingJobFor(args.Get(0).(*domain.Video)))
+		}).Return(true, nil).Once()
+		mockRepo.On("SaveStuckWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == string(domain.VideoUpload) && v.ID == 4 && v.TranscodeAttempts == 2
+		}), string(domain.VideoProcessing)).Run(func(args mock.Arguments) {
+			jobs = append(jobs, domain.TranscodingJobFor(args.Get(0).(*domain.Video)))
+		}).Return(true, nil).Once()
+
+		result, err := reconciler.Reconcile(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReconcileResult{Republished: 2}, result)
+		assert.Equal(t, []domain.TranscodingJob{
+			{VideoID: 2, FileName: "original/2/a.mp4"},
+			{VideoID: 4, FileName: "processed/1/index.m3u8", Clip: &domain.ClipJob{SourceVideoID: 1, StartMs: 1000, EndMs: 20000}},
+		}, jobs)
+		mockMinIO.AssertNotCalled(t, "ObjectExists", ctx, "original/3/b.mp4")
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 原始檔不存在或重試次數用盡時標記為 failed**
+	t.Run("標記失敗", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		reconciler := NewStuckJobReconciler(mockRepo, mockMinIO, new(MockLeaderLock), time.Minute, timeout, nil)
+
+		mockRepo.On("FindByStatus", string(domain.VideoUpload)).Return([]domain.Video{
+			{ID: 5, Status: string(domain.VideoUpload), FileName: "c.mp4", UpdatedAt: now.Add(-time.Hour)},
+		}, nil).Once()
+		mockRepo.On("FindByStatus", string(domain.VideoProcessing)).Return([]domain.Video{
+			{ID: 6, Status: string(domain.VideoProcessing), FileName: "original/6/d.mp4", UpdatedAt: now.Add(-time.Hour),
+				TranscodeAttempts: domain.MaxTranscodeAttempts},
+		}, nil).Once()
+		mockMinIO.On("ObjectExists", ctx, "c.mp4").Return(false, nil).Once()
+		mockMinIO.On("ObjectExists", ctx, "original/6/d.mp4").Return(true, nil).Once()
+		var events []domain.OutboxEvent
+		mockRepo.On("SaveStuckWithEvents", mock.MatchedBy(func(v *domain.Video) bool {
+			return v.Status == string(domain.VideoFailed)
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			outbox, err := videoStatusEvents(nil)(args.Get(0).(*domain.Video))
+			assert.NoError(t, err)
+			events = append(events, outbox...)
+		}).Return(true, nil).Twice()
+
+		result, err := reconciler.Reconcile(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReconcileResult{Failed: 2}, result)
+		// 標記為 failed 時通知上傳者
+		assert.Len(t, events, 2)
+		for _, event := range events {
+			assert.Equal(t, domain.EventVideoFailed, event.EventType)
+			assert.Equal(t, domain.VideoStatusTopic, event.Topic)
+		}
+	})
+
+	// **情境 3: 檢查期間 Worker 更新了心跳或完成轉碼時略過，不重新發布**
+	t.Run("已由 Worker 認領", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		reconciler := NewStuckJobReconciler(mockRepo, mockMinIO, new(MockLeaderLock), time.Minute, timeout, nil)
+
+		mockRepo.On("FindByStatus", string(domain.VideoUpload)).Return([]domain.Video{}, nil).Once()
+		mockRepo.On("FindByStatus", string(domain.VideoProcessing)).Return([]domain.Video{
+			{ID: 7, Status: string(domain.VideoProcessing), FileName: "original/7/e.mp4", UpdatedAt: now.Add(-time.Hour)},
+		}, nil).Once()
+		mockMinIO.On("ObjectExists", ctx, "original/7/e.mp4").Return(true, nil).Once()
+		mockRepo.On("SaveStuckWithEvents", mock.Anything, string(domain.VideoProcessing)).Return(false, nil).Once()
+
+		result, err := reconciler.Reconcile(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReconcileResult{Skipped: 1}, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 非 leader 不執行**
+	t.Run("非 leader", func(t *testing.T) {
+		mockRepo := new(MockVideoRepo)
+		mockLock := new(MockLeaderLock)
+		reconciler := NewStuckJobReconciler(mockRepo, new(MockMinIOClient), mockLock, time.Minute, timeout, nil)
+
+		mockLock.On("Acquire", ctx, domain.StuckJobLockKey, mock.Anything, 2*time.Minute).Return(false, nil).Once()
+
+		reconciler.run(ctx, now)
+
+		mockRepo.AssertNotCalled(t, "FindByStatus", mock.Anything)
+	})
+}
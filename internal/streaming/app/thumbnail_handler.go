@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// ListThumbnails 實作 列出影片縮圖
+func (s *StreamingGRPCServer) ListThumbnails(ctx context.Context, req *streaming_pb.ListThumbnailsReq) (*streaming_pb.ListThumbnailsRes, error) {
+	items, err := s.ThumbnailUsecase.ListThumbnails(ctx, req.VideoId, req.MemberId)
+	if err != nil {
+		return &streaming_pb.ListThumbnailsRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	thumbnails := make([]*streaming_pb.Thumbnail, len(items))
+	for index := range items {
+		thumbnails[index] = toThumbnailPb(&items[index])
+	}
+	return &streaming_pb.ListThumbnailsRes{
+		Success:    true,
+		Thumbnails: thumbnails,
+	}, nil
+}
+
+// UploadThumbnail 實作 上傳自訂縮圖
+func (s *StreamingGRPCServer) UploadThumbnail(ctx context.Context, req *streaming_pb.UploadThumbnailReq) (*streaming_pb.ThumbnailRes, error) {
+	item, err := s.ThumbnailUsecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{
+		VideoID:  req.VideoId,
+		MemberID: req.MemberId,
+		Image:    req.Image,
+	})
+	return toThumbnailRes(item, err), nil
+}
+
+// SetActiveThumbnail 實作 選擇使用中的縮圖
+func (s *StreamingGRPCServer) SetActiveThumbnail(ctx context.Context, req *streaming_pb.SetActiveThumbnailReq) (*streaming_pb.ThumbnailRes, error) {
+	item, err := s.ThumbnailUsecase.SetActiveThumbnail(ctx, domain.SetActiveThumbnailReq{
+		VideoID:     req.VideoId,
+		MemberID:    req.MemberId,
+		ThumbnailID: uint(req.ThumbnailId),
+	})
+	return toThumbnailRes(item, err), nil
+}
+
+func toThumbnailRes(item *domain.ThumbnailItem, err error) *streaming_pb.ThumbnailRes {
+	if err != nil {
+		return &streaming_pb.ThumbnailRes{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	return &streaming_pb.ThumbnailRes{
+		Success:   true,
+		Thumbnail: toThumbnailPb(item),
+	}
+}
+
+func toThumbnailPb(item *domain.ThumbnailItem) *streaming_pb.Thumbnail {
+	images := make([]*streaming_pb.ThumbnailImage, len(domain.ThumbnailSizes))
+	for index, size := range domain.ThumbnailSizes {
+		images[index] = &streaming_pb.ThumbnailImage{
+			Size:   string(size.Size),
+			Width:  int32(size.Width),
+			Height: int32(size.Height),
+			Url:    item.URLs[index],
+		}
+	}
+	return &streaming_pb.Thumbnail{
+		Id:        uint64(item.ID),
+		Source:    item.Source,
+		Score:     item.Score,
+		TimeMs:    item.TimeMs,
+		Active:    item.Active,
+		Images:    images,
+		CreatedAt: item.CreatedAt.Unix(),
+	}
+}
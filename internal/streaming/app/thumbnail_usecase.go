@@ -0,0 +1,166 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// ThumbnailUseCase 影片縮圖：轉碼時挑選的候選縮圖、上傳者自訂縮圖與選擇使用中的縮圖
+type ThumbnailUseCase interface {
+	ListThumbnails(ctx context.Context, videoID, memberID string) ([]domain.ThumbnailItem, error)
+	UploadThumbnail(ctx context.Context, req domain.UploadThumbnailReq) (*domain.ThumbnailItem, error)
+	SetActiveThumbnail(ctx context.Context, req domain.SetActiveThumbnailReq) (*domain.ThumbnailItem, error)
+}
+
+type thumbnailUseCase struct {
+	MinioClient   database.MinIOClientRepo
+	ThumbnailRepo repository.ThumbnailRepo
+	VideoRepo     repository.VideoRepo
+	PlaybackURLs  *domain.PlaybackURLBuilder
+}
+
+// NewThumbnailUseCase 建立 ThumbnailUseCase
+func NewThumbnailUseCase(minIO database.MinIOClientRepo, thumbnailRepo repository.ThumbnailRepo, videoRepo repository.VideoRepo,
+	playbackURLs *domain.PlaybackURLBuilder) ThumbnailUseCase {
+	return &thumbnailUseCase{
+		MinioClient:   minIO,
+		ThumbnailRepo: thumbnailRepo,
+		VideoRepo:     videoRepo,
+		PlaybackURLs:  playbackURLs,
+	}
+}
+
+// 讓 test 替換實際的縮放（需要 FFmpeg）
+var resizeThumbnail = ResizeThumbnail
+
+// ListThumbnails 列出影片的候選與自訂縮圖，僅上傳者可查看
+func (t *thumbnailUseCase) ListThumbnails(ctx context.Context, videoID, memberID string) ([]domain.ThumbnailItem, error) {
+	video, err := t.getOwnedVideo(videoID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	thumbnails, err := t.ThumbnailRepo.ListByVideo(video.ID)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得縮圖失敗: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	items := make([]domain.ThumbnailItem, len(thumbnails))
+	for index := range thumbnails {
+		items[index] = t.toItem(video, thumbnails[index])
+	}
+	return items, nil
+}
+
+// UploadThumbnail 上傳自訂縮圖，驗證後縮放成各尺寸存到 MinIO；上傳後需另外選擇才會使用
+func (t *thumbnailUseCase) UploadThumbnail(ctx context.Context, req domain.UploadThumbnailReq) (*domain.ThumbnailItem, error) {
+	video, err := t.getOwnedVideo(req.VideoID, req.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateThumbnailImage(req.Image); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	count, err := t.ThumbnailRepo.CountBySource(video.ID, domain.ThumbnailCustom)
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 取得縮圖失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if count >= domain.MaxCustomThumbnails {
+		errMsg := fmt.Sprintf("videoID[%s] 自訂縮圖最多 %d 張", req.VideoID, domain.MaxCustomThumbnails)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	thumbnail := domain.VideoThumbnail{
+		VideoID: video.ID,
+		Name:    fmt.Sprintf("%s%d", domain.ThumbnailCustom, time.Now().UnixNano()),
+		Source:  string(domain.ThumbnailCustom),
+	}
+	if err := t.uploadSizes(ctx, video, thumbnail.Name, req.Image); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 上傳縮圖失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := t.ThumbnailRepo.Create(&thumbnail); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 保存縮圖失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	item := t.toItem(video, thumbnail)
+	return &item, nil
+}
+
+// SetActiveThumbnail 選擇影片使用中的縮圖，之後的封面網址改用此縮圖
+func (t *thumbnailUseCase) SetActiveThumbnail(ctx context.Context, req domain.SetActiveThumbnailReq) (*domain.ThumbnailItem, error) {
+	video, err := t.getOwnedVideo(req.VideoID, req.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := t.ThumbnailRepo.Get(req.ThumbnailID)
+	if err != nil || thumbnail.VideoID != video.ID {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到縮圖 %d", req.VideoID, req.ThumbnailID)
+		return nil, errprocess.Set(errMsg)
+	}
+	if err := t.ThumbnailRepo.SetActive(video.ID, thumbnail.Name); err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 設定縮圖失敗: %v", req.VideoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	video.ThumbnailName = thumbnail.Name
+	item := t.toItem(video, *thumbnail)
+	return &item, nil
+}
+
+// uploadSizes 將圖片寫入暫存目錄、縮放成 ThumbnailSizes 的每個尺寸後上傳到 processed/{videoID}/
+func (t *thumbnailUseCase) uploadSizes(ctx context.Context, video *domain.Video, name string, image []byte) error {
+	dir, err := os.MkdirTemp("", "thumbnail-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "source")
+	if err := os.WriteFile(source, image, 0644); err != nil {
+		return err
+	}
+	if err := resizeThumbnail(source, dir, name); err != nil {
+		return err
+	}
+	for _, size := range domain.ThumbnailSizes {
+		file := domain.ThumbnailFileName(name, size.Size)
+		if err := t.MinioClient.UploadFile(ctx, video.ProcessedKey(file), filepath.Join(dir, file), "image/jpeg"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOwnedVideo 取得影片並確認 memberID 為上傳者
+func (t *thumbnailUseCase) getOwnedVideo(videoID, memberID string) (*domain.Video, error) {
+	id, _ := strconv.Atoi(videoID)
+
+	video, err := t.VideoRepo.GetByID(uint(id))
+	if err != nil {
+		errMsg := fmt.Sprintf("videoID[%s] 找不到影片: %v", videoID, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	if !video.IsOwner(memberID) {
+		errMsg := fmt.Sprintf("videoID[%s] memberID[%s] 非影片擁有者", videoID, memberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return video, nil
+}
+
+func (t *thumbnailUseCase) toItem(video *domain.Video, thumbnail domain.VideoThumbnail) domain.ThumbnailItem {
+	return domain.ThumbnailItem{
+		VideoThumbnail: thumbnail,
+		URLs:           t.PlaybackURLs.ThumbnailURLs(video, thumbnail.Name),
+		Active:         video.ThumbnailName == thumbnail.Name,
+	}
+}
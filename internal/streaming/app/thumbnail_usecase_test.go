@@ -0,0 +1,302 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockThumbnailRepo 是 ThumbnailRepo 的 Mock
+type MockThumbnailRepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockThumbnailRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// ReplaceAuto 模擬取代自動縮圖
+func (m *MockThumbnailRepo) ReplaceAuto(videoID uint, thumbnails []domain.VideoThumbnail) error {
+	args := m.Called(videoID, thumbnails)
+	return args.Error(0)
+}
+
+// Create 模擬新增縮圖
+func (m *MockThumbnailRepo) Create(thumbnail *domain.VideoThumbnail) error {
+	args := m.Called(thumbnail)
+	return args.Error(0)
+}
+
+// Get 模擬取得縮圖
+func (m *MockThumbnailRepo) Get(id uint) (*domain.VideoThumbnail, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VideoThumbnail), args.Error(1)
+}
+
+// ListByVideo 模擬列出影片的縮圖
+func (m *MockThumbnailRepo) ListByVideo(videoID uint) ([]domain.VideoThumbnail, error) {
+	args := m.Called(videoID)
+	return args.Get(0).([]domain.VideoThumbnail), args.Error(1)
+}
+
+// CountBySource 模擬計算縮圖數量
+func (m *MockThumbnailRepo) CountBySource(videoID uint, source domain.ThumbnailSource) (int64, error) {
+	args := m.Called(videoID, source)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// SetActive 模擬設定使用中的縮圖
+func (m *MockThumbnailRepo) SetActive(videoID uint, name string) error {
+	args := m.Called(videoID, name)
+	return args.Error(0)
+}
+
+// thumbnailImage 產生指定尺寸的 PNG
+func thumbnailImage(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestThumbnailFrames(t *testing.T) {
+	logger.SetNewNop()
+
+	// **情境 1: 解析 metadata=print 輸出**
+	t.Run("解析畫面量測值", func(t *testing.T) {
+		output := "[Parsed_metadata_2 @ 0x1] frame:0    pts:0       pts_time:0\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YLOW=16\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YAVG=18.5\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YHIGH=20\n" +
+			"frame=    1 fps=0.0 q=2.0 size=N/A\n" +
+			"[Parsed_metadata_2 @ 0x1] frame:1    pts:12800   pts_time:12.5\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.scene_score=0.62\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YLOW=30\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YAVG=110\n" +
+			"[Parsed_metadata_2 @ 0x1] lavfi.signalstats.YHIGH=220\n"
+		assert.Equal(t, []domain.FrameCandidate{
+			{Index: 0, Time: 0, Brightness: 18.5, Contrast: 4},
+			{Index: 1, Time: 12500 * time.Millisecond, SceneScore: 0.62, Brightness: 110, Contrast: 190},
+		}, domain.ParseFrameMetadata(output))
+		assert.Empty(t, domain.ParseFrameMetadata("no frames"))
+	})
+
+	// **情境 2: 接近全黑或全白的畫面分數為 0，亮度適中且對比大的畫面較高分**
+	t.Run("畫面分數", func(t *testing.T) {
+		assert.Zero(t, domain.FrameCandidate{Brightness: 10, Contrast: 200}.Score())
+		assert.Zero(t, domain.FrameCandidate{Brightness: 250, Contrast: 200}.Score())
+		sharp := domain.FrameCandidate{Brightness: 120, Contrast: 200, SceneScore: 0.5}
+		flat := domain.FrameCandidate{Brightness: 120, Contrast: 20, SceneScore: 0.5}
+		dim := domain.FrameCandidate{Brightness: 50, Contrast: 200, SceneScore: 0.5}
+		assert.Greater(t, sharp.Score(), flat.Score())
+		assert.Greater(t, sharp.Score(), dim.Score())
+	})
+
+	// **情境 3: 依分數挑選，略過時間太接近與分數為 0 的畫面**
+	t.Run("挑選候選縮圖", func(t *testing.T) {
+		frames := []domain.FrameCandidate{
+			{Index: 0, Time: 0, Brightness: 10},
+			{Index: 1, Time: 10 * time.Second, Brightness: 120, Contrast: 200},
+			{Index: 2, Time: 11 * time.Second, Brightness: 120, Contrast: 180},
+			{Index: 3, Time: 20 * time.Second, Brightness: 100, Contrast: 150},
+		}
+		picked := domain.PickThumbnailFrames(frames, domain.ThumbnailCandidateCount)
+		assert.Len(t, picked, 2)
+		assert.Equal(t, 1, picked[0].Index)
+		assert.Equal(t, 3, picked[1].Index)
+	})
+
+	// **情境 4: 候選畫面的間隔依影片長度放寬，長片也涵蓋整部影片**
+	t.Run("畫面間隔", func(t *testing.T) {
+		assert.Zero(t, domain.ThumbnailFrameGap(0))
+		assert.Equal(t, 10*time.Second, domain.ThumbnailFrameGap(10*time.Minute))
+		assert.Equal(t, 2*time.Minute, domain.ThumbnailFrameGap(2*time.Hour))
+	})
+
+	// **情境 5: 自訂縮圖需為解析度在範圍內的 PNG 或 JPEG**
+	t.Run("驗證自訂縮圖", func(t *testing.T) {
+		assert.NoError(t, domain.ValidateThumbnailImage(thumbnailImage(t, 640, 360)))
+		assert.EqualError(t, domain.ValidateThumbnailImage(thumbnailImage(t, 320, 180)), "縮圖解析度需至少 640x360: 320x180")
+		assert.NoError(t, domain.ValidateThumbnailImage(thumbnailImage(t, 3840, 2160)))
+		assert.EqualError(t, domain.ValidateThumbnailImage(thumbnailImage(t, 3841, 2160)), "縮圖解析度不可超過 3840x2160: 3841x2160")
+		assert.Error(t, domain.ValidateThumbnailImage([]byte("not an image")))
+		assert.EqualError(t, domain.ValidateThumbnailImage(make([]byte, domain.MaxThumbnailSize+1)), "縮圖圖片不可超過 2097152 bytes")
+	})
+
+	// **情境 6: 使用中的縮圖作為封面，舊影片使用 poster.jpg**
+	t.Run("封面檔名", func(t *testing.T) {
+		assert.Equal(t, domain.PosterFileName, (&domain.Video{}).PosterFile())
+		assert.Equal(t, "thumb_auto1_large.jpg", (&domain.Video{ThumbnailName: "auto1"}).PosterFile())
+		assert.True(t, domain.IsAutoThumbnail(""))
+		assert.True(t, domain.IsAutoThumbnail("auto2"))
+		assert.False(t, domain.IsAutoThumbnail("custom1"))
+	})
+}
+
+func TestUploadThumbnail(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	originalResize := resizeThumbnail
+	defer func() { resizeThumbnail = originalResize }()
+	resizeThumbnail = func(inputPath, outputDir, name string) error { return nil }
+
+	// **情境 1: 上傳者上傳自訂縮圖，縮放成各尺寸後上傳**
+	t.Run("上傳成功", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		mockMinIO := new(MockMinIOClient)
+		usecase := NewThumbnailUseCase(mockMinIO, mockRepo, mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner", ThumbnailName: "auto1"}, nil).Once()
+		mockRepo.On("CountBySource", uint(1), domain.ThumbnailCustom).Return(int64(0), nil).Once()
+		for _, size := range []string{"large", "medium", "small"} {
+			mockMinIO.On("UploadFile", ctx, mock.MatchedBy(func(key string) bool {
+				return strings.HasPrefix(key, "processed/1/thumb_custom") && strings.HasSuffix(key, "_"+size+".jpg")
+			}), mock.Anything, "image/jpeg").Return(nil).Once()
+		}
+		mockRepo.On("Create", mock.MatchedBy(func(thumbnail *domain.VideoThumbnail) bool {
+			return thumbnail.VideoID == 1 && thumbnail.Source == string(domain.ThumbnailCustom)
+		})).Return(nil).Once()
+
+		item, err := usecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{VideoID: "1", MemberID: "owner", Image: thumbnailImage(t, 1280, 720)})
+
+		assert.NoError(t, err)
+		assert.False(t, item.Active)
+		assert.Len(t, item.URLs, len(domain.ThumbnailSizes))
+		assert.Contains(t, item.URLs[0], "/video/hls/1/thumb_custom")
+		mockRepo.AssertExpectations(t)
+		mockMinIO.AssertExpectations(t)
+	})
+
+	// **情境 2: 非上傳者無法上傳**
+	t.Run("非上傳者", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+
+		_, err := usecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{VideoID: "1", MemberID: "other", Image: thumbnailImage(t, 1280, 720)})
+
+		assert.EqualError(t, err, "videoID[1] memberID[other] 非影片擁有者")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	// **情境 3: 圖片解析度不足**
+	t.Run("解析度不足", func(t *testing.T) {
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), new(MockThumbnailRepo), mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+
+		_, err := usecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{VideoID: "1", MemberID: "owner", Image: thumbnailImage(t, 100, 100)})
+
+		assert.EqualError(t, err, "videoID[1] 縮圖解析度需至少 640x360: 100x100")
+	})
+
+	// **情境 4: 自訂縮圖數量已達上限**
+	t.Run("數量上限", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("CountBySource", uint(1), domain.ThumbnailCustom).Return(int64(domain.MaxCustomThumbnails), nil).Once()
+
+		_, err := usecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{VideoID: "1", MemberID: "owner", Image: thumbnailImage(t, 1280, 720)})
+
+		assert.EqualError(t, err, "videoID[1] 自訂縮圖最多 5 張")
+	})
+
+	// **情境 5: 縮放失敗**
+	t.Run("縮放失敗", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+		resizeThumbnail = func(inputPath, outputDir, name string) error { return errors.New("ffmpeg error") }
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("CountBySource", uint(1), domain.ThumbnailCustom).Return(int64(0), nil).Once()
+
+		_, err := usecase.UploadThumbnail(ctx, domain.UploadThumbnailReq{VideoID: "1", MemberID: "owner", Image: thumbnailImage(t, 1280, 720)})
+
+		assert.EqualError(t, err, "videoID[1] 上傳縮圖失敗: ffmpeg error")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestSetActiveThumbnail(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 選擇影片的縮圖**
+	t.Run("選擇縮圖", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner", ThumbnailName: "auto1"}, nil).Once()
+		mockRepo.On("Get", uint(3)).Return(&domain.VideoThumbnail{ID: 3, VideoID: 1, Name: "custom1", Source: "custom"}, nil).Once()
+		mockRepo.On("SetActive", uint(1), "custom1").Return(nil).Once()
+
+		item, err := usecase.SetActiveThumbnail(ctx, domain.SetActiveThumbnailReq{VideoID: "1", MemberID: "owner", ThumbnailID: 3})
+
+		assert.NoError(t, err)
+		assert.True(t, item.Active)
+		assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/1/thumb_custom1_small.jpg", item.URLs[2])
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 縮圖不屬於此影片**
+	t.Run("其他影片的縮圖", func(t *testing.T) {
+		mockRepo := new(MockThumbnailRepo)
+		mockVideoRepo := new(MockVideoRepo)
+		usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+
+		mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner"}, nil).Once()
+		mockRepo.On("Get", uint(3)).Return(&domain.VideoThumbnail{ID: 3, VideoID: 2, Name: "auto1"}, nil).Once()
+
+		_, err := usecase.SetActiveThumbnail(ctx, domain.SetActiveThumbnailReq{VideoID: "1", MemberID: "owner", ThumbnailID: 3})
+
+		assert.EqualError(t, err, "videoID[1] 找不到縮圖 3")
+		mockRepo.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListThumbnails(t *testing.T) {
+	logger.SetNewNop()
+	mockRepo := new(MockThumbnailRepo)
+	mockVideoRepo := new(MockVideoRepo)
+	usecase := NewThumbnailUseCase(new(MockMinIOClient), mockRepo, mockVideoRepo, nil)
+
+	// **情境 1: 列出縮圖並標示使用中的縮圖**
+	mockVideoRepo.On("GetByID", uint(1)).Return(&domain.Video{ID: 1, MemberID: "owner", ThumbnailName: "auto2"}, nil).Once()
+	mockRepo.On("ListByVideo", uint(1)).Return([]domain.VideoThumbnail{
+		{ID: 1, VideoID: 1, Name: "auto1", Source: "auto", Score: 0.8},
+		{ID: 2, VideoID: 1, Name: "auto2", Source: "auto", Score: 0.6},
+	}, nil).Once()
+
+	items, err := usecase.ListThumbnails(context.Background(), "1", "owner")
+
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.False(t, items[0].Active)
+	assert.True(t, items[1].Active)
+	assert.Equal(t, "http://127.0.0.1:8080/streaming/video/hls/1/thumb_auto1_large.jpg", items[0].URLs[0])
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// GetTrending 實作 取得熱門排行榜
+func (s *StreamingGRPCServer) GetTrending(ctx context.Context, req *streaming_pb.GetTrendingReq) (*streaming_pb.GetTrendingRes, error) {
+	videos, total, err := s.TrendingUsecase.GetTrending(ctx, uint(req.CategoryId), req.Type, domain.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return &streaming_pb.GetTrendingRes{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &streaming_pb.GetTrendingRes{
+		Success: true,
+		Video:   toSearchFeedBack(videos),
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// TrendingJob 定期以最近的瀏覽、按讚、留言重建熱門排行榜
+type TrendingJob struct {
+	videoRepo    repository.VideoRepo
+	reactionRepo repository.ReactionRepo
+	commentRepo  repository.CommentRepo
+	cache        repository.TrendingCache
+	lock         repository.LeaderLock
+	owner        string // leader lock 的持有者，每個 replica 不同
+	interval     time.Duration
+}
+
+// NewTrendingJob 建構 TrendingJob 實例
+func NewTrendingJob(videoRepo repository.VideoRepo, reactionRepo repository.ReactionRepo, commentRepo repository.CommentRepo,
+	cache repository.TrendingCache, lock repository.LeaderLock, interval time.Duration) *TrendingJob {
+	return &TrendingJob{
+		videoRepo:    videoRepo,
+		reactionRepo: reactionRepo,
+		commentRepo:  commentRepo,
+		cache:        cache,
+		lock:         lock,
+		owner:        uuid.NewString(),
+		interval:     interval,
+	}
+}
+
+// Start 啟動時先重建一次，之後定期重建，直到 ctx 結束
+// 以 leader lock 確保同一時間只有一個 replica 重建，避免每個 replica 都掃描統計資料表
+func (j *TrendingJob) Start(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	logger.Log.Info(fmt.Sprintf("TrendingJob 已啟動，間隔 %s", j.interval))
+	j.run(ctx, time.Now())
+	for {
+		select {
+		case now := <-ticker.C:
+			j.run(ctx, now)
+		case <-ctx.Done():
+			logger.Log.Info("TrendingJob 收到停止訊號")
+			if err := j.lock.Release(context.Background(), domain.TrendingLockKey, j.owner); err != nil {
+				logger.Log.Errorf("TrendingJob 釋放 leader lock 失敗:", err)
+			}
+			return
+		}
+	}
+}
+
+func (j *TrendingJob) run(ctx context.Context, now time.Time) {
+	// lock 的 TTL 為兩個間隔，與排行榜的過期時間相同，leader 當機後由其他 replica 接手並在排行榜過期前重建
+	leader, err := j.lock.Acquire(ctx, domain.TrendingLockKey, j.owner, 2*j.interval)
+	if err != nil {
+		logger.Log.Errorf("TrendingJob 取得 leader lock 失敗:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	count, err := j.Rebuild(ctx, now)
+	if err != nil {
+		logger.Log.Errorf("TrendingJob 重建熱門排行榜失敗:", err)
+		return
+	}
+	logger.Log.Info(fmt.Sprintf("TrendingJob 已重建熱門排行榜，共 %d 部影片", count))
+}
+
+// Rebuild 計算 TrendingWindow 內每部影片的時間衰減分數，依全站、類型、分類寫入排行榜，回傳上榜影片數
+func (j *TrendingJob) Rebuild(ctx context.Context, now time.Time) (int, error) {
+	since := now.Add(-domain.TrendingWindow)
+	views, err := j.videoRepo.HourlyViews(since)
+	if err != nil {
+		return 0, fmt.Errorf("取得瀏覽統計失敗: %w", err)
+	}
+	likes, err := j.reactionRepo.HourlyLikes(since)
+	if err != nil {
+		return 0, fmt.Errorf("取得按讚統計失敗: %w", err)
+	}
+	comments, err := j.commentRepo.HourlyCounts(ctx, since)
+	if err != nil {
+		return 0, fmt.Errorf("取得留言統計失敗: %w", err)
+	}
+
+	scores := domain.TrendingScores{}
+	scores.Add(views, domain.TrendingViewWeight, now)
+	scores.Add(likes, domain.TrendingLikeWeight, now)
+	scores.Add(comments, domain.TrendingCommentWeight, now)
+
+	ids := make([]uint, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	videos, err := j.videoRepo.GetByIDs(ids)
+	if err != nil {
+		return 0, fmt.Errorf("取得影片失敗: %w", err)
+	}
+
+	boards := trendingBoards(videos, scores)
+	if err := j.cache.Replace(ctx, boards, 2*j.interval); err != nil {
+		return 0, fmt.Errorf("寫入排行榜失敗: %w", err)
+	}
+	return len(boards[domain.TrendingKey(0, "")]), nil
+}
+
+// trendingBoards 將上榜影片分到全站、類型、分類、分類 + 類型的排行榜，每個排行榜依分數排序並保留前 MaxTrendingSize 名
+func trendingBoards(videos []domain.Video, scores domain.TrendingScores) map[string][]domain.TrendingEntry {
+	boards := map[string][]domain.TrendingEntry{}
+	for _, video := range videos {
+		score := scores[video.ID]
+		if !video.IsListed() || score <= 0 {
+			continue
+		}
+		entry := domain.TrendingEntry{VideoID: video.ID, Score: score}
+		keys := []string{domain.TrendingKey(0, ""), domain.TrendingKey(0, video.Type)}
+		if video.CategoryID != nil {
+			keys = append(keys, domain.TrendingKey(*video.CategoryID, ""), domain.TrendingKey(*video.CategoryID, video.Type))
+		}
+		for _, key := range keys {
+			boards[key] = append(boards[key], entry)
+		}
+	}
+
+	for key, entries := range boards {
+		sort.Slice(entries, func(i, k int) bool {
+			if entries[i].Score != entries[k].Score {
+				return entries[i].Score > entries[k].Score
+			}
+			return entries[i].VideoID > entries[k].VideoID
+		})
+		if len(entries) > domain.MaxTrendingSize {
+			boards[key] = entries[:domain.MaxTrendingSize]
+		}
+	}
+	return boards
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	errprocess "streaming_video_service/pkg/err"
+)
+
+// TrendingUseCase 熱門排行榜
+type TrendingUseCase interface {
+	GetTrending(ctx context.Context, categoryID uint, videoType string, page domain.Pagination) ([]domain.Video, int64, error)
+}
+
+type trendingUseCase struct {
+	TrendingCache repository.TrendingCache
+	VideoRepo     repository.VideoRepo
+}
+
+// NewTrendingUseCase 建立 TrendingUseCase
+func NewTrendingUseCase(trendingCache repository.TrendingCache, videoRepo repository.VideoRepo) TrendingUseCase {
+	return &trendingUseCase{
+		TrendingCache: trendingCache,
+		VideoRepo:     videoRepo,
+	}
+}
+
+// GetTrending 依熱門分數分頁列出影片，categoryID 為 0、videoType 為空值時不區分
+// 排行榜由 TrendingJob 定期重建，期間被改為非公開的影片不會列出
+func (t *trendingUseCase) GetTrending(ctx context.Context, categoryID uint, videoType string, page domain.Pagination) ([]domain.Video, int64, error) {
+	if videoType != "" && videoType != domain.VideoTypeShort && videoType != domain.VideoTypeLong {
+		errMsg := fmt.Sprintf("不支援的影片類型: %s", videoType)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+
+	page = page.Normalize()
+	key := domain.TrendingKey(categoryID, videoType)
+	ids, total, err := t.TrendingCache.Top(ctx, key, page.Offset(), page.PageSize)
+	if err != nil {
+		errMsg := fmt.Sprintf("key[%s] page[%d] 取得熱門排行榜失敗: %v", key, page.Page, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	if len(ids) == 0 {
+		return []domain.Video{}, total, nil
+	}
+
+	videos, err := t.VideoRepo.GetByIDs(ids)
+	if err != nil {
+		errMsg := fmt.Sprintf("key[%s] page[%d] 取得熱門影片失敗: %v", key, page.Page, err)
+		return nil, 0, errprocess.Set(errMsg)
+	}
+	byID := make(map[uint]domain.Video, len(videos))
+	for _, video := range videos {
+		byID[video.ID] = video
+	}
+	trending := make([]domain.Video, 0, len(ids))
+	for _, id := range ids {
+		if video, ok := byID[id]; ok && video.IsListed() {
+			trending = append(trending, video)
+		}
+	}
+	return trending, total, nil
+}
@@ -0,0 +1,180 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTrendingCache 熱門排行榜快取的 Mock
+type MockTrendingCache struct {
+	mock.Mock
+}
+
+func (m *MockTrendingCache) Replace(ctx context.Context, boards map[string][]domain.TrendingEntry, ttl time.Duration) error {
+	args := m.Called(ctx, boards, ttl)
+	return args.Error(0)
+}
+
+func (m *MockTrendingCache) Top(ctx context.Context, key string, offset, limit int) ([]uint, int64, error) {
+	args := m.Called(ctx, key, offset, limit)
+	return args.Get(0).([]uint), args.Get(1).(int64), args.Error(2)
+}
+
+func TestGetTrending(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+
+	// **情境 1: 依排行榜順序回傳，排除已不公開的影片**
+	t.Run("依排行榜順序回傳", func(t *testing.T) {
+		mockCache := new(MockTrendingCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewTrendingUseCase(mockCache, mockVideo)
+
+		mockCache.On("Top", ctx, "trending:category:3:type:short", 0, domain.DefaultPageSize).
+			Return([]uint{2, 1, 3}, int64(3), nil).Once()
+		mockVideo.On("GetByIDs", []uint{2, 1, 3}).Return([]domain.Video{
+			{ID: 1, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)},
+			{ID: 2, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)},
+			{ID: 3, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate)},
+		}, nil).Once()
+
+		videos, total, err := usecase.GetTrending(ctx, 3, domain.VideoTypeShort, domain.Pagination{Page: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Len(t, videos, 2)
+		assert.Equal(t, uint(2), videos[0].ID)
+		assert.Equal(t, uint(1), videos[1].ID)
+		mockCache.AssertExpectations(t)
+		mockVideo.AssertExpectations(t)
+	})
+
+	// **情境 2: 排行榜為空時不查詢影片**
+	t.Run("排行榜為空", func(t *testing.T) {
+		mockCache := new(MockTrendingCache)
+		mockVideo := new(MockVideoRepo)
+		usecase := NewTrendingUseCase(mockCache, mockVideo)
+
+		mockCache.On("Top", ctx, "trending:all", 20, 20).Return([]uint{}, int64(0), nil).Once()
+
+		videos, total, err := usecase.GetTrending(ctx, 0, "", domain.Pagination{Page: 2, PageSize: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, videos)
+		mockVideo.AssertNotCalled(t, "GetByIDs", mock.Anything)
+	})
+
+	// **情境 3: 不支援的影片類型**
+	t.Run("不支援的影片類型", func(t *testing.T) {
+		mockCache := new(MockTrendingCache)
+		usecase := NewTrendingUseCase(mockCache, new(MockVideoRepo))
+
+		_, _, err := usecase.GetTrending(ctx, 0, "movie", domain.Pagination{})
+
+		assert.EqualError(t, err, "不支援的影片類型: movie")
+		mockCache.AssertNotCalled(t, "Top", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	// **情境 4: Redis 異常**
+	t.Run("Redis 異常", func(t *testing.T) {
+		mockCache := new(MockTrendingCache)
+		usecase := NewTrendingUseCase(mockCache, new(MockVideoRepo))
+
+		mockCache.On("Top", ctx, "trending:type:long", 0, domain.DefaultPageSize).
+			Return([]uint(nil), int64(0), errors.New("redis down")).Once()
+
+		_, _, err := usecase.GetTrending(ctx, 0, domain.VideoTypeLong, domain.Pagination{})
+
+		assert.EqualError(t, err, "key[trending:type:long] page[1] 取得熱門排行榜失敗: redis down")
+	})
+}
+
+func TestTrendingJobRebuild(t *testing.T) {
+	logger.SetNewNop()
+	ctx := context.Background()
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-domain.TrendingWindow)
+	categoryID := uint(3)
+
+	// **情境 1: 依衰減後的分數建立全站、類型、分類排行榜**
+	t.Run("建立排行榜", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockReaction := new(MockReactionRepo)
+		mockComment := new(MockCommentRepo)
+		mockCache := new(MockTrendingCache)
+		job := NewTrendingJob(mockVideo, mockReaction, mockComment, mockCache, new(MockLeaderLock), time.Hour)
+
+		// 影片 1：12 小時前 100 次瀏覽（衰減為 50 分）；影片 2：剛剛 2 則留言（20 分）；影片 3：私人影片
+		mockVideo.On("HourlyViews", since).Return([]domain.HourlyActivity{
+			{VideoID: 1, Hour: now.Add(-domain.TrendingHalfLife), Count: 100},
+			{VideoID: 3, Hour: now, Count: 1000},
+		}, nil).Once()
+		mockReaction.On("HourlyLikes", since).Return([]domain.HourlyActivity{}, nil).Once()
+		mockComment.On("HourlyCounts", ctx, since).Return([]domain.HourlyActivity{
+			{VideoID: 2, Hour: now, Count: 2},
+		}, nil).Once()
+		mockVideo.On("GetByIDs", mock.MatchedBy(func(ids []uint) bool { return len(ids) == 3 })).Return([]domain.Video{
+			{ID: 1, Type: domain.VideoTypeLong, CategoryID: &categoryID, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)},
+			{ID: 2, Type: domain.VideoTypeShort, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPublic)},
+			{ID: 3, Type: domain.VideoTypeShort, Status: string(domain.VideoReady), Visibility: string(domain.VisibilityPrivate)},
+		}, nil).Once()
+
+		var boards map[string][]domain.TrendingEntry
+		mockCache.On("Replace", ctx, mock.Anything, 2*time.Hour).Run(func(args mock.Arguments) {
+			boards = args.Get(1).(map[string][]domain.TrendingEntry)
+		}).Return(nil).Once()
+
+		count, err := job.Rebuild(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Len(t, boards, 5)
+		all := boards["trending:all"]
+		assert.Equal(t, uint(1), all[0].VideoID)
+		assert.InDelta(t, 50, all[0].Score, 1e-9)
+		assert.Equal(t, uint(2), all[1].VideoID)
+		assert.InDelta(t, 20, all[1].Score, 1e-9)
+		assert.Len(t, boards["trending:type:short"], 1)
+		assert.Len(t, boards["trending:type:long"], 1)
+		assert.Len(t, boards["trending:category:3"], 1)
+		assert.Len(t, boards["trending:category:3:type:long"], 1)
+		mockCache.AssertExpectations(t)
+	})
+
+	// **情境 2: 取得統計失敗時不更新排行榜**
+	t.Run("取得統計失敗", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockCache := new(MockTrendingCache)
+		job := NewTrendingJob(mockVideo, new(MockReactionRepo), new(MockCommentRepo), mockCache, new(MockLeaderLock), time.Hour)
+
+		mockVideo.On("HourlyViews", since).Return([]domain.HourlyActivity(nil), errors.New("db error")).Once()
+
+		_, err := job.Rebuild(ctx, now)
+
+		assert.EqualError(t, err, "取得瀏覽統計失敗: db error")
+		mockCache.AssertNotCalled(t, "Replace", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	// **情境 3: 非 leader 的 replica 不重建**
+	t.Run("非 leader 不重建", func(t *testing.T) {
+		mockVideo := new(MockVideoRepo)
+		mockLock := new(MockLeaderLock)
+		job := NewTrendingJob(mockVideo, new(MockReactionRepo), new(MockCommentRepo), new(MockTrendingCache), mockLock, time.Hour)
+
+		mockLock.On("Acquire", ctx, domain.TrendingLockKey, mock.Anything, 2*time.Hour).Return(false, nil).Once()
+
+		job.run(ctx, now)
+
+		mockLock.AssertExpectations(t)
+		mockVideo.AssertNotCalled(t, "HourlyViews", mock.Anything)
+	})
+}
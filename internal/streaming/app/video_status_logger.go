@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+	"streaming_video_service/pkg/logger"
+)
+
+// VideoStatusLogger 消費 VideoStatusTopic 並記錄影片狀態事件
+// in-memory JobQueue 只存在於同一個 process，chat_service 收不到影片狀態事件；
+// 由此消費避免佇列塞滿後 OutboxRelay 發布時等待，僅在 job_queue.backend 為 memory 時啟動
+type VideoStatusLogger struct {
+	queue database.JobQueue
+}
+
+// NewVideoStatusLogger 建構 VideoStatusLogger 實例
+func NewVideoStatusLogger(queue database.JobQueue) *VideoStatusLogger {
+	return &VideoStatusLogger{queue: queue}
+}
+
+// Start 開始消費影片狀態事件，直到 ctx 結束
+func (l *VideoStatusLogger) Start(ctx context.Context) {
+	logger.Log.Info("VideoStatusLogger 已啟動，記錄影片狀態事件")
+	if err := l.queue.Consume(ctx, domain.VideoStatusTopic, l.Handle); err != nil && ctx.Err() == nil {
+		logger.Log.Errorf("VideoStatusLogger 消費影片狀態事件失敗:", err)
+	}
+}
+
+// Handle 記錄一則影片狀態事件後確認
+func (l *VideoStatusLogger) Handle(ctx context.Context, msg database.QueueMessage) error {
+	logger.Log.Info(fmt.Sprintf("messageID[%s] 影片狀態事件 %s: %s", msg.ID, msg.Type, msg.Body))
+	return nil
+}
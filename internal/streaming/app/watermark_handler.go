@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+
+	"streaming_video_service/internal/streaming/domain"
+	streaming_pb "streaming_video_service/pkg/proto/streaming"
+)
+
+// SetChannelWatermark 實作 設定頻道浮水印
+func (s *StreamingGRPCServer) SetChannelWatermark(ctx context.Context, req *streaming_pb.SetWatermarkReq) (*streaming_pb.WatermarkRes, error) {
+	watermark, err := s.WatermarkUsecase.SetChannelWatermark(ctx, toSetWatermarkReq(req))
+	return toWatermarkRes(watermark, err), nil
+}
+
+// GetChannelWatermark 實作 取得頻道浮水印
+func (s *StreamingGRPCServer) GetChannelWatermark(ctx context.Context, req *streaming_pb.GetWatermarkReq) (*streaming_pb.WatermarkRes, error) {
+	watermark, err := s.WatermarkUsecase.GetChannelWatermark(ctx, req.MemberId)
+	return toWatermarkRes(watermark, err), nil
+}
+
+// SetPlatformWatermark 實作 設定平台浮水印
+func (s *StreamingGRPCServer) SetPlatformWatermark(ctx context.Context, req *streaming_pb.SetWatermarkReq) (*streaming_pb.WatermarkRes, error) {
+	watermark, err := s.WatermarkUsecase.SetPlatformWatermark(ctx, req.Role, toSetWatermarkReq(req))
+	return toWatermarkRes(watermark, err), nil
+}
+
+// GetPlatformWatermark 實作 取得平台浮水印
+func (s *StreamingGRPCServer) GetPlatformWatermark(ctx context.Context, req *streaming_pb.GetWatermarkReq) (*streaming_pb.WatermarkRes, error) {
+	watermark, err := s.WatermarkUsecase.GetPlatformWatermark(ctx, req.Role)
+	return toWatermarkRes(watermark, err), nil
+}
+
+func toSetWatermarkReq(req *streaming_pb.SetWatermarkReq) domain.SetWatermarkReq {
+	return domain.SetWatermarkReq{
+		MemberID: req.MemberId,
+		Image:    req.Image,
+		Position: domain.WatermarkPosition(req.Position),
+		Opacity:  req.Opacity,
+		Enabled:  req.Enabled,
+	}
+}
+
+// toWatermarkRes 將浮水印設定轉為 proto 回應格式，未設定時 Watermark 為空
+func toWatermarkRes(watermark *domain.Watermark, err error) *streaming_pb.WatermarkRes {
+	if err != nil {
+		return &streaming_pb.WatermarkRes{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	res := &streaming_pb.WatermarkRes{Success: true}
+	if watermark != nil {
+		res.Watermark = &streaming_pb.Watermark{
+			Position:  watermark.Position,
+			Opacity:   watermark.Opacity,
+			Enabled:   watermark.Enabled,
+			UpdatedAt: watermark.UpdatedAt.Unix(),
+		}
+	}
+	return res
+}
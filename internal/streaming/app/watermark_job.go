@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+)
+
+// prepareWatermarks 依影片上傳時的選擇取得要燒錄的浮水印，並下載圖片到本地
+//   - 上傳時選擇燒錄頻道浮水印：使用上傳者的頻道浮水印
+//   - 免費方案上傳：使用平台浮水印
+//
+// 浮水印未設定或已停用時略過，不影響轉碼
+func prepareWatermarks(ctx context.Context, video *domain.Video, mClient database.MinIOClientRepo,
+	watermarkRepo repository.WatermarkRepo) ([]WatermarkOverlay, error) {
+	var owners []string
+	if video.ChannelWatermark {
+		owners = append(owners, video.MemberID)
+	}
+	if video.PlatformWatermark {
+		owners = append(owners, domain.PlatformWatermarkOwner)
+	}
+
+	var overlays []WatermarkOverlay
+	for _, owner := range owners {
+		watermark, err := watermarkRepo.Get(owner)
+		if err != nil {
+			removeWatermarks(overlays)
+			return nil, fmt.Errorf("取得浮水印設定 %s 失敗: %w", owner, err)
+		}
+		if watermark == nil || !watermark.Enabled {
+			continue
+		}
+		imagePath := fmt.Sprintf("./tmp/%d_watermark_%d", video.ID, len(overlays))
+		if err := mClient.DownloadFile(ctx, watermark.ObjectKey, imagePath); err != nil {
+			removeWatermarks(overlays)
+			return nil, fmt.Errorf("下載浮水印圖片 %s 失敗: %w", watermark.ObjectKey, err)
+		}
+		overlays = append(overlays, WatermarkOverlay{
+			ImagePath: imagePath,
+			Position:  domain.WatermarkPosition(watermark.Position),
+			Opacity:   watermark.Opacity,
+		})
+	}
+	return overlays, nil
+}
+
+// removeWatermarks 清理下載的浮水印圖片
+func removeWatermarks(overlays []WatermarkOverlay) {
+	for _, overlay := range overlays {
+		if err := os.Remove(overlay.ImagePath); err != nil {
+			log.Printf("警告：清理浮水印圖片失敗: %v", err)
+		}
+	}
+}
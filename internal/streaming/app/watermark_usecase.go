@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/internal/streaming/repository"
+	"streaming_video_service/pkg/database"
+	errprocess "streaming_video_service/pkg/err"
+	"streaming_video_service/pkg/token"
+)
+
+// WatermarkUseCase 頻道與平台浮水印設定
+type WatermarkUseCase interface {
+	SetChannelWatermark(ctx context.Context, req domain.SetWatermarkReq) (*domain.Watermark, error)
+	GetChannelWatermark(ctx context.Context, memberID string) (*domain.Watermark, error)
+	SetPlatformWatermark(ctx context.Context, role string, req domain.SetWatermarkReq) (*domain.Watermark, error)
+	GetPlatformWatermark(ctx context.Context, role string) (*domain.Watermark, error)
+}
+
+type watermarkUseCase struct {
+	MinioClient   database.MinIOClientRepo
+	WatermarkRepo repository.WatermarkRepo
+}
+
+// NewWatermarkUseCase 建立 WatermarkUseCase
+func NewWatermarkUseCase(minIO database.MinIOClientRepo, watermarkRepo repository.WatermarkRepo) WatermarkUseCase {
+	return &watermarkUseCase{
+		MinioClient:   minIO,
+		WatermarkRepo: watermarkRepo,
+	}
+}
+
+// 讓 test mock 上傳浮水印圖片，MinIO 上傳需要本地檔案，先寫入暫存檔再上傳
+var uploadWatermarkImage = func(ctx context.Context, mClient database.MinIOClientRepo, objectName string, image []byte, contentType string) error {
+	tempFile, err := os.CreateTemp("", "watermark-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(image); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	return mClient.UploadFile(ctx, objectName, tempFile.Name(), contentType)
+}
+
+// SetChannelWatermark 設定頻道浮水印，之後上傳時可選擇燒錄
+func (w *watermarkUseCase) SetChannelWatermark(ctx context.Context, req domain.SetWatermarkReq) (*domain.Watermark, error) {
+	if req.MemberID == "" {
+		return nil, errprocess.Set("需登入才能設定浮水印")
+	}
+	return w.save(ctx, req.MemberID, req)
+}
+
+// GetChannelWatermark 取得頻道浮水印，未設定時回傳 nil
+func (w *watermarkUseCase) GetChannelWatermark(ctx context.Context, memberID string) (*domain.Watermark, error) {
+	if memberID == "" {
+		return nil, errprocess.Set("需登入才能查看浮水印")
+	}
+	return w.get(memberID)
+}
+
+// SetPlatformWatermark 由管理員設定平台浮水印，燒錄在免費方案會員之後上傳的影片
+func (w *watermarkUseCase) SetPlatformWatermark(ctx context.Context, role string, req domain.SetWatermarkReq) (*domain.Watermark, error) {
+	if role != string(token.RoleAdmin) {
+		errMsg := fmt.Sprintf("memberID[%s] 需管理員權限才能設定平台浮水印", req.MemberID)
+		return nil, errprocess.Set(errMsg)
+	}
+	return w.save(ctx, domain.PlatformWatermarkOwner, req)
+}
+
+// GetPlatformWatermark 取得平台浮水印，未設定時回傳 nil
+func (w *watermarkUseCase) GetPlatformWatermark(ctx context.Context, role string) (*domain.Watermark, error) {
+	if role != string(token.RoleAdmin) {
+		return nil, errprocess.Set("需管理員權限才能查看平台浮水印")
+	}
+	return w.get(domain.PlatformWatermarkOwner)
+}
+
+func (w *watermarkUseCase) get(owner string) (*domain.Watermark, error) {
+	watermark, err := w.WatermarkRepo.Get(owner)
+	if err != nil {
+		errMsg := fmt.Sprintf("owner[%s] 取得浮水印失敗: %v", owner, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return watermark, nil
+}
+
+// save 驗證並保存浮水印設定；有附圖片時先上傳到 MinIO，第一次設定必須附圖片
+// 未指定的位置、不透明度與開關沿用目前的設定，第一次設定時使用預設值
+func (w *watermarkUseCase) save(ctx context.Context, owner string, req domain.SetWatermarkReq) (*domain.Watermark, error) {
+	position, opacity := req.Position, req.Opacity
+	if position == "" {
+		position = domain.WatermarkBottomRight
+	}
+	if opacity == 0 {
+		opacity = domain.DefaultWatermarkOpacity
+	}
+	if err := domain.ValidateWatermark(position, opacity); err != nil {
+		errMsg := fmt.Sprintf("owner[%s] 浮水印設定錯誤: %v", owner, err)
+		return nil, errprocess.Set(errMsg)
+	}
+
+	watermark, err := w.get(owner)
+	if err != nil {
+		return nil, err
+	}
+	if watermark == nil {
+		if len(req.Image) == 0 {
+			errMsg := fmt.Sprintf("owner[%s] 第一次設定浮水印需上傳圖片", owner)
+			return nil, errprocess.Set(errMsg)
+		}
+		watermark = &domain.Watermark{
+			Owner:     owner,
+			ObjectKey: domain.WatermarkObjectKey(owner),
+			Position:  string(position),
+			Opacity:   opacity,
+			Enabled:   true,
+		}
+	}
+
+	if len(req.Image) > 0 {
+		if len(req.Image) > domain.MaxWatermarkSize {
+			errMsg := fmt.Sprintf("owner[%s] 浮水印圖片不可超過 %d bytes", owner, domain.MaxWatermarkSize)
+			return nil, errprocess.Set(errMsg)
+		}
+		contentType := http.DetectContentType(req.Image)
+		if contentType != "image/png" && contentType != "image/jpeg" {
+			errMsg := fmt.Sprintf("owner[%s] 浮水印圖片需為 PNG 或 JPEG: %s", owner, contentType)
+			return nil, errprocess.Set(errMsg)
+		}
+		if err := uploadWatermarkImage(ctx, w.MinioClient, watermark.ObjectKey, req.Image, contentType); err != nil {
+			errMsg := fmt.Sprintf("owner[%s] 上傳浮水印圖片失敗: %v", owner, err)
+			return nil, errprocess.Set(errMsg)
+		}
+	}
+
+	if req.Position != "" {
+		watermark.Position = string(req.Position)
+	}
+	if req.Opacity != 0 {
+		watermark.Opacity = req.Opacity
+	}
+	if req.Enabled != nil {
+		watermark.Enabled = *req.Enabled
+	}
+	if err := w.WatermarkRepo.Save(watermark); err != nil {
+		errMsg := fmt.Sprintf("owner[%s] 保存浮水印設定失敗: %v", owner, err)
+		return nil, errprocess.Set(errMsg)
+	}
+	return watermark, nil
+}
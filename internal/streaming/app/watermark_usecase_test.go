@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"streaming_video_service/internal/streaming/domain"
+	"streaming_video_service/pkg/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWatermarkRepo 是 WatermarkRepo 的 Mock
+type MockWatermarkRepo struct {
+	mock.Mock
+}
+
+// AutoMigrate 模擬建立資料表
+func (m *MockWatermarkRepo) AutoMigrate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// Get 模擬取得浮水印設定
+func (m *MockWatermarkRepo) Get(owner string) (*domain.Watermark, error) {
+	args := m.Called(owner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Watermark), args.Error(1)
+}
+
+// Save 模擬保存浮水印設定
+func (m *MockWatermarkRepo) Save(watermark *domain.Watermark) error {
+	args := m.Called(watermark)
+	return args.Error(0)
+}
+
+// pngHeader 讓 http.DetectContentType 判斷為 image/png
+var pngHeader = []byte("\x89PNG\r\n\x1a\n0000")
+
+func TestSetChannelWatermark(t *testing.T) {
+	ctx := context.Background()
+	originalUpload := uploadWatermarkImage
+	defer func() { uploadWatermarkImage = originalUpload }()
+
+	// **情境 1: 第一次設定並上傳圖片**
+	t.Run("第一次設定", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+		var uploaded string
+		uploadWatermarkImage = func(ctx context.Context, mClient database.MinIOClientRepo, objectName string, image []byte, contentType string) error {
+			uploaded = objectName + " " + contentType
+			return nil
+		}
+
+		mockRepo.On("Get", "owner").Return(nil, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(w *domain.Watermark) bool {
+			return w.Owner == "owner" && w.ObjectKey == "watermarks/owner/watermark" &&
+				w.Position == string(domain.WatermarkBottomRight) && w.Opacity == domain.DefaultWatermarkOpacity && w.Enabled
+		})).Return(nil).Once()
+
+		watermark, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{MemberID: "owner", Image: pngHeader})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "watermarks/owner/watermark image/png", uploaded)
+		assert.Equal(t, string(domain.WatermarkBottomRight), watermark.Position)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 2: 第一次設定未附圖片**
+	t.Run("未附圖片", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+
+		mockRepo.On("Get", "owner").Return(nil, nil).Once()
+
+		_, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{MemberID: "owner"})
+
+		assert.EqualError(t, err, "owner[owner] 第一次設定浮水印需上傳圖片")
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	// **情境 3: 只更新位置與不透明度，不重新上傳圖片，未帶開關時維持開啟**
+	t.Run("只更新設定", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+		uploadWatermarkImage = func(ctx context.Context, mClient database.MinIOClientRepo, objectName string, image []byte, contentType string) error {
+			return errors.New("should not upload")
+		}
+
+		mockRepo.On("Get", "owner").Return(&domain.Watermark{Owner: "owner", ObjectKey: "watermarks/owner/watermark",
+			Position: string(domain.WatermarkBottomRight), Opacity: 0.8, Enabled: true}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(w *domain.Watermark) bool {
+			return w.Position == string(domain.WatermarkTopLeft) && w.Opacity == 0.3 && w.Enabled
+		})).Return(nil).Once()
+
+		_, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{
+			MemberID: "owner", Position: domain.WatermarkTopLeft, Opacity: 0.3,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 4: 只關閉浮水印，位置與不透明度維持原本的設定**
+	t.Run("只關閉浮水印", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+		disabled := false
+
+		mockRepo.On("Get", "owner").Return(&domain.Watermark{Owner: "owner", ObjectKey: "watermarks/owner/watermark",
+			Position: string(domain.WatermarkTopLeft), Opacity: 0.3, Enabled: true}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(w *domain.Watermark) bool {
+			return w.Position == string(domain.WatermarkTopLeft) && w.Opacity == 0.3 && !w.Enabled
+		})).Return(nil).Once()
+
+		_, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{MemberID: "owner", Enabled: &disabled})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// **情境 5: 圖片格式錯誤**
+	t.Run("圖片格式錯誤", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+
+		mockRepo.On("Get", "owner").Return(nil, nil).Once()
+
+		_, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{MemberID: "owner", Image: []byte("not an image")})
+
+		assert.EqualError(t, err, "owner[owner] 浮水印圖片需為 PNG 或 JPEG: text/plain; charset=utf-8")
+	})
+
+	// **情境 6: 位置錯誤**
+	t.Run("位置錯誤", func(t *testing.T) {
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), new(MockWatermarkRepo))
+
+		_, err := usecase.SetChannelWatermark(ctx, domain.SetWatermarkReq{MemberID: "owner", Position: "center"})
+
+		assert.EqualError(t, err, "owner[owner] 浮水印設定錯誤: 不支援的浮水印位置: center")
+	})
+}
+
+func TestSetPlatformWatermark(t *testing.T) {
+	ctx := context.Background()
+
+	// **情境 1: 非管理員無法設定平台浮水印**
+	t.Run("非管理員", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+
+		_, err := usecase.SetPlatformWatermark(ctx, "member", domain.SetWatermarkReq{MemberID: "m1", Image: pngHeader})
+
+		assert.EqualError(t, err, "memberID[m1] 需管理員權限才能設定平台浮水印")
+		mockRepo.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	// **情境 2: 管理員設定平台浮水印**
+	t.Run("管理員", func(t *testing.T) {
+		mockRepo := new(MockWatermarkRepo)
+		usecase := NewWatermarkUseCase(new(MockMinIOClient), mockRepo)
+
+		enabled := true
+		mockRepo.On("Get", domain.PlatformWatermarkOwner).
+			Return(&domain.Watermark{Owner: domain.PlatformWatermarkOwner}, nil).Once()
+		mockRepo.On("Save", mock.MatchedBy(func(w *domain.Watermark) bool {
+			return w.Owner == domain.PlatformWatermarkOwner && w.Enabled
+		})).Return(nil).Once()
+
+		_, err := usecase.SetPlatformWatermark(ctx, "admin", domain.SetWatermarkReq{MemberID: "a1", Enabled: &enabled})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestWatermarkFilter(t *testing.T) {
+	// **情境 1: 頻道與平台浮水印依序疊加**
+	filter := watermarkFilter([]WatermarkOverlay{
+		{ImagePath: "channel.png", Position: domain.WatermarkTopLeft, Opacity: 0.5},
+		{ImagePath: "platform.png", Position: domain.WatermarkBottomRight, Opacity: 1},
+	})
+
+	assert.Equal(t, "[1:v]format=rgba,colorchannelmixer=aa=0.50[wm0];[0:v][wm0]overlay=16:16[v0];"+
+		"[2:v]format=rgba,colorchannelmixer=aa=1.00[wm1];[v0][wm1]overlay=W-w-16:H-h-16[vout]", filter)
+}
@@ -14,92 +14,69 @@ import (
 	"streaming_video_service/internal/streaming/repository"
 	"streaming_video_service/pkg/database"
 	"streaming_video_service/pkg/logger"
-	// Kafka 客戶端
-	// RabbitMQ 客戶端
 )
 
 // Consumer 定義一個消息消費者，將所有必要的依賴注入進來
 type Consumer struct {
-	rabbitChannel database.RabbitRepo
+	queue         database.JobQueue
 	minioClient   database.MinIOClientRepo
 	videoRepo     repository.VideoRepo
+	watermarkRepo repository.WatermarkRepo
+	thumbnailRepo repository.ThumbnailRepo
 	queueName     string
+	playbackURLs  *domain.PlaybackURLBuilder // 影片狀態事件的封面網址
 }
 
-// NewConsumer 建構 Consumer 實例
-func NewConsumer(rabbitChannel database.RabbitRepo, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo, queueName string) *Consumer {
+// NewConsumer 建構 Consumer 實例，queue 可為 RabbitMQ、Kafka 或 in-memory
+func NewConsumer(queue database.JobQueue, minioClient database.MinIOClientRepo, videoRepo repository.VideoRepo,
+	watermarkRepo repository.WatermarkRepo, thumbnailRepo repository.ThumbnailRepo, queueName string,
+	playbackURLs *domain.PlaybackURLBuilder) *Consumer {
 	return &Consumer{
-		rabbitChannel: rabbitChannel,
+		queue:         queue,
 		minioClient:   minioClient,
 		videoRepo:     videoRepo,
+		watermarkRepo: watermarkRepo,
+		thumbnailRepo: thumbnailRepo,
 		queueName:     queueName,
+		playbackURLs:  playbackURLs,
 	}
 }
 
-// StartConsumer 開始消費訊息，並處理轉碼工作
-func (c *Consumer) StartConsumer(ctx context.Context) {
-	// 設定消費該 queue
-	msgs, err := c.rabbitChannel.GetRabbit().Consume(
-		c.queueName, // 使用依賴注入進來的 queue name
-		"",          // consumer tag，留空由系統分配
-		false,       // autoAck 為 false，使用手動確認
-		false,       // exclusive
-		false,       // noLocal
-		false,       // noWait
-		nil,         // arguments
-	)
-	if err != nil {
-		log.Fatalf("無法開始消費 RabbitMQ 訊息: %v", err)
-	}
+// 讓測試可以替換實際的轉碼（需要 FFmpeg 與 MinIO）
+var transcode = processTranscodingJob
 
+// StartConsumer 開始消費訊息，並處理轉碼工作，直到 ctx 結束
+func (c *Consumer) StartConsumer(ctx context.Context) {
 	log.Println("Consumer 已啟動，等待轉碼工作訊息...")
 
-	// 持續監聽訊息
-	for {
-		select {
-		case d, ok := <-msgs:
-			if !ok {
-				log.Println("RabbitMQ 消費 channel 已關閉")
-				return
-			}
-
-			// 處理收到的訊息
-			var job domain.TranscodingJob
-			if err := json.Unmarshal(d.Body, &job); err != nil {
-				log.Printf("解析轉碼工作訊息失敗: %v", err)
-				// 若解析失敗，拒絕並重新排入佇列
-				if err := d.Nack(false, true); err != nil {
-					log.Printf("Nack 訊息失敗: %v", err)
-				}
-				continue
-			}
-
-			log.Printf("收到轉碼工作訊息: VideoID=%d, FileName=%s, Type=%s", job.VideoID, job.FileName, job.Type)
+	if err := c.queue.Consume(ctx, c.queueName, c.handle); err != nil && ctx.Err() == nil {
+		log.Printf("消費轉碼工作訊息失敗: %v", err)
+		logger.Log.Errorf("消費轉碼工作訊息失敗:", err)
+		return
+	}
+	log.Println("Consumer 收到停止訊號")
+}
 
-			// 呼叫 processTranscodingJob 執行轉碼工作
-			if err := processTranscodingJob(ctx, job, c.minioClient, c.videoRepo); err != nil {
-				log.Printf("處理轉碼工作失敗: %v", err)
-				// 處理失敗時，拒絕訊息並重新排入佇列
+// handle 處理一則轉碼工作訊息，回傳錯誤時由 JobQueue 依 RetryPolicy 延遲重試
+// 無法解析的訊息重試也不會成功，直接確認並捨棄
+func (c *Consumer) handle(ctx context.Context, msg database.QueueMessage) error {
+	var job domain.TranscodingJob
+	if err := json.Unmarshal(msg.Body, &job); err != nil {
+		logger.Log.Errorf(fmt.Sprintf("messageID[%s] 解析轉碼工作訊息失敗:", msg.ID), err)
+		return nil
+	}
 
-				logger.Log.Errorf("處理轉碼工作失敗:", err)
-				time.Sleep(10 * time.Second)
-				if err := d.Nack(false, true); err != nil {
-					log.Printf("Nack 訊息失敗: %v", err)
-				}
-				continue
-			}
+	log.Printf("收到轉碼工作訊息: VideoID=%d, FileName=%s, Type=%s", job.VideoID, job.FileName, job.Type)
 
-			// 處理成功後，確認訊息
-			if err := d.Ack(false); err != nil {
-				log.Printf("確認訊息失敗: %v", err)
-			} else {
-				log.Printf("成功處理並確認訊息，VideoID: %d", job.VideoID)
-			}
-		case <-ctx.Done():
-			log.Println("Consumer 收到停止訊號")
-			return
-		}
+	// 呼叫 processTranscodingJob 執行轉碼工作
+	if err := transcode(ctx, job, c.minioClient, c.videoRepo, c.watermarkRepo, c.thumbnailRepo, c.playbackURLs); err != nil {
+		log.Printf("處理轉碼工作失敗: %v", err)
+		logger.Log.Errorf("處理轉碼工作失敗:", err)
+		return err
 	}
+
+	log.Printf("成功處理並確認訊息，VideoID: %d", job.VideoID)
+	return nil
 }
 
 // processTranscodingJob 負責執行轉碼工作：
@@ -108,31 +85,111 @@ func (c *Consumer) StartConsumer(ctx context.Context) {
 // 3. 將轉碼結果上傳到 MinIO 的 processed/{videoID}/ 目錄
 // 4. 更新資料庫中該影片的狀態為 "ready"
 // 5. 清理本地暫存檔案
-func processTranscodingJob(ctx context.Context, job domain.TranscodingJob, mClient database.MinIOClientRepo, videoRepo repository.VideoRepo) error {
+// 剪輯工作（job.Clip 不為 nil）改由來源影片轉碼後的 HLS 分段剪出片段，見 cutClip
+func processTranscodingJob(ctx context.Context, job domain.TranscodingJob, mClient database.MinIOClientRepo,
+	videoRepo repository.VideoRepo, watermarkRepo repository.WatermarkRepo, thumbnailRepo repository.ThumbnailRepo,
+	playbackURLs *domain.PlaybackURLBuilder) (err error) {
+	// 以條件更新將 upload 認領為 processing，認領失敗代表影片已完成或正由其他 Worker 處理
+	// （例如 reconciler 重新發布後原本的訊息才被處理），直接略過
+	video, err := videoRepo.GetByID(job.VideoID)
+	if err != nil {
+		return fmt.Errorf("從資料庫取得影片失敗: %w", err)
+	}
+	if video.IsBlocked() {
+		log.Printf("影片 VideoID: %d 已被下架，略過轉碼工作", job.VideoID)
+		return nil
+	}
+	claimed, err := videoRepo.ClaimTranscode(job.VideoID)
+	if err != nil {
+		return fmt.Errorf("認領轉碼工作失敗: %w", err)
+	}
+	if !claimed {
+		log.Printf("影片 VideoID: %d 已轉碼完成或正由其他 Worker 處理，略過重複的轉碼工作", job.VideoID)
+		return nil
+	}
+	// 轉碼失敗時改回 upload，讓重試的訊息可以再次認領；重試次數用盡時標記為 failed 並通知上傳者，不再重試
+	defer func() {
+		if err == nil {
+			return
+		}
+		failed, releaseErr := videoRepo.ReleaseTranscode(job.VideoID, videoStatusEvents(playbackURLs))
+		if releaseErr != nil {
+			log.Printf("警告：釋放轉碼工作失敗，VideoID: %d: %v", job.VideoID, releaseErr)
+			return
+		}
+		if failed {
+			logger.Log.Errorf(fmt.Sprintf("videoID[%d] 轉碼失敗 %d 次，標記為 failed:", job.VideoID, domain.MaxTranscodeAttempts), err)
+			err = nil
+		}
+	}()
+	// 轉碼期間定期更新 updated_at，StuckJobReconciler 只處理停止更新的影片
+	stopHeartbeat := startTranscodeHeartbeat(ctx, job.VideoID, videoRepo)
+	defer stopHeartbeat()
+
 	// 1. 定義本地檔案的暫存路徑
 	localInputPath := fmt.Sprintf("./tmp/%d_original.mp4", job.VideoID)
 	localOutputDir := fmt.Sprintf("./tmp/%d_processed", job.VideoID)
 
-	// 2. 從 MinIO 下載原始影片檔
-	log.Printf("下載原始影片，VideoID: %d, ObjectKey: %s", job.VideoID, job.FileName)
-	if err := mClient.DownloadFile(ctx, job.FileName, localInputPath); err != nil {
-		return fmt.Errorf("下載原始影片失敗: %w", err)
-	}
-
 	// 3. 建立本地轉碼輸出目錄
 	if err := os.MkdirAll(localOutputDir, 0755); err != nil {
 		return fmt.Errorf("建立轉碼輸出目錄失敗: %w", err)
 	}
+	// 7. 不論成功或失敗都清理本地暫存檔案：原始檔（剪輯時為來源分段目錄），
+	// 以及輸出目錄內的 HLS、剪好的片段、候選縮圖與預覽短片
+	defer func() {
+		if removeErr := os.RemoveAll(localInputPath); removeErr != nil {
+			log.Printf("警告：清理本地原始檔失敗: %v", removeErr)
+		}
+		if removeErr := os.RemoveAll(localOutputDir); removeErr != nil {
+			log.Printf("警告：清理本地轉碼輸出目錄失敗: %v", removeErr)
+		}
+	}()
 
-	// 4. 呼叫 FFmpeg 進行轉碼
-	// 根據影片類型，你可以選擇不同的轉碼策略，這裡以 HLS 為例
-	log.Printf("開始轉碼影片 VideoID: %d 為 HLS 格式", job.VideoID)
-	if err := TranscodeToHLS(localInputPath, localOutputDir); err != nil {
-		return fmt.Errorf("FFmpeg HLS 轉碼失敗: %w", err)
+	// 剪輯時由剪好的片段擷取封面，並在完成後清除下載的來源分段
+	posterInput := localInputPath
+	if job.Clip != nil {
+		if localInputPath, err = cutClip(ctx, job, mClient, localOutputDir); err != nil {
+			return err
+		}
+		posterInput = filepath.Join(localOutputDir, "index.m3u8")
+	} else {
+		// 2. 從 MinIO 下載原始影片檔
+		log.Printf("下載原始影片，VideoID: %d, ObjectKey: %s", job.VideoID, job.FileName)
+		if err := mClient.DownloadFile(ctx, job.FileName, localInputPath); err != nil {
+			return fmt.Errorf("下載原始影片失敗: %w", err)
+		}
+
+		// 浮水印圖片下載到輸入檔旁，與原始檔一起清理
+		watermarks, err := prepareWatermarks(ctx, video, mClient, watermarkRepo)
+		if err != nil {
+			return err
+		}
+		defer removeWatermarks(watermarks)
+
+		// 4. 呼叫 FFmpeg 進行轉碼
+		// 根據影片類型，你可以選擇不同的轉碼策略，這裡以 HLS 為例
+		log.Printf("開始轉碼影片 VideoID: %d 為 HLS 格式（浮水印 %d 個）", job.VideoID, len(watermarks))
+		if err := TranscodeToHLS(localInputPath, localOutputDir, watermarks); err != nil {
+			return fmt.Errorf("FFmpeg HLS 轉碼失敗: %w", err)
+		}
+	}
+	// 候選縮圖與 HLS 檔案一起上傳，挑選失敗時退回擷取單張封面，擷取失敗不影響播放
+	thumbnails, err := generateThumbnails(posterInput, localOutputDir)
+	if err != nil {
+		log.Printf("警告：挑選候選縮圖失敗，改為擷取封面，VideoID: %d: %v", job.VideoID, err)
+		if err := GeneratePoster(posterInput, filepath.Join(localOutputDir, domain.PosterFileName)); err != nil {
+			log.Printf("警告：擷取封面失敗，VideoID: %d: %v", job.VideoID, err)
+		}
+	}
+	// 動畫預覽同樣一起上傳，產生失敗不影響播放，只是不提供預覽網址
+	hasPreview := true
+	if err := generatePreview(posterInput, filepath.Join(localOutputDir, domain.PreviewFileName)); err != nil {
+		log.Printf("警告：產生預覽短片失敗，VideoID: %d: %v", job.VideoID, err)
+		hasPreview = false
 	}
 
 	// 5. 將轉碼結果上傳回 MinIO
-	// 假設轉碼後在 localOutputDir 會產生 index.m3u8 與 TS 段檔
+	// 假設轉碼後在 localOutputDir 會產生 index.m3u8、TS 段檔與封面圖
 	files, err := ioutil.ReadDir(localOutputDir)
 	if err != nil {
 		return fmt.Errorf("讀取轉碼輸出目錄失敗: %w", err)
@@ -148,27 +205,143 @@ func processTranscodingJob(ctx context.Context, job domain.TranscodingJob, mClie
 	}
 
 	// 6. 更新資料庫中該影片的狀態為 "ready"
-	video, err := videoRepo.GetByID(job.VideoID)
+	// 重新讀取影片，避免覆寫轉碼期間上傳者修改的欄位
+	video, err = videoRepo.GetByID(job.VideoID)
 	if err != nil {
 		return fmt.Errorf("從資料庫取得影片失敗: %w", err)
 	}
+	// 重新轉碼時取代原有的自動縮圖；上傳者選擇自訂縮圖時保留，否則使用分數最高的候選縮圖
+	for index := range thumbnails {
+		thumbnails[index].VideoID = video.ID
+	}
+	if err := thumbnailRepo.ReplaceAuto(video.ID, thumbnails); err != nil {
+		return fmt.Errorf("保存候選縮圖失敗: %w", err)
+	}
+	if domain.IsAutoThumbnail(video.ThumbnailName) {
+		video.ThumbnailName = ""
+		if len(thumbnails) > 0 {
+			video.ThumbnailName = thumbnails[0].Name
+		}
+	}
+	// 同一個交易內寫入 video.ready 事件，通知上傳者；只更新仍為 processing 的影片，轉碼期間被下架的影片維持 blocked
+	readyAt := time.Now()
 	video.Status = string(domain.VideoReady)
-	if err := videoRepo.Update(video); err != nil {
+	video.ReadyAt = &readyAt
+	video.HasPreview = hasPreview
+	saved, err := videoRepo.SaveReadyWithEvents(video, videoStatusEvents(playbackURLs))
+	if err != nil {
 		return fmt.Errorf("更新影片狀態失敗: %w", err)
 	}
-	log.Printf("影片 VideoID: %d 狀態更新為 ready", job.VideoID)
-
-	// 7. 清理本地暫存檔案
-	if err := os.Remove(localInputPath); err != nil {
-		log.Printf("警告：清理本地原始檔失敗: %v", err)
+	if saved {
+		log.Printf("影片 VideoID: %d 狀態更新為 ready", job.VideoID)
+	} else {
+		log.Printf("影片 VideoID: %d 轉碼期間已被下架或改由其他 Worker 處理，不更新為 ready", job.VideoID)
 	}
-	if err := os.RemoveAll(localOutputDir); err != nil {
-		log.Printf("警告：清理本地轉碼輸出目錄失敗: %v", err)
+	// 可公開提供的影片複製到 public/，重新轉碼時覆寫原有的副本；失敗時由 PublishScheduler 重試
+	if saved && video.WantsPublicCopy() {
+		if err := publishPublicCopy(ctx, mClient, videoRepo, video); err != nil {
+			log.Printf("警告：建立公開副本失敗，VideoID: %d: %v", job.VideoID, err)
+		}
 	}
 
 	return nil
 }
 
+// startTranscodeHeartbeat 每 TranscodeHeartbeatInterval 更新一次影片的 updated_at，回傳停止函式
+func startTranscodeHeartbeat(ctx context.Context, videoID uint, videoRepo repository.VideoRepo) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(domain.TranscodeHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := videoRepo.TouchTranscode(videoID); err != nil {
+					log.Printf("警告：更新轉碼心跳失敗，VideoID: %d: %v", videoID, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// generateThumbnails 以場景偵測擷取候選畫面，依亮度、對比與場景變化挑選 ThumbnailCandidateCount 張，
+// 縮放成各尺寸輸出到 outputDir；回傳的縮圖依分數由高到低排列
+func generateThumbnails(inputPath, outputDir string) ([]domain.VideoThumbnail, error) {
+	// 擷取的畫面放在另一個目錄，避免與轉碼結果一起上傳
+	framesDir := outputDir + "_frames"
+	if err := os.MkdirAll(framesDir, 0755); err != nil {
+		return nil, fmt.Errorf("建立候選畫面目錄失敗: %w", err)
+	}
+	defer os.RemoveAll(framesDir)
+
+	// 取得長度失敗時仍擷取畫面，只是不限制相鄰畫面的間隔
+	duration, err := ProbeDuration(inputPath)
+	if err != nil {
+		log.Printf("警告：取得影片長度失敗，候選畫面可能集中在開頭: %v", err)
+	}
+	frames, err := ExtractThumbnailFrames(inputPath, framesDir, duration)
+	if err != nil {
+		return nil, err
+	}
+	picked := domain.PickThumbnailFrames(frames, domain.ThumbnailCandidateCount)
+	if len(picked) == 0 {
+		return nil, fmt.Errorf("%d 格候選畫面中沒有合適的縮圖", len(frames))
+	}
+
+	thumbnails := make([]domain.VideoThumbnail, 0, len(picked))
+	for rank, frame := range picked {
+		name := domain.AutoThumbnailName(rank + 1)
+		framePath := filepath.Join(framesDir, fmt.Sprintf(ThumbnailFramePattern, frame.Index+1))
+		if err := ResizeThumbnail(framePath, outputDir, name); err != nil {
+			return nil, err
+		}
+		thumbnails = append(thumbnails, domain.VideoThumbnail{
+			Name:   name,
+			Source: string(domain.ThumbnailAuto),
+			Score:  frame.Score(),
+			TimeMs: frame.Time.Milliseconds(),
+		})
+	}
+	return thumbnails, nil
+}
+
+// generatePreview 避開黑畫面挑選擷取位置，產生動畫預覽短片
+// 偵測黑畫面失敗時仍以預設位置擷取
+func generatePreview(inputPath, outputPath string) error {
+	duration, err := ProbeDuration(inputPath)
+	if err != nil {
+		return err
+	}
+	blacks, err := DetectBlackFrames(inputPath, domain.PreviewScanLength(duration))
+	if err != nil {
+		log.Printf("警告：偵測黑畫面失敗，以預設位置擷取預覽: %v", err)
+	}
+	start, length := domain.PreviewWindow(duration, blacks)
+	if length <= 0 {
+		return fmt.Errorf("影片長度為 0，無法產生預覽短片")
+	}
+	return GeneratePreview(inputPath, outputPath, start, length)
+}
+
+// videoStatusEvents 影片變成 ready 或 failed 時的事件，附上封面網址
+func videoStatusEvents(playbackURLs *domain.PlaybackURLBuilder) func(video *domain.Video) ([]domain.OutboxEvent, error) {
+	return func(video *domain.Video) ([]domain.OutboxEvent, error) {
+		event, err := domain.NewVideoStatusEvent(video, playbackURLs.AssetURLs(video, video.PosterFile())[0])
+		if err != nil {
+			return nil, err
+		}
+		return []domain.OutboxEvent{event}, nil
+	}
+}
+
 func getContentType(filename string) string {
 	ext := filepath.Ext(filename)
 	switch ext {
@@ -176,20 +349,26 @@ func getContentType(filename string) string {
 		return "application/vnd.apple.mpegurl"
 	case ".ts":
 		return "video/MP2T"
+	case ".jpg":
+		return "image/jpeg"
+	case ".mp4":
+		return "video/mp4"
 	default:
 		return "application/octet-stream"
 	}
 }
 
 // 在消息消費端（例如 RabbitMQ 消費端）的某個函式中：
-func consumeTranscodingMessage(ctx context.Context, message []byte, mClient database.MinIOClientRepo, videoRepo repository.VideoRepo) {
+func consumeTranscodingMessage(ctx context.Context, message []byte, mClient database.MinIOClientRepo,
+	videoRepo repository.VideoRepo, watermarkRepo repository.WatermarkRepo, thumbnailRepo repository.ThumbnailRepo,
+	playbackURLs *domain.PlaybackURLBuilder) {
 	var job domain.TranscodingJob
 	if err := json.Unmarshal(message, &job); err != nil {
 		log.Printf("解析轉碼工作訊息失敗: %v", err)
 		return
 	}
 
-	if err := processTranscodingJob(ctx, job, mClient, videoRepo); err != nil {
+	if err := processTranscodingJob(ctx, job, mClient, videoRepo, watermarkRepo, thumbnailRepo, playbackURLs); err != nil {
 		log.Printf("處理轉碼工作失敗: %v", err)
 		// 根據需求，你可以選擇重試此消息或記錄錯誤
 	} else {
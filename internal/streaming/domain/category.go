@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTagsPerVideo 每部影片最多標籤數
+	MaxTagsPerVideo = 15
+	// MaxTagLength 單一標籤最大字元數
+	MaxTagLength = 32
+
+	// DefaultPageSize 未指定 page_size 時的預設筆數
+	DefaultPageSize = 20
+	// MaxPageSize page_size 上限
+	MaxPageSize = 100
+)
+
+// Category 影片分類，由 migration 預先建立
+type Category struct {
+	ID   uint   `gorm:"primaryKey"`
+	Slug string `gorm:"type:varchar(64);uniqueIndex"` // 英文代稱，例如 "music"
+	Name string `gorm:"type:varchar(64)"`             // 顯示名稱
+}
+
+// Tag 自由標籤，名稱統一小寫
+type Tag struct {
+	ID   uint   `gorm:"primaryKey"`
+	Name string `gorm:"type:varchar(64);uniqueIndex"`
+}
+
+// VideoTag 影片與標籤的多對多關聯
+type VideoTag struct {
+	VideoID uint `gorm:"primaryKey"`
+	TagID   uint `gorm:"primaryKey;index"`
+}
+
+// SearchFilter 搜尋條件，Tag / CategoryID 為空值時不過濾
+type SearchFilter struct {
+	Keyword    string
+	Tag        string
+	CategoryID uint
+	MaxRating  ContentRating // 觀看者可觀看的最高分級，空值僅列出普遍級
+}
+
+// RecommendFilter 推薦條件，Tags 不為空時優先推薦含相同標籤的影片
+type RecommendFilter struct {
+	Limit     int
+	Tags      []string
+	MaxRating ContentRating // 觀看者可觀看的最高分級，空值僅列出普遍級
+}
+
+// Pagination 分頁參數，Page 從 1 開始
+type Pagination struct {
+	Page     int
+	PageSize int
+}
+
+// Normalize 修正不合法的分頁參數
+func (p Pagination) Normalize() Pagination {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+	return p
+}
+
+// Offset 取得資料庫查詢的 offset
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// NormalizeTag 標籤統一格式：去除前後空白與 "#"，轉小寫，超過長度則截斷
+func NormalizeTag(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(tag), "#")))
+	if utf8.RuneCountInString(tag) > MaxTagLength {
+		tag = string([]rune(tag)[:MaxTagLength])
+	}
+	return tag
+}
+
+// NormalizeTags 標籤統一格式、去除空值與重複，最多保留 MaxTagsPerVideo 個
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+		if len(res) == MaxTagsPerVideo {
+			break
+		}
+	}
+	return res
+}
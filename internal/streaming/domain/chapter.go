@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxChapters 每部影片的章節上限
+	MaxChapters = 100
+	// MaxChapterTitleLength 章節標題長度上限（字元）
+	MaxChapterTitleLength = 100
+	// MinChapters 說明欄至少要有幾行時間標記才視為章節
+	MinChapters = 2
+)
+
+// chapterEpoch HLS 以 EXT-X-PROGRAM-DATE-TIME 對應 EXT-X-DATERANGE 的時間，影片開頭固定對應此時間
+var chapterEpoch = time.Unix(0, 0).UTC()
+
+// Chapter 章節，Start 為距離影片開頭的時間
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// VideoChapter 上傳者設定的章節，未設定時由說明欄解析
+type VideoChapter struct {
+	VideoID uint   `gorm:"primaryKey"`
+	Seq     int    `gorm:"primaryKey"` // 章節順序，從 0 開始
+	StartMs int64  // 距離影片開頭的毫秒數
+	Title   string `gorm:"type:varchar(100)"`
+}
+
+// ValidateChapters 第一個章節需從 00:00 開始，之後依時間遞增且標題不可空白
+// 標題會寫入 m3u8 與 WebVTT，不可包含換行等控制字元或 WebVTT 的時間分隔符號 "-->"
+func ValidateChapters(chapters []Chapter) error {
+	if len(chapters) > MaxChapters {
+		return fmt.Errorf("章節數量不可超過 %d", MaxChapters)
+	}
+	for index, chapter := range chapters {
+		title := strings.TrimSpace(chapter.Title)
+		if title == "" || utf8.RuneCountInString(title) > MaxChapterTitleLength {
+			return fmt.Errorf("第 %d 個章節標題需為 1 到 %d 個字", index+1, MaxChapterTitleLength)
+		}
+		if strings.ContainsFunc(chapter.Title, unicode.IsControl) || strings.Contains(chapter.Title, "-->") {
+			return fmt.Errorf("第 %d 個章節標題不可包含控制字元或 -->", index+1)
+		}
+		if index == 0 && chapter.Start != 0 {
+			return errors.New("第一個章節需從 00:00 開始")
+		}
+		if index > 0 && chapter.Start <= chapters[index-1].Start {
+			return fmt.Errorf("第 %d 個章節的開始時間需晚於前一個章節", index+1)
+		}
+	}
+	return nil
+}
+
+// chapterLine 說明欄中的時間標記，例如 "00:00 Intro"、"1:02:03 - Outro"
+var chapterLine = regexp.MustCompile(`^\s*(?:(\d{1,2}):)?(\d{1,2}):(\d{2})\s*[-–:|]?\s+(.+?)\s*$`)
+
+// ParseChapters 由說明欄解析章節，每行一個時間標記
+// 時間標記少於 MinChapters 行或不符合 ValidateChapters 時視為沒有章節
+func ParseChapters(description string) []Chapter {
+	var chapters []Chapter
+	for _, line := range strings.Split(description, "\n") {
+		match := chapterLine.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		hours, _ := strconv.Atoi(match[1])
+		minutes, _ := strconv.Atoi(match[2])
+		seconds, _ := strconv.Atoi(match[3])
+		if seconds >= 60 || (match[1] != "" && minutes >= 60) {
+			continue
+		}
+		chapters = append(chapters, Chapter{
+			Start: time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second,
+			Title: match[4],
+		})
+	}
+	if len(chapters) < MinChapters || ValidateChapters(chapters) != nil {
+		return nil
+	}
+	return chapters
+}
+
+// chapterEnd 章節結束時間：下一個章節的開始，最後一個章節為影片長度（未知時回傳 0）
+func chapterEnd(chapters []Chapter, index int, duration time.Duration) time.Duration {
+	if index+1 < len(chapters) {
+		return chapters[index+1].Start
+	}
+	if duration > chapters[index].Start {
+		return duration
+	}
+	return 0
+}
+
+// ChaptersVTT 產生 WebVTT chapters track，供 <track kind="chapters"> 使用
+func ChaptersVTT(chapters []Chapter, duration time.Duration) []byte {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for index, chapter := range chapters {
+		end := chapterEnd(chapters, index, duration)
+		if end == 0 {
+			// 影片長度未知時，最後一個章節以一秒結束，避免產生無效的 cue
+			end = chapter.Start + time.Second
+		}
+		title := strings.ReplaceAll(cleanChapterTitle(chapter.Title), "-->", "->")
+		fmt.Fprintf(&b, "\n%d\n%s --> %s\n%s\n", index+1, vttTimestamp(chapter.Start), vttTimestamp(end), title)
+	}
+	return []byte(b.String())
+}
+
+// cleanChapterTitle 將控制字元換成空白，避免 ValidateChapters 加入檢查前存下的標題破壞輸出格式
+func cleanChapterTitle(title string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, title)
+}
+
+func vttTimestamp(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// PlaylistDuration 加總 media playlist 中各分段的 #EXTINF 長度
+func PlaylistDuration(playlist []byte) time.Duration {
+	var total time.Duration
+	for _, segment := range ParsePlaylistSegments(playlist) {
+		total += segment.Duration
+	}
+	return total
+}
+
+// AddChapterDateRanges 在 media playlist 的第一個分段前加入 EXT-X-PROGRAM-DATE-TIME 與每個章節的 EXT-X-DATERANGE
+// 影片開頭對應 1970-01-01T00:00:00Z，播放器可依此換算章節時間；沒有分段（例如 master playlist）時原樣回傳
+func AddChapterDateRanges(playlist []byte, chapters []Chapter) []byte {
+	if len(chapters) == 0 {
+		return playlist
+	}
+	lines := strings.Split(string(playlist), "\n")
+	first := -1
+	for index, line := range lines {
+		if strings.HasPrefix(line, "#EXTINF:") {
+			first = index
+			break
+		}
+		if strings.HasPrefix(line, "#EXT-X-PROGRAM-DATE-TIME:") {
+			return playlist
+		}
+	}
+	if first < 0 {
+		return playlist
+	}
+
+	duration := PlaylistDuration(playlist)
+	tags := []string{"#EXT-X-PROGRAM-DATE-TIME:" + hlsDate(0)}
+	for index, chapter := range chapters {
+		tag := fmt.Sprintf(`#EXT-X-DATERANGE:ID="chapter-%d",CLASS="chapter",START-DATE="%s"`, index+1, hlsDate(chapter.Start))
+		if end := chapterEnd(chapters, index, duration); end > 0 {
+			tag += fmt.Sprintf(",DURATION=%.3f", (end - chapter.Start).Seconds())
+		}
+		tag += fmt.Sprintf(`,X-TITLE="%s"`, strings.ReplaceAll(cleanChapterTitle(chapter.Title), `"`, "'"))
+		tags = append(tags, tag)
+	}
+
+	out := make([]string, 0, len(lines)+len(tags))
+	out = append(out, lines[:first]...)
+	out = append(out, tags...)
+	out = append(out, lines[first:]...)
+	return []byte(strings.Join(out, "\n"))
+}
+
+func hlsDate(offset time.Duration) string {
+	return chapterEpoch.Add(offset).Format("2006-01-02T15:04:05.000Z")
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MinClipLength 片段最短長度
+	MinClipLength = 15 * time.Second
+	// MaxClipLength 片段最長長度
+	MaxClipLength = 60 * time.Second
+	// clipAlignTolerance 剪輯點與分段邊界相差在此範圍內視為對齊關鍵影格
+	clipAlignTolerance = 50 * time.Millisecond
+)
+
+// ClipJob 剪輯工作：由來源影片轉碼後的 HLS 分段剪出 [Start, End) 的片段
+type ClipJob struct {
+	SourceVideoID uint  `json:"source_video_id"`
+	StartMs       int64 `json:"start_ms"`
+	EndMs         int64 `json:"end_ms"`
+}
+
+// CreateClipReq usecase create clip request
+type CreateClipReq struct {
+	SourceVideoID string
+	Viewer        Viewer // 剪輯者，需可觀看來源影片
+	Title         string // 空值時使用來源影片標題
+	Description   string
+	Start         time.Duration
+	End           time.Duration
+}
+
+// ValidateClipRange 片段需在影片長度內，且長度介於 MinClipLength 與 MaxClipLength
+func ValidateClipRange(start, end, duration time.Duration) error {
+	if start < 0 || end <= start {
+		return fmt.Errorf("片段時間錯誤: %s - %s", start, end)
+	}
+	if length := end - start; length < MinClipLength || length > MaxClipLength {
+		return fmt.Errorf("片段長度需介於 %s 到 %s", MinClipLength, MaxClipLength)
+	}
+	if duration > 0 && end > duration+clipAlignTolerance {
+		return fmt.Errorf("片段結束時間超過影片長度 %s", duration)
+	}
+	return nil
+}
+
+// PlaylistSegment media playlist 中的一個分段
+type PlaylistSegment struct {
+	URI      string
+	Start    time.Duration
+	Duration time.Duration
+}
+
+// ParsePlaylistSegments 依序解析 media playlist 的分段與其開始時間
+func ParsePlaylistSegments(playlist []byte) []PlaylistSegment {
+	var segments []PlaylistSegment
+	var start, pending time.Duration
+	hasInf := false
+	for _, line := range strings.Split(string(playlist), "\n") {
+		line = strings.TrimSpace(line)
+		if value, ok := strings.CutPrefix(line, "#EXTINF:"); ok {
+			value, _, _ = strings.Cut(value, ",")
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			pending = time.Duration(math.Round(seconds * float64(time.Second)))
+			hasInf = true
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") || !hasInf {
+			continue
+		}
+		segments = append(segments, PlaylistSegment{URI: line, Start: start, Duration: pending})
+		start += pending
+		hasInf = false
+	}
+	return segments
+}
+
+// ClipPlan 剪輯計畫
+//   - Segments：與片段重疊的分段
+//   - Offset：片段開始時間距離第一個分段開頭的時間
+//   - Aligned：剪輯點都落在分段邊界（分段皆以關鍵影格開頭），可直接 stream copy 不需重新編碼
+type ClipPlan struct {
+	Segments []PlaylistSegment
+	Offset   time.Duration
+	Duration time.Duration
+	Aligned  bool
+}
+
+// PlanClip 依分段決定剪輯 [start, end) 需要哪些分段，以及是否能 stream copy
+func PlanClip(segments []PlaylistSegment, start, end time.Duration) ClipPlan {
+	plan := ClipPlan{Duration: end - start}
+	for _, segment := range segments {
+		segmentEnd := segment.Start + segment.Duration
+		if segmentEnd <= start || segment.Start >= end {
+			continue
+		}
+		plan.Segments = append(plan.Segments, segment)
+	}
+	if len(plan.Segments) == 0 {
+		return plan
+	}
+	first, last := plan.Segments[0], plan.Segments[len(plan.Segments)-1]
+	plan.Offset = start - first.Start
+	lastEnd := last.Start + last.Duration
+	plan.Aligned = plan.Offset <= clipAlignTolerance && absDuration(lastEnd-end) <= clipAlignTolerance
+	return plan
+}
+
+// BuildPlaylist 以分段組出 VOD media playlist
+func BuildPlaylist(segments []PlaylistSegment) []byte {
+	var target float64
+	for _, segment := range segments {
+		target = math.Max(target, math.Ceil(segment.Duration.Seconds()))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:%d\n#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n", int(target))
+	for _, segment := range segments {
+		fmt.Fprintf(&b, "#EXTINF:%.6f,\n%s\n", segment.Duration.Seconds(), segment.URI)
+	}
+	b.WriteString("#EXT-X-ENDLIST\n")
+	return []byte(b.String())
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// CommentSort 留言排序方式
+type CommentSort string
+
+const (
+	//CommentSortTop 熱門：回覆數多的在前
+	CommentSortTop CommentSort = "top"
+	//CommentSortNewest 最新：新留言在前
+	CommentSortNewest CommentSort = "newest"
+)
+
+const (
+	// MaxCommentLength 留言內容上限（字元數）
+	MaxCommentLength = 2000
+	// NotifyComment 通知影片上傳者有新留言的 websocket action
+	NotifyComment = "notify_comment"
+)
+
+// IsValid check comment sort is defined
+func (s CommentSort) IsValid() bool {
+	return s == CommentSortTop || s == CommentSortNewest
+}
+
+// Comment 影片留言，存於 MongoDB video_comments
+// 回覆只有一層：ParentID 為空是頂層留言，回覆別人的回覆時掛在同一則頂層留言下
+type Comment struct {
+	ID         string     `bson:"_id" json:"comment_id"` // ObjectID hex，依建立時間遞增
+	VideoID    uint       `bson:"video_id" json:"video_id"`
+	MemberID   string     `bson:"member_id" json:"member_id"`
+	ParentID   string     `bson:"parent_id" json:"parent_id,omitempty"`
+	Content    string     `bson:"content" json:"content"`
+	ReplyCount int64      `bson:"reply_count" json:"reply_count"`
+	Pinned     bool       `bson:"pinned" json:"pinned"`
+	Deleted    bool       `bson:"deleted" json:"deleted"` // 有回覆的留言刪除時只清除內容，保留討論串
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	EditedAt   *time.Time `bson:"edited_at,omitempty" json:"edited_at,omitempty"`
+}
+
+// IsOwner check memberID is comment author
+func (c *Comment) IsOwner(memberID string) bool {
+	return memberID != "" && c.MemberID == memberID
+}
+
+// CommentCursor 留言分頁游標，記錄上一頁最後一筆的排序欄位
+type CommentCursor struct {
+	ReplyCount int64  `json:"r,omitempty"`
+	CreatedAt  int64  `json:"t"` // unix 毫秒
+	ID         string `json:"id"`
+}
+
+// CommentQuery 列出留言條件
+type CommentQuery struct {
+	VideoID  uint
+	ParentID string      // 空值列出頂層留言，否則列出該留言的回覆（依時間先後）
+	Sort     CommentSort // 僅用於頂層留言
+	Cursor   *CommentCursor
+	Limit    int
+}
+
+// CommentPage 一頁留言
+type CommentPage struct {
+	Comments   []Comment
+	Pinned     *Comment // 僅頂層留言的第一頁帶回置頂留言
+	NextCursor string   // 空值表示沒有下一頁
+}
+
+// Encode 游標轉為 URL 可用的字串
+func (c CommentCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorOf 以留言建立下一頁的游標
+func CursorOf(comment Comment) CommentCursor {
+	return CommentCursor{
+		ReplyCount: comment.ReplyCount,
+		CreatedAt:  comment.CreatedAt.UnixMilli(),
+		ID:         comment.ID,
+	}
+}
+
+// DecodeCommentCursor 解析游標，空字串回傳 nil
+func DecodeCommentCursor(cursor string) (*CommentCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var c CommentCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DownloadTopic 下載檔案產生工作的佇列 topic，由 DownloadWorker 消費
+	DownloadTopic = "streaming.download"
+	// DownloadJobEvent 下載檔案產生工作的訊息類型
+	DownloadJobEvent = "download.requested"
+	// DownloadPrefix 下載用 MP4 的 object key 前綴，與轉碼後的 HLS 分開存放
+	DownloadPrefix = "downloads/"
+	// DownloadLinkTTL 下載連結的有效期限
+	DownloadLinkTTL = 10 * time.Minute
+	// DownloadDailyQuota 每位會員在 DownloadQuotaWindow 內可取得的下載連結數
+	DownloadDailyQuota = 10
+	// DownloadQuotaWindow 下載次數的計算區間
+	DownloadQuotaWindow = 24 * time.Hour
+	// DownloadStaleAfter 產生中的下載檔案超過此時間仍未完成時，視為工作遺失並重新發布
+	DownloadStaleAfter = 30 * time.Minute
+	// DownloadRetryAfter 檔案產生中時，建議客戶端多久後再請求
+	DownloadRetryAfter = 10 * time.Second
+)
+
+// ErrQuotaExceeded 已達使用上限，gateway 回傳 429
+var ErrQuotaExceeded = errors.New("已達使用上限")
+
+// DownloadQuality 下載檔案的畫質，以高度表示，來源解析度較低時不放大
+type DownloadQuality string
+
+const (
+	Download1080p DownloadQuality = "1080p"
+	Download720p  DownloadQuality = "720p"
+	Download480p  DownloadQuality = "480p"
+	Download360p  DownloadQuality = "360p"
+	// DefaultDownloadQuality 未指定時的畫質
+	DefaultDownloadQuality = Download720p
+)
+
+// Height 畫質對應的影片高度，不支援的畫質為 0
+func (q DownloadQuality) Height() int {
+	switch q {
+	case Download1080p:
+		return 1080
+	case Download720p:
+		return 720
+	case Download480p:
+		return 480
+	case Download360p:
+		return 360
+	}
+	return 0
+}
+
+// IsValid check quality is supported
+func (q DownloadQuality) IsValid() bool {
+	return q.Height() > 0
+}
+
+// DownloadObjectKey 下載用 MP4 的 object key，例如 downloads/5/720p.mp4
+func DownloadObjectKey(videoID uint, quality DownloadQuality) string {
+	return fmt.Sprintf("%s%d/%s.mp4", DownloadPrefix, videoID, quality)
+}
+
+// DownloadStatus 下載檔案的狀態
+type DownloadStatus string
+
+const (
+	DownloadPreparing DownloadStatus = "preparing" // 已發布工作，DownloadWorker 產生中
+	DownloadReady     DownloadStatus = "ready"     // 已快取在 MinIO，可產生下載連結
+	DownloadFailed    DownloadStatus = "failed"    // 產生失敗，下次請求時重新發布工作
+)
+
+// VideoDownload 影片某個畫質的下載檔案（faststart MP4），產生後快取在 MinIO 的 ObjectKey
+type VideoDownload struct {
+	VideoID       uint   `gorm:"primaryKey"`
+	Quality       string `gorm:"type:varchar(10);primaryKey"`
+	Status        string `gorm:"type:varchar(20)"` // "preparing", "ready", "failed"
+	ObjectKey     string
+	SizeBytes     int64
+	SourceReadyAt *time.Time // 產生檔案時影片的 ReadyAt，用來判斷影片是否已重新轉碼
+	UpdatedAt     time.Time
+}
+
+// IsStale 產生中超過 DownloadStaleAfter，工作可能已遺失
+func (d *VideoDownload) IsStale(now time.Time) bool {
+	return d.Status == string(DownloadPreparing) && d.UpdatedAt.Before(now.Add(-DownloadStaleAfter))
+}
+
+// BuiltFrom 檔案是否由影片目前的轉碼結果產生；重新轉碼後 ReadyAt 改變，快取的檔案需重新產生
+func (d *VideoDownload) BuiltFrom(video *Video) bool {
+	if d.SourceReadyAt == nil || video.ReadyAt == nil {
+		return d.SourceReadyAt == nil && video.ReadyAt == nil
+	}
+	return d.SourceReadyAt.Equal(*video.ReadyAt)
+}
+
+// DownloadGrant 發給會員的下載連結，用來計算下載次數
+type DownloadGrant struct {
+	ID        uint   `gorm:"primaryKey"`
+	MemberID  string `gorm:"type:varchar(64);index"`
+	VideoID   uint
+	Quality   string    `gorm:"type:varchar(10)"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// DownloadJob DownloadTopic 的訊息內容
+type DownloadJob struct {
+	VideoID uint            `json:"video_id"`
+	Quality DownloadQuality `json:"quality"`
+}
+
+// DownloadJobEvents 建立下載工作事件，供 DownloadRepo.SaveWithEvents 使用
+func DownloadJobEvents(videoID uint, quality DownloadQuality) ([]OutboxEvent, error) {
+	event, err := NewOutboxEvent(videoID, DownloadJobEvent, DownloadTopic, DownloadJob{VideoID: videoID, Quality: quality})
+	if err != nil {
+		return nil, err
+	}
+	return []OutboxEvent{event}, nil
+}
+
+// RequestDownloadReq usecase request download request
+type RequestDownloadReq struct {
+	VideoID string
+	Viewer  Viewer
+	Quality DownloadQuality // 空值為 DefaultDownloadQuality
+}
+
+// DownloadLink 下載請求的結果；Status 為 preparing 時檔案產生中，客戶端稍後再請求
+type DownloadLink struct {
+	Status         DownloadStatus
+	URL            string // 帶簽章的短效連結，Status 為 ready 時才有
+	ExpiresAt      time.Time
+	SizeBytes      int64
+	QuotaRemaining int // 取得此連結後，計算區間內剩餘的下載次數
+}
+
+// SetDownloadEnabledReq usecase set download enabled request
+type SetDownloadEnabledReq struct {
+	VideoID  string
+	MemberID string
+	Enabled  bool
+}
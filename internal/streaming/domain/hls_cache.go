@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// CachedObjectKind 快取物件的種類，播放清單與分段使用不同的 TTL
+type CachedObjectKind string
+
+const (
+	// CachedPlaylist m3u8 播放清單
+	CachedPlaylist CachedObjectKind = "playlist"
+	// CachedSegment TS 分段、封面等其他 HLS 檔案
+	CachedSegment CachedObjectKind = "segment"
+)
+
+// HotObjectCacheOptions 熱門 HLS 物件快取設定
+type HotObjectCacheOptions struct {
+	MaxBytes       int64 // 本機 LRU 的容量上限
+	MaxObjectBytes int64 // 超過此大小的物件不快取
+	PlaylistTTL    time.Duration
+	SegmentTTL     time.Duration
+}
+
+// CacheCounters 某種物件的命中次數
+type CacheCounters struct {
+	LocalHits  int64 `json:"local_hits"`  // 本機 LRU 命中
+	SharedHits int64 `json:"shared_hits"` // 本機未命中、Redis 命中
+	Misses     int64 `json:"misses"`      // 由 MinIO 讀取
+	Coalesced  int64 `json:"coalesced"`   // 與同時未命中的呼叫端共用同一次讀取
+}
+
+// CacheStats 熱門 HLS 物件快取的統計
+type CacheStats struct {
+	Playlist  CacheCounters `json:"playlist"`
+	Segment   CacheCounters `json:"segment"`
+	Entries   int           `json:"entries"`
+	Bytes     int64         `json:"bytes"`
+	MaxBytes  int64         `json:"max_bytes"`
+	Evictions int64         `json:"evictions"`
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ArchivePrefix 封存的物件移到此 prefix 下，key 保留原本的路徑，例如 archive/processed/{videoID}/index.m3u8
+	// MinIO 可對此 prefix 設定 lifecycle transition，轉移到較便宜的 tier
+	ArchivePrefix = "archive/"
+	// OriginalPrefix 上傳的原始檔
+	OriginalPrefix = "original/"
+	// ProcessedPrefix 轉碼後的 HLS 檔案與封面，不可公開讀取
+	ProcessedPrefix = "processed/"
+	// PublicPrefix 可公開提供的影片轉碼後檔案的副本，bucket 只對此 prefix 開放 public read，CDN 以此為 origin
+	// 影片改為 private、隱藏、分級限制、下架或移到冷儲存時刪除副本
+	PublicPrefix = "public/"
+
+	// StorageLifecycleLockKey StorageLifecycleJob 的 leader lock
+	StorageLifecycleLockKey = "streaming:lock:storage_lifecycle"
+	// OrphanGCLockKey OrphanGC 的 leader lock
+	OrphanGCLockKey = "streaming:lock:orphan_gc"
+)
+
+// OriginalState 原始檔的狀態
+type OriginalState string
+
+const (
+	// OriginalStored 原始檔仍在 original/{videoID}/
+	OriginalStored OriginalState = "stored"
+	// OriginalArchived 原始檔已移到 archive/original/{videoID}/
+	OriginalArchived OriginalState = "archived"
+	// OriginalDeleted 原始檔已刪除
+	OriginalDeleted OriginalState = "deleted"
+)
+
+// OriginalAction ready 後原始檔的處理方式
+type OriginalAction string
+
+const (
+	// OriginalKeep 保留原始檔
+	OriginalKeep OriginalAction = "keep"
+	// OriginalDelete 刪除原始檔
+	OriginalDelete OriginalAction = "delete"
+	// OriginalArchive 將原始檔移到 ArchivePrefix
+	OriginalArchive OriginalAction = "archive"
+)
+
+// IsValid check original action is defined
+func (a OriginalAction) IsValid() bool {
+	switch a {
+	case OriginalKeep, OriginalDelete, OriginalArchive:
+		return true
+	}
+	return false
+}
+
+// StorageTier 轉碼後檔案的儲存層級
+type StorageTier string
+
+const (
+	// StorageHot 轉碼後檔案在 processed/{videoID}/
+	StorageHot StorageTier = "hot"
+	// StorageCold 長期沒有瀏覽，轉碼後檔案已移到 archive/processed/{videoID}/
+	StorageCold StorageTier = "cold"
+)
+
+// LifecycleRules 儲存生命週期規則，After 為 0 表示停用該規則
+type LifecycleRules struct {
+	OriginalAction OriginalAction
+	OriginalAfter  time.Duration // ready 超過此時間後處理原始檔
+	ColdAfter      time.Duration // ready 且超過此時間沒有瀏覽的影片移到 ArchivePrefix
+}
+
+// LifecycleResult StorageLifecycleJob 一次執行的結果
+type LifecycleResult struct {
+	OriginalsDeleted  int
+	OriginalsArchived int
+	MovedToCold       int
+}
+
+// OrphanObject 找不到對應 videos 記錄的物件
+type OrphanObject struct {
+	Key          string
+	VideoID      uint
+	Size         int64
+	LastModified time.Time
+}
+
+// OrphanReport OrphanGC 一次執行的報告，DryRun 時只列出不刪除
+type OrphanReport struct {
+	DryRun  bool
+	Scanned int
+	Orphans []OrphanObject
+	Deleted int
+	Bytes   int64 // 孤兒物件的總大小
+}
+
+// ArchivedKey 物件封存後的 key
+func ArchivedKey(key string) string {
+	return ArchivePrefix + key
+}
+
+// VideoIDFromKey 由 original/{videoID}/...、processed/{videoID}/...、public/{videoID}/...、downloads/{videoID}/... 與其封存的 key 解析 videoID
+func VideoIDFromKey(key string) (uint, bool) {
+	key = strings.TrimPrefix(key, ArchivePrefix)
+	for _, prefix := range []string{OriginalPrefix, ProcessedPrefix, PublicPrefix, DownloadPrefix} {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(key, prefix), "/", 2)
+		id, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil || len(parts) != 2 || id == 0 {
+			return 0, false
+		}
+		return uint(id), true
+	}
+	return 0, false
+}
+
+// VideoObjectPrefixes OrphanGC 掃描的 prefix，key 中都帶有 videoID
+func VideoObjectPrefixes() []string {
+	return []string{OriginalPrefix, ProcessedPrefix, PublicPrefix, ArchivePrefix + OriginalPrefix, ArchivePrefix + ProcessedPrefix, DownloadPrefix}
+}
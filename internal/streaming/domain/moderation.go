@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ReportClaimTimeout 管理員認領檢舉後未處理超過此時間，其他管理員可重新認領
+	ReportClaimTimeout = 30 * time.Minute
+	// maxReportDetailLength 檢舉說明長度上限（字元）
+	maxReportDetailLength = 1000
+	// maxModerationNoteLength 處理備註長度上限（字元）
+	maxModerationNoteLength = 1000
+)
+
+// ReportReason 檢舉原因代碼
+type ReportReason string
+
+const (
+	ReportSpam           ReportReason = "spam"           // 垃圾訊息、詐騙
+	ReportHarassment     ReportReason = "harassment"     // 騷擾、霸凌
+	ReportHate           ReportReason = "hate"           // 仇恨言論
+	ReportViolence       ReportReason = "violence"       // 暴力、血腥
+	ReportSexual         ReportReason = "sexual"         // 色情內容
+	ReportChildSafety    ReportReason = "child_safety"   // 危害兒童
+	ReportCopyright      ReportReason = "copyright"      // 侵害著作權
+	ReportMisinformation ReportReason = "misinformation" // 不實資訊
+	ReportOther          ReportReason = "other"          // 其他，需附說明
+)
+
+// IsValid check report reason is defined
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportSpam, ReportHarassment, ReportHate, ReportViolence, ReportSexual,
+		ReportChildSafety, ReportCopyright, ReportMisinformation, ReportOther:
+		return true
+	}
+	return false
+}
+
+// ReportStatus 檢舉在審核佇列中的狀態
+type ReportStatus string
+
+const (
+	ReportOpen     ReportStatus = "open"     // 等待認領
+	ReportClaimed  ReportStatus = "claimed"  // 管理員處理中
+	ReportResolved ReportStatus = "resolved" // 已處理
+)
+
+// IsValid check report status is defined
+func (s ReportStatus) IsValid() bool {
+	switch s {
+	case ReportOpen, ReportClaimed, ReportResolved:
+		return true
+	}
+	return false
+}
+
+// ModerationAction 管理員處理檢舉的動作
+type ModerationAction string
+
+const (
+	ModerationDismiss     ModerationAction = "dismiss"      // 駁回，不變更影片
+	ModerationAgeRestrict ModerationAction = "age_restrict" // 年齡限制，分級改為限制級並鎖定
+	ModerationHide        ModerationAction = "hide"         // 隱藏，不出現在任何列表，僅上傳者可觀看
+	ModerationRemove      ModerationAction = "remove"       // 下架，影片改為 blocked，任何人都無法播放
+	// ModerationClaim 認領檢舉，只記錄在稽核紀錄
+	ModerationClaim ModerationAction = "claim"
+	// ModerationRate 管理員直接覆寫影片分級，只記錄在稽核紀錄
+	ModerationRate ModerationAction = "rate"
+)
+
+// IsValid check action can resolve a report
+func (a ModerationAction) IsValid() bool {
+	switch a {
+	case ModerationDismiss, ModerationAgeRestrict, ModerationHide, ModerationRemove:
+		return true
+	}
+	return false
+}
+
+// Apply 將處理動作套用到影片，回傳是否有變更
+func (a ModerationAction) Apply(video *Video) bool {
+	switch a {
+	case ModerationAgeRestrict:
+		if video.Rating() == RatingAdult && video.RatingLocked {
+			return false
+		}
+		video.ContentRating = string(RatingAdult)
+		video.RatingLocked = true
+	case ModerationHide:
+		if video.Hidden {
+			return false
+		}
+		video.Hidden = true
+	case ModerationRemove:
+		if video.IsBlocked() {
+			return false
+		}
+		video.Status = string(VideoBlocked)
+	default:
+		return false
+	}
+	return true
+}
+
+// VideoReport 會員對影片的檢舉，同一會員對同一部影片同時只能有一筆未處理的檢舉
+type VideoReport struct {
+	ID         uint   `gorm:"primaryKey"`
+	VideoID    uint   `gorm:"not null;index"`
+	ReporterID string `gorm:"type:varchar(64);not null;index"`
+	Reason     string `gorm:"type:varchar(32);not null"`
+	Detail     string `gorm:"type:text"`
+	Status     string `gorm:"type:varchar(20);not null;default:open;index"`
+	ClaimedBy  string `gorm:"type:varchar(64)"` // 認領的管理員
+	ClaimedAt  *time.Time
+	Resolution string `gorm:"type:varchar(20)"` // 處理動作
+	ResolvedBy string `gorm:"type:varchar(64)"`
+	ResolvedAt *time.Time
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+// TableName 指定資料表名稱
+func (VideoReport) TableName() string {
+	return "video_reports"
+}
+
+// IsPending 尚未處理（open 或 claimed）
+func (r *VideoReport) IsPending() bool {
+	return r.Status == string(ReportOpen) || r.Status == string(ReportClaimed)
+}
+
+// ModerationAudit 審核稽核紀錄，每次認領與處理各一筆，只新增不修改
+type ModerationAudit struct {
+	ID        uint      `gorm:"primaryKey"`
+	ReportID  uint      `gorm:"not null;index"`
+	VideoID   uint      `gorm:"not null;index"`
+	ActorID   string    `gorm:"type:varchar(64);not null"` // 執行動作的管理員
+	Action    string    `gorm:"type:varchar(20);not null"`
+	Note      string    `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// TableName 指定資料表名稱
+func (ModerationAudit) TableName() string {
+	return "moderation_audits"
+}
+
+// ReportVideoReq usecase report video request
+type ReportVideoReq struct {
+	VideoID  string
+	MemberID string
+	Reason   ReportReason
+	Detail   string
+}
+
+// Validate 檢查檢舉原因與說明
+func (r ReportVideoReq) Validate() error {
+	if !r.Reason.IsValid() {
+		return fmt.Errorf("不支援的檢舉原因: %s", r.Reason)
+	}
+	if r.Reason == ReportOther && r.Detail == "" {
+		return fmt.Errorf("檢舉原因為 other 時需附說明")
+	}
+	if len([]rune(r.Detail)) > maxReportDetailLength {
+		return fmt.Errorf("檢舉說明超過 %d 字", maxReportDetailLength)
+	}
+	return nil
+}
+
+// ListReportsReq usecase list reports request，Status 空值為 open
+type ListReportsReq struct {
+	Role    string
+	Status  ReportStatus
+	VideoID uint // 0 表示不限影片
+	Page    Pagination
+}
+
+// ClaimReportReq usecase claim report request
+type ClaimReportReq struct {
+	ReportID uint
+	MemberID string
+	Role     string
+}
+
+// ResolveReportReq usecase resolve report request
+type ResolveReportReq struct {
+	ReportID uint
+	MemberID string
+	Role     string
+	Action   ModerationAction
+	Note     string
+}
+
+// Validate 檢查處理動作與備註
+func (r ResolveReportReq) Validate() error {
+	if !r.Action.IsValid() {
+		return fmt.Errorf("不支援的處理動作: %s", r.Action)
+	}
+	if len([]rune(r.Note)) > maxModerationNoteLength {
+		return fmt.Errorf("處理備註超過 %d 字", maxModerationNoteLength)
+	}
+	return nil
+}
+
+// ListModerationAuditReq usecase list moderation audit request，VideoID 與 ReportID 至少帶一個
+type ListModerationAuditReq struct {
+	Role     string
+	VideoID  uint
+	ReportID uint
+	Page     Pagination
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxStatus 外送事件狀態
+type OutboxStatus string
+
+const (
+	// OutboxPending 已寫入資料庫，等待 OutboxRelay 發布
+	OutboxPending OutboxStatus = "pending"
+	// OutboxSent 已發布且 broker 已確認
+	OutboxSent OutboxStatus = "sent"
+	// OutboxDead 發布失敗達 OutboxMaxAttempts 次，不再重試，保留在資料表供人工排查
+	OutboxDead OutboxStatus = "dead"
+)
+
+const (
+	// EventTranscodeRequested 影片需要轉碼，發布到 QueueName 由 Worker 處理
+	EventTranscodeRequested = "video.transcode_requested"
+	// EventVideoReady 影片轉碼完成，發布到 VideoStatusTopic
+	EventVideoReady = "video.ready"
+	// EventVideoFailed 影片轉碼失敗，發布到 VideoStatusTopic
+	EventVideoFailed = "video.failed"
+	// VideoStatusTopic 影片狀態事件的 topic，由 chat_service 消費並通知上傳者
+	VideoStatusTopic = "video.status"
+	// EventCommentCreated 影片有新留言，發布到 NotificationTopic 通知上傳者
+	EventCommentCreated = "comment.created"
+	// NotificationTopic 推播給會員的通知事件，由 chat_service 消費並轉送到會員的 websocket
+	NotificationTopic = "member.notification"
+	// OutboxRelayLockKey OutboxRelay 的 leader lock，同一時間只有一個 replica 發布，維持事件順序
+	OutboxRelayLockKey = "streaming:lock:outbox_relay"
+	// OutboxBatchSize OutboxRelay 每次最多發布幾筆事件
+	OutboxBatchSize = 100
+	// OutboxConfirmTimeout 等待 broker 確認（publisher confirm）的時間
+	OutboxConfirmTimeout = 5 * time.Second
+	// OutboxMaxAttempts 事件最多發布幾次，之後標記為 dead，避免單一事件擋住後面的事件
+	OutboxMaxAttempts = 10
+)
+
+// OutboxEvent 交易式外送箱（transactional outbox），streaming_service 發出的領域事件都經由 outbox 發布
+// 領域事件與影片記錄在同一個交易內寫入，交易成功後才由 OutboxRelay 發布到 RabbitMQ，
+// 不會出現資料庫已寫入但訊息遺失，或訊息已發出但資料庫寫入失敗的情況
+// 發布為 at-least-once，消費端需能處理重複的事件
+type OutboxEvent struct {
+	ID          uint   `gorm:"primaryKey"`
+	AggregateID uint   `gorm:"index"` // 事件所屬的影片
+	EventType   string `gorm:"type:varchar(64);not null"`
+	Topic       string `gorm:"type:varchar(128);not null"` // RabbitMQ routing key（預設 exchange 時為 queue 名稱）
+	Payload     string `gorm:"type:jsonb;not null"`
+	Status      string `gorm:"type:varchar(16);not null;default:pending;index"`
+	Attempts    int    `gorm:"default:0"` // 發布失敗的次數
+	LastError   string `gorm:"type:text"` // 最後一次發布失敗的原因
+	CreatedAt   time.Time
+	SentAt      *time.Time
+}
+
+// NewOutboxEvent 將 payload 序列化為 JSON 並建立待發布的事件
+func NewOutboxEvent(aggregateID uint, eventType, topic string, payload interface{}) (OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return OutboxEvent{}, err
+	}
+	return OutboxEvent{
+		AggregateID: aggregateID,
+		EventType:   eventType,
+		Topic:       topic,
+		Payload:     string(data),
+		Status:      string(OutboxPending),
+	}, nil
+}
+
+// TranscodingJobEvents 依影片記錄建立轉碼工作事件，供 VideoRepo.SaveWithEvents 使用
+func TranscodingJobEvents(video *Video) ([]OutboxEvent, error) {
+	event, err := NewOutboxEvent(video.ID, EventTranscodeRequested, QueueName, TranscodingJobFor(video))
+	if err != nil {
+		return nil, err
+	}
+	return []OutboxEvent{event}, nil
+}
+
+// VideoStatusEvent video.ready / video.failed 的內容，chat_service 依此推播給上傳者
+type VideoStatusEvent struct {
+	VideoID      uint   `json:"video_id"`
+	MemberID     string `json:"member_id"`
+	Title        string `json:"title"`
+	Status       string `json:"status"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"` // 轉碼失敗時沒有封面
+}
+
+// NewVideoStatusEvent 依影片目前的狀態（ready 或 failed）建立影片狀態事件
+func NewVideoStatusEvent(video *Video, thumbnailURL string) (OutboxEvent, error) {
+	eventType := EventVideoReady
+	if video.Status == string(VideoFailed) {
+		eventType = EventVideoFailed
+		thumbnailURL = ""
+	}
+	return NewOutboxEvent(video.ID, eventType, VideoStatusTopic, VideoStatusEvent{
+		VideoID:      video.ID,
+		MemberID:     video.MemberID,
+		Title:        video.Title,
+		Status:       video.Status,
+		ThumbnailURL: thumbnailURL,
+	})
+}
+
+// NotificationEvent NotificationTopic 的內容，chat_service 依 Action 與 Payload 推播給會員
+type NotificationEvent struct {
+	MemberID string                 `json:"member_id"`
+	Action   string                 `json:"action"` // websocket action，例如 NotifyComment
+	Payload  map[string]interface{} `json:"payload,omitempty"`
+}
+
+// NewNotificationEvent 建立推播給 memberID 的通知事件，aggregateID 為通知所屬的影片
+func NewNotificationEvent(aggregateID uint, eventType, memberID, action string, payload map[string]interface{}) (OutboxEvent, error) {
+	return NewOutboxEvent(aggregateID, eventType, NotificationTopic, NotificationEvent{
+		MemberID: memberID,
+		Action:   action,
+		Payload:  payload,
+	})
+}
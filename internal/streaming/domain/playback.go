@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strings"
+)
+
+const (
+	// DefaultGatewayURL 未設定 playback.gateway_url 時使用的 api_gateway /streaming 路由
+	DefaultGatewayURL = "http://127.0.0.1:8080/streaming"
+	// PlaylistFileName FFmpeg 輸出的 HLS 播放清單，與分段放在 processed/{videoID}/ 下
+	PlaylistFileName = "index.m3u8"
+)
+
+// PlaybackSource 播放網址的來源
+type PlaybackSource string
+
+const (
+	PlaybackCDN     PlaybackSource = "cdn"     // CDN，origin 為物件儲存的 bucket
+	PlaybackStorage PlaybackSource = "storage" // 直接由 MinIO / 公開 bucket 讀取
+	PlaybackGateway PlaybackSource = "gateway" // 經由 api_gateway，會檢查觀看權限
+)
+
+// PlaybackURL 一個候選播放網址，客戶端依序嘗試
+type PlaybackURL struct {
+	Source PlaybackSource
+	URL    string
+}
+
+// CDNHost 一個 CDN 網址
+//   - Regions 為空：一般 CDN，依 Weight 加權選擇
+//   - Regions 不為空：只提供給這些地區，優先於一般 CDN
+type CDNHost struct {
+	URL     string
+	Weight  int // 0 視為 1
+	Regions []string
+}
+
+// PlaybackOptions 播放網址設定
+type PlaybackOptions struct {
+	GatewayURL string // api_gateway 的 /streaming 路由，空值使用 DefaultGatewayURL
+	StorageURL string // 公開 bucket 的網址，空值停用
+	CDNs       []CDNHost
+	Rand       func(n int) int // 加權選擇 CDN 的亂數，回傳 [0, n)，nil 使用 math/rand
+}
+
+// PlaybackURLBuilder 組成影片的播放網址
+// 候選順序：地區專屬 CDN → 一般 CDN（加權隨機排序）→ 公開 bucket → gateway
+// CDN 與公開 bucket 不檢查觀看權限，只讀取 PublicPrefix 下的副本，只提供給 IsPubliclyServable 且已有副本的影片，
+// 其餘影片只由 gateway 播放；不經 gateway 的播放由 QoE startup beacon 計入瀏覽次數
+//
+// nil 的 *PlaybackURLBuilder 使用 DefaultGatewayURL
+type PlaybackURLBuilder struct {
+	gatewayURL string
+	storageURL string
+	cdns       []CDNHost
+	intn       func(n int) int
+}
+
+// NewPlaybackURLBuilder 檢查設定並建立 PlaybackURLBuilder
+func NewPlaybackURLBuilder(options PlaybackOptions) (*PlaybackURLBuilder, error) {
+	if options.GatewayURL == "" {
+		options.GatewayURL = DefaultGatewayURL
+	}
+	gatewayURL, err := normalizeBaseURL(options.GatewayURL)
+	if err != nil {
+		return nil, fmt.Errorf("playback.gateway_url: %w", err)
+	}
+	builder := &PlaybackURLBuilder{gatewayURL: gatewayURL, intn: options.Rand}
+	if builder.intn == nil {
+		builder.intn = rand.Intn
+	}
+	if options.StorageURL != "" {
+		if builder.storageURL, err = normalizeBaseURL(options.StorageURL); err != nil {
+			return nil, fmt.Errorf("playback.storage_url: %w", err)
+		}
+	}
+	for index, cdn := range options.CDNs {
+		if cdn.URL, err = normalizeBaseURL(cdn.URL); err != nil {
+			return nil, fmt.Errorf("playback.cdn[%d].url: %w", index, err)
+		}
+		if cdn.Weight < 0 {
+			return nil, fmt.Errorf("playback.cdn[%d].weight 不可為負數: %d", index, cdn.Weight)
+		}
+		if cdn.Weight == 0 {
+			cdn.Weight = 1
+		}
+		regions := make([]string, len(cdn.Regions))
+		for i, region := range cdn.Regions {
+			regions[i] = strings.ToLower(region)
+		}
+		cdn.Regions = regions
+		builder.cdns = append(builder.cdns, cdn)
+	}
+	return builder, nil
+}
+
+// normalizeBaseURL 檢查網址含 scheme 與 host，去掉結尾的 /
+func normalizeBaseURL(raw string) (string, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("需為含 scheme 與 host 的網址: %s", raw)
+	}
+	return strings.TrimRight(raw, "/"), nil
+}
+
+func (b *PlaybackURLBuilder) orDefault() *PlaybackURLBuilder {
+	if b != nil {
+		return b
+	}
+	return &PlaybackURLBuilder{gatewayURL: DefaultGatewayURL}
+}
+
+// Candidates 影片 HLS 播放清單的候選網址，region 為客戶端所在地區，空值不套用地區設定
+func (b *PlaybackURLBuilder) Candidates(video *Video, region string) []PlaybackURL {
+	sources := b.orDefault().sources(video, region)
+	urls := make([]PlaybackURL, len(sources))
+	for index, source := range sources {
+		urls[index] = PlaybackURL{Source: source.source, URL: source.assetURL(video, PlaylistFileName)}
+	}
+	return urls
+}
+
+// HLSURL 影片 HLS 播放清單的主要網址
+func (b *PlaybackURLBuilder) HLSURL(video *Video) string {
+	return b.AssetURLs(video, PlaylistFileName)[0]
+}
+
+// AssetURLs 影片 processed 目錄下的檔案（播放清單、分段、封面）的主要網址，所有檔案使用同一個來源
+func (b *PlaybackURLBuilder) AssetURLs(video *Video, names ...string) []string {
+	source := b.orDefault().sources(video, "")[0]
+	urls := make([]string, len(names))
+	for index, name := range names {
+		urls[index] = source.assetURL(video, name)
+	}
+	return urls
+}
+
+// ChaptersURL 影片的 WebVTT chapters track 網址，由 gateway 依章節產生
+func (b *PlaybackURLBuilder) ChaptersURL(videoID uint) string {
+	return fmt.Sprintf("%s/video/%d/chapters.vtt", b.orDefault().gatewayURL, videoID)
+}
+
+// playbackBase 一個來源的網址前綴
+type playbackBase struct {
+	source  PlaybackSource
+	baseURL string
+}
+
+// assetURL CDN 與公開 bucket 依 PublicPrefix 下的副本 key 組成網址；gateway 使用 /video/hls/:video_id/:segment 路由，播放清單為 index
+func (p playbackBase) assetURL(video *Video, name string) string {
+	if p.source != PlaybackGateway {
+		return p.baseURL + "/" + video.PublicKey(name)
+	}
+	if name == PlaylistFileName {
+		name = "index"
+	}
+	return fmt.Sprintf("%s/video/hls/%d/%s", p.baseURL, video.ID, name)
+}
+
+// sources 依序列出影片可使用的來源，最後一定是 gateway
+func (b *PlaybackURLBuilder) sources(video *Video, region string) []playbackBase {
+	var sources []playbackBase
+	if video.IsPubliclyServable() && video.PublicCopy {
+		for _, cdn := range b.orderedCDNs(strings.ToLower(region)) {
+			sources = append(sources, playbackBase{source: PlaybackCDN, baseURL: cdn})
+		}
+		if b.storageURL != "" {
+			sources = append(sources, playbackBase{source: PlaybackStorage, baseURL: b.storageURL})
+		}
+	}
+	return append(sources, playbackBase{source: PlaybackGateway, baseURL: b.gatewayURL})
+}
+
+// orderedCDNs 地區專屬 CDN 依設定順序在前，其後為一般 CDN 依權重加權隨機排序
+func (b *PlaybackURLBuilder) orderedCDNs(region string) []string {
+	var ordered []string
+	var weighted []CDNHost
+	for _, cdn := range b.cdns {
+		if len(cdn.Regions) == 0 {
+			weighted = append(weighted, cdn)
+			continue
+		}
+		if region == "" {
+			continue
+		}
+		for _, r := range cdn.Regions {
+			if r == region {
+				ordered = append(ordered, cdn.URL)
+				break
+			}
+		}
+	}
+
+	for len(weighted) > 0 {
+		total := 0
+		for _, cdn := range weighted {
+			total += cdn.Weight
+		}
+		pick := b.intn(total)
+		for index, cdn := range weighted {
+			if pick < cdn.Weight {
+				ordered = append(ordered, cdn.URL)
+				weighted = append(weighted[:index:index], weighted[index+1:]...)
+				break
+			}
+			pick -= cdn.Weight
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// PlaylistKind definition playlist kind
+type PlaylistKind string
+
+const (
+	//PlaylistCustom 會員自建的播放清單
+	PlaylistCustom PlaylistKind = "custom"
+	//PlaylistWatchLater 每位會員內建的「稍後觀看」清單，不可刪除或改名
+	PlaylistWatchLater PlaylistKind = "watch_later"
+
+	// WatchLaterAlias playlist_id 帶入此值時代表呼叫者的「稍後觀看」清單
+	WatchLaterAlias = "watch_later"
+	// WatchLaterTitle 「稍後觀看」清單名稱
+	WatchLaterTitle = "稍後觀看"
+
+	// MaxPlaylistItems 單一播放清單最多影片數
+	MaxPlaylistItems = 5000
+	// MaxPlaylistTitleLength 播放清單名稱最大字元數
+	MaxPlaylistTitleLength = 150
+)
+
+// Playlist 播放清單，Visibility 僅支援 public / private
+type Playlist struct {
+	ID         uint   `gorm:"primaryKey"`
+	MemberID   string `gorm:"type:varchar(64);index"`
+	Title      string `gorm:"type:varchar(150)"`
+	Kind       string `gorm:"type:varchar(20);default:custom"`
+	Visibility string `gorm:"type:varchar(20);default:private"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// IsOwner check member is the playlist owner
+func (p *Playlist) IsOwner(memberID string) bool {
+	return memberID != "" && p.MemberID == memberID
+}
+
+// PlaylistItem 播放清單中的影片，同一部影片在清單中只會出現一次
+type PlaylistItem struct {
+	PlaylistID uint `gorm:"primaryKey"`
+	VideoID    uint `gorm:"primaryKey"`
+	Position   int  `gorm:"index"` // 從 0 開始的播放順序
+	CreatedAt  time.Time
+}
+
+// UpdatePlaylistReq usecase update playlist request，空值表示不修改
+type UpdatePlaylistReq struct {
+	PlaylistID string
+	MemberID   string
+	Title      string
+	Visibility VideoVisibility
+}
+
+// PlaylistEntry 播放清單項目與播放資訊
+type PlaylistEntry struct {
+	Position int
+	VideoID  uint
+	Title    string
+	Type     string
+	HlsURL   string
+}
+
+// PlaylistDetail usecase get playlist response
+type PlaylistDetail struct {
+	Playlist Playlist
+	Items    []PlaylistEntry
+}
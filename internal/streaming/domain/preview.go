@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	// PreviewFileName 滑鼠移到縮圖上時播放的無聲預覽短片，與 HLS 檔案放在 processed/{videoID}/ 下
+	PreviewFileName = "preview.mp4"
+	// PreviewMinDuration 預覽短片長度下限，影片比這更短時使用整部影片
+	PreviewMinDuration = 3 * time.Second
+	// PreviewMaxDuration 預覽短片長度上限
+	PreviewMaxDuration = 5 * time.Second
+	// previewStartRatio 預設由影片 1/5 處擷取，通常已過片頭
+	previewStartRatio = 0.2
+	// PreviewScanMargin 偵測黑畫面時，預設擷取位置之後多掃描的長度
+	PreviewScanMargin = 30 * time.Second
+)
+
+// BlackInterval ffmpeg blackdetect 偵測到的黑畫面區間
+type BlackInterval struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+var blackDetectPattern = regexp.MustCompile(`black_start:\s*([0-9.]+)\s+black_end:\s*([0-9.]+)`)
+
+// ParseBlackDetect 解析 ffmpeg blackdetect filter 的輸出
+func ParseBlackDetect(output string) []BlackInterval {
+	var intervals []BlackInterval
+	for _, match := range blackDetectPattern.FindAllStringSubmatch(output, -1) {
+		start, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseFloat(match[2], 64)
+		if err != nil || end <= start {
+			continue
+		}
+		intervals = append(intervals, BlackInterval{
+			Start: time.Duration(start * float64(time.Second)),
+			End:   time.Duration(end * float64(time.Second)),
+		})
+	}
+	return intervals
+}
+
+// PreviewScanLength 偵測黑畫面需掃描的長度（由影片開頭起算）
+func PreviewScanLength(duration time.Duration) time.Duration {
+	scan := time.Duration(float64(duration)*previewStartRatio) + PreviewScanMargin
+	if scan > duration {
+		return duration
+	}
+	return scan
+}
+
+// PreviewWindow 決定預覽短片的擷取位置與長度
+// 由影片 1/5 處開始，遇到黑畫面（含片頭黑畫面）時往後移到黑畫面結束，超出影片長度時往前對齊結尾
+func PreviewWindow(duration time.Duration, blacks []BlackInterval) (start, length time.Duration) {
+	if duration <= 0 {
+		return 0, 0
+	}
+	length = PreviewMaxDuration
+	if duration < length {
+		length = duration
+	}
+
+	sorted := append([]BlackInterval(nil), blacks...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	start = time.Duration(float64(duration) * previewStartRatio)
+	for _, black := range sorted {
+		if black.Start < start+length && black.End > start {
+			start = black.End
+		}
+	}
+	if start+length > duration {
+		start = duration - length
+	}
+	return start, length
+}
+
+// PreviewURL 影片動畫預覽的網址，尚未產生預覽時為空值
+func (b *PlaybackURLBuilder) PreviewURL(video *Video) string {
+	if !video.HasPreview {
+		return ""
+	}
+	return b.AssetURLs(video, PreviewFileName)[0]
+}
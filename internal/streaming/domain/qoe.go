@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// QoETopic 播放器 QoE beacon 的佇列 topic，由 QoEAggregator 消費
+	QoETopic = "streaming.qoe"
+	// QoEBatchEvent QoE beacon 批次的訊息類型
+	QoEBatchEvent = "qoe.batch"
+	// MaxQoEBeaconsPerBatch 每次回報的 beacon 數量上限
+	MaxQoEBeaconsPerBatch = 200
+	// DefaultQoESummaryDays 未指定時 QoE 統計的天數
+	DefaultQoESummaryDays = 7
+	// MaxQoESummaryDays QoE 統計的天數上限
+	MaxQoESummaryDays = 90
+	// DefaultRendition 未帶 rendition 時使用的名稱
+	DefaultRendition = "default"
+	// MaxQoEBeaconAge beacon 事件時間最多早於收到時間多久，統計以日為單位，過舊的 beacon 會改寫已結算的日期
+	MaxQoEBeaconAge = 24 * time.Hour
+	// maxQoEValue 單一 beacon 數值上限（毫秒或影格數），過大的值視為錯誤資料
+	maxQoEValue = 24 * 60 * 60 * 1000
+)
+
+// QoEEvent 播放器回報的事件種類
+type QoEEvent string
+
+const (
+	QoEStartup       QoEEvent = "startup"        // 起播，Value 為起播時間（毫秒），每次起播計為一次瀏覽
+	QoERebuffer      QoEEvent = "rebuffer"       // 緩衝，Value 為緩衝時間（毫秒）
+	QoEBitrateSwitch QoEEvent = "bitrate_switch" // 切換 rendition，Rendition 為切換後的 rendition
+	QoEError         QoEEvent = "error"          // 播放錯誤
+	QoEDroppedFrames QoEEvent = "dropped_frames" // 掉格，Value 為掉格數
+)
+
+// qoeRenditions 可回報的 rendition，統計依 rendition 分列，限制名稱避免任意值產生大量資料列
+var qoeRenditions = map[string]bool{
+	DefaultRendition: true,
+	"2160p":          true,
+	"1440p":          true,
+	"1080p":          true,
+	"720p":           true,
+	"480p":           true,
+	"360p":           true,
+	"240p":           true,
+	"144p":           true,
+}
+
+// IsValid check event is supported
+func (e QoEEvent) IsValid() bool {
+	switch e {
+	case QoEStartup, QoERebuffer, QoEBitrateSwitch, QoEError, QoEDroppedFrames:
+		return true
+	}
+	return false
+}
+
+// QoEBeacon 播放器回報的單一事件
+type QoEBeacon struct {
+	VideoID   uint      `json:"video_id"`
+	Rendition string    `json:"rendition"` // 事件發生時播放的 rendition，例如 720p
+	Event     QoEEvent  `json:"event"`
+	Value     int64     `json:"value"`                // startup / rebuffer 為毫秒，dropped_frames 為影格數
+	ErrorCode string    `json:"error_code,omitempty"` // error 事件的錯誤代碼，只記錄在 log
+	At        time.Time `json:"at"`                   // 事件發生時間，統計以 UTC 日期分組
+}
+
+// Validate 檢查 beacon 並補上預設的 rendition 與時間
+func (b *QoEBeacon) Validate(now time.Time) error {
+	if b.VideoID == 0 {
+		return fmt.Errorf("缺少 video_id")
+	}
+	if !b.Event.IsValid() {
+		return fmt.Errorf("不支援的 QoE 事件: %s", b.Event)
+	}
+	if b.Value < 0 || b.Value > maxQoEValue {
+		return fmt.Errorf("QoE 數值超出範圍: %d", b.Value)
+	}
+	if b.Rendition == "" {
+		b.Rendition = DefaultRendition
+	}
+	if !qoeRenditions[b.Rendition] {
+		return fmt.Errorf("不支援的 rendition: %s", b.Rendition)
+	}
+	// 未帶時間或時間在未來（播放器時鐘錯誤）時以收到的時間為準
+	if b.At.IsZero() || b.At.After(now) {
+		b.At = now
+	}
+	if b.At.Before(now.Add(-MaxQoEBeaconAge)) {
+		return fmt.Errorf("QoE 事件時間過舊: %s", b.At.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// QoEBatch 一次回報的 beacon，由 ReportQoE 發布到 QoETopic
+type QoEBatch struct {
+	MemberID   string      `json:"member_id"`
+	ReceivedAt time.Time   `json:"received_at"`
+	Beacons    []QoEBeacon `json:"beacons"`
+}
+
+// ReportQoEReq usecase report QoE request
+type ReportQoEReq struct {
+	MemberID string
+	Beacons  []QoEBeacon
+}
+
+// ReportQoERes usecase report QoE response，不合法的 beacon 會被略過
+type ReportQoERes struct {
+	Accepted int
+	Rejected int
+}
+
+// VideoQoEDaily 每部影片、每個 rendition、每天的 QoE 累計
+type VideoQoEDaily struct {
+	VideoID         uint      `gorm:"primaryKey"`
+	Rendition       string    `gorm:"primaryKey;type:varchar(32)"`
+	Day             time.Time `gorm:"primaryKey;type:date;index"` // UTC 日期
+	Plays           int64     `gorm:"default:0"`                  // startup 事件數
+	StartupMsSum    int64     `gorm:"default:0"`
+	RebufferCount   int64     `gorm:"default:0"`
+	RebufferMsSum   int64     `gorm:"default:0"`
+	BitrateSwitches int64     `gorm:"default:0"`
+	Errors          int64     `gorm:"default:0"`
+	DroppedFrames   int64     `gorm:"default:0"`
+}
+
+// TableName 指定資料表名稱
+func (VideoQoEDaily) TableName() string {
+	return "video_qoe_daily"
+}
+
+// AggregateQoE 依影片、rendition、UTC 日期加總 beacon，依相同順序回傳
+func AggregateQoE(beacons []QoEBeacon) []VideoQoEDaily {
+	type key struct {
+		videoID   uint
+		rendition string
+		day       time.Time
+	}
+	index := map[key]int{}
+	var stats []VideoQoEDaily
+	for _, beacon := range beacons {
+		k := key{beacon.VideoID, beacon.Rendition, beacon.At.UTC().Truncate(24 * time.Hour)}
+		i, ok := index[k]
+		if !ok {
+			i = len(stats)
+			index[k] = i
+			stats = append(stats, VideoQoEDaily{VideoID: k.videoID, Rendition: k.rendition, Day: k.day})
+		}
+		stat := &stats[i]
+		switch beacon.Event {
+		case QoEStartup:
+			stat.Plays++
+			stat.StartupMsSum += beacon.Value
+		case QoERebuffer:
+			stat.RebufferCount++
+			stat.RebufferMsSum += beacon.Value
+		case QoEBitrateSwitch:
+			stat.BitrateSwitches++
+		case QoEError:
+			stat.Errors++
+		case QoEDroppedFrames:
+			stat.DroppedFrames += beacon.Value
+		}
+	}
+	// 固定寫入順序，避免多個 consumer 同時 upsert 時互相鎖住
+	sort.Slice(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+		if a.VideoID != b.VideoID {
+			return a.VideoID < b.VideoID
+		}
+		if a.Rendition != b.Rendition {
+			return a.Rendition < b.Rendition
+		}
+		return a.Day.Before(b.Day)
+	})
+	return stats
+}
+
+// GetQoESummaryReq usecase get QoE summary request，僅上傳者與管理員可查詢
+type GetQoESummaryReq struct {
+	VideoID  string
+	MemberID string
+	Role     string
+	Days     int
+}
+
+// QoEStats 一段期間的 QoE 統計
+type QoEStats struct {
+	Rendition       string
+	Plays           int64
+	AvgStartupMs    float64
+	RebufferCount   int64
+	RebufferMsSum   int64
+	RebufferPerPlay float64 // 平均每次播放的緩衝次數
+	BitrateSwitches int64
+	Errors          int64
+	ErrorRate       float64 // 平均每次播放的錯誤數
+	DroppedFrames   int64
+}
+
+// QoESummary 影片最近 Days 天的 QoE，Renditions 依 rendition 名稱排序
+type QoESummary struct {
+	VideoID    uint
+	Days       int
+	Overall    QoEStats
+	Renditions []QoEStats
+}
+
+// NewQoEStats 由累計值計算平均
+func NewQoEStats(total VideoQoEDaily) QoEStats {
+	stats := QoEStats{
+		Rendition:       total.Rendition,
+		Plays:           total.Plays,
+		RebufferCount:   total.RebufferCount,
+		RebufferMsSum:   total.RebufferMsSum,
+		BitrateSwitches: total.BitrateSwitches,
+		Errors:          total.Errors,
+		DroppedFrames:   total.DroppedFrames,
+	}
+	if total.Plays > 0 {
+		plays := float64(total.Plays)
+		stats.AvgStartupMs = float64(total.StartupMsSum) / plays
+		stats.RebufferPerPlay = float64(total.RebufferCount) / plays
+		stats.ErrorRate = float64(total.Errors) / plays
+	}
+	return stats
+}
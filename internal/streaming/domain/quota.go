@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// QuotaUploadWindow 每日上傳次數的計算區間
+const QuotaUploadWindow = 24 * time.Hour
+
+// ErrStorageQuotaExceeded 儲存空間已達上限，gateway 回傳 507
+var ErrStorageQuotaExceeded = errors.New("儲存空間已達上限")
+
+// QuotaKind 配額項目
+type QuotaKind string
+
+const (
+	QuotaStorage      QuotaKind = "storage"       // 總儲存量（bytes）
+	QuotaVideos       QuotaKind = "videos"        // 影片數
+	QuotaDailyUploads QuotaKind = "daily_uploads" // QuotaUploadWindow 內的上傳次數
+)
+
+// QuotaExceededError 超過配額，儲存空間為 ErrStorageQuotaExceeded，其餘為 ErrQuotaExceeded
+type QuotaExceededError struct {
+	Kind QuotaKind
+	Msg  string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Unwrap(), e.Msg)
+}
+
+// Unwrap 讓 errors.Is 可判斷 gateway 要回傳 507 或 429
+func (e *QuotaExceededError) Unwrap() error {
+	if e.Kind == QuotaStorage {
+		return ErrStorageQuotaExceeded
+	}
+	return ErrQuotaExceeded
+}
+
+// QuotaLimits 上傳配額，0 表示不限制
+type QuotaLimits struct {
+	MaxStorageBytes  int64
+	MaxVideos        int64
+	MaxUploadsPerDay int64
+}
+
+// QuotaPolicy 依角色決定的上傳配額，未列出的角色使用 Default；會員個別設定的 MemberQuota 優先
+type QuotaPolicy struct {
+	Default QuotaLimits
+	Roles   map[string]QuotaLimits
+}
+
+// Limits 取得會員的配額與來源（"member" 或 "role"）
+func (p QuotaPolicy) Limits(role string, override *MemberQuota) (QuotaLimits, string) {
+	if override != nil {
+		return override.Limits(), "member"
+	}
+	if limits, ok := p.Roles[role]; ok {
+		return limits, "role"
+	}
+	return p.Default, "role"
+}
+
+// MemberQuota 管理員為會員個別設定的配額，整組取代角色配額
+type MemberQuota struct {
+	MemberID         string `gorm:"type:varchar(64);primaryKey"`
+	MaxStorageBytes  int64
+	MaxVideos        int64
+	MaxUploadsPerDay int64
+	UpdatedAt        time.Time
+}
+
+// Limits 個別設定的配額
+func (q *MemberQuota) Limits() QuotaLimits {
+	return QuotaLimits{
+		MaxStorageBytes:  q.MaxStorageBytes,
+		MaxVideos:        q.MaxVideos,
+		MaxUploadsPerDay: q.MaxUploadsPerDay,
+	}
+}
+
+// QuotaUsage 會員目前的用量，接收檔案前預留時增加，上傳失敗或刪除影片時減少
+type QuotaUsage struct {
+	MemberID    string `gorm:"type:varchar(64);primaryKey"`
+	StoredBytes int64  `gorm:"default:0"` // 上傳的原始檔大小總和
+	VideoCount  int64  `gorm:"default:0"`
+	UpdatedAt   time.Time
+}
+
+// UploadRecord 上傳記錄，用來計算每日上傳次數；預留時 VideoID 為 0，影片建立後填入
+// 上傳失敗時刪除並歸還用量，刪除影片不會減少當日次數
+type UploadRecord struct {
+	ID        uint   `gorm:"primaryKey"`
+	MemberID  string `gorm:"type:varchar(64);index"`
+	VideoID   uint
+	SizeBytes int64
+	CreatedAt time.Time `gorm:"index"`
+}
+
+// QuotaReport 會員的配額與用量
+type QuotaReport struct {
+	Limits       QuotaLimits
+	Source       string // "member"：管理員個別設定，"role"：依角色
+	StoredBytes  int64
+	VideoCount   int64
+	UploadsToday int64 // QuotaUploadWindow 內的上傳次數
+}
+
+// CheckUpload 檢查再上傳 sizeBytes 的影片是否超過配額，未超過回傳 nil
+func (r QuotaReport) CheckUpload(memberID string, sizeBytes int64) *QuotaExceededError {
+	limits := r.Limits
+	switch {
+	case limits.MaxUploadsPerDay > 0 && r.UploadsToday >= limits.MaxUploadsPerDay:
+		return &QuotaExceededError{Kind: QuotaDailyUploads,
+			Msg: fmt.Sprintf("memberID[%s] 24 小時內最多上傳 %d 部影片", memberID, limits.MaxUploadsPerDay)}
+	case limits.MaxVideos > 0 && r.VideoCount >= limits.MaxVideos:
+		return &QuotaExceededError{Kind: QuotaVideos,
+			Msg: fmt.Sprintf("memberID[%s] 最多保存 %d 部影片", memberID, limits.MaxVideos)}
+	case limits.MaxStorageBytes > 0 && r.StoredBytes+sizeBytes > limits.MaxStorageBytes:
+		return &QuotaExceededError{Kind: QuotaStorage,
+			Msg: fmt.Sprintf("memberID[%s] 已使用 %d / %d bytes，無法再上傳 %d bytes",
+				memberID, r.StoredBytes, limits.MaxStorageBytes, sizeBytes)}
+	}
+	return nil
+}
+
+// SetMemberQuotaReq usecase set member quota request
+type SetMemberQuotaReq struct {
+	MemberID string // 設定對象
+	Role     string // 呼叫者角色，需為 admin
+	Limits   QuotaLimits
+}
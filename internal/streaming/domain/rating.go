@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ContentRating 影片分級，由上傳者設定，管理員可覆寫並鎖定
+type ContentRating string
+
+const (
+	//RatingAll 普遍級：所有人皆可觀看
+	RatingAll ContentRating = "all"
+	//RatingTeen 保護級：需登入且年滿 13 歲
+	RatingTeen ContentRating = "13+"
+	//RatingAdult 限制級：需登入且年滿 18 歲
+	RatingAdult ContentRating = "18+"
+)
+
+// BirthdateLayout 生日的日期格式，與 member_service 相同
+const BirthdateLayout = "2006-01-02"
+
+// contentRatings 由寬到嚴排列
+var contentRatings = []ContentRating{RatingAll, RatingTeen, RatingAdult}
+
+// IsValid check rating is defined
+func (r ContentRating) IsValid() bool {
+	switch r {
+	case RatingAll, RatingTeen, RatingAdult:
+		return true
+	}
+	return false
+}
+
+// MinimumAge 觀看此分級需年滿的歲數
+func (r ContentRating) MinimumAge() int {
+	switch r {
+	case RatingTeen:
+		return 13
+	case RatingAdult:
+		return 18
+	}
+	return 0
+}
+
+// UpTo 此分級（含）以下的所有分級，未定義的分級視為 RatingAll
+func (r ContentRating) UpTo() []string {
+	ratings := []string{}
+	for _, rating := range contentRatings {
+		ratings = append(ratings, string(rating))
+		if rating == r {
+			return ratings
+		}
+	}
+	return []string{string(RatingAll)}
+}
+
+// Viewer 觀看者身分與年齡資料，由 gateway 從 JWT 取得
+type Viewer struct {
+	MemberID    string
+	Birthdate   string // YYYY-MM-DD，未填寫為空值
+	AgeVerified bool   // 已由管理員驗證成年
+}
+
+// MaxRating 觀看者可觀看的最高分級
+//   - 訪客、未填寫生日的會員：僅普遍級
+//   - 已驗證成年：限制級
+//   - 其他依生日計算的年齡
+func (v Viewer) MaxRating(now time.Time) ContentRating {
+	if v.MemberID == "" {
+		return RatingAll
+	}
+	if v.AgeVerified {
+		return RatingAdult
+	}
+	birthdate, err := time.Parse(BirthdateLayout, v.Birthdate)
+	if err != nil {
+		return RatingAll
+	}
+
+	age := now.Year() - birthdate.Year()
+	if now.Month() < birthdate.Month() || (now.Month() == birthdate.Month() && now.Day() < birthdate.Day()) {
+		age--
+	}
+	max := RatingAll
+	for _, rating := range contentRatings {
+		if age >= rating.MinimumAge() {
+			max = rating
+		}
+	}
+	return max
+}
+
+// Allows 可觀看此分級（含）以下的觀看者是否可觀看 rating
+func (r ContentRating) Allows(rating ContentRating) bool {
+	return rating.MinimumAge() <= r.MinimumAge()
+}
+
+// CanView 觀看者是否可觀看此分級
+func (v Viewer) CanView(rating ContentRating, now time.Time) bool {
+	return v.MaxRating(now).Allows(rating)
+}
+
+// SetContentRatingReq usecase set content rating request
+// 上傳者可修改未鎖定的分級；管理員覆寫後鎖定分級並寫入稽核紀錄
+type SetContentRatingReq struct {
+	VideoID  string
+	MemberID string
+	Role     string
+	Rating   ContentRating
+	Note     string // 管理員覆寫時寫入稽核紀錄
+}
+
+// Validate 檢查分級與備註
+func (r SetContentRatingReq) Validate() error {
+	if !r.Rating.IsValid() {
+		return fmt.Errorf("不支援的分級: %s", r.Rating)
+	}
+	if len([]rune(r.Note)) > maxModerationNoteLength {
+		return fmt.Errorf("處理備註超過 %d 字", maxModerationNoteLength)
+	}
+	return nil
+}
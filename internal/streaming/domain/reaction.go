@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+// ReactionType definition member reaction to a video
+type ReactionType string
+
+const (
+	//ReactionNone 未表態（清除按讚 / 倒讚）
+	ReactionNone ReactionType = ""
+	//ReactionLike 按讚
+	ReactionLike ReactionType = "like"
+	//ReactionDislike 倒讚
+	ReactionDislike ReactionType = "dislike"
+)
+
+// IsValid check reaction is defined
+func (r ReactionType) IsValid() bool {
+	switch r {
+	case ReactionNone, ReactionLike, ReactionDislike:
+		return true
+	}
+	return false
+}
+
+// ReactionDelta 由 prev 改為 next 時，按讚數與倒讚數的變化量
+func ReactionDelta(prev, next ReactionType) (likes, dislikes int64) {
+	switch prev {
+	case ReactionLike:
+		likes--
+	case ReactionDislike:
+		dislikes--
+	}
+	switch next {
+	case ReactionLike:
+		likes++
+	case ReactionDislike:
+		dislikes++
+	}
+	return likes, dislikes
+}
+
+// VideoReaction 會員對影片的表態，每位會員對每部影片只有一筆
+type VideoReaction struct {
+	VideoID   uint       `gorm:"primaryKey"`
+	MemberID  string     `gorm:"primaryKey;type:varchar(64)"`
+	Reaction  string     `gorm:"type:varchar(10)"` // "like", "dislike"
+	UpdatedAt time.Time  `gorm:"index"`
+	LikedAt   *time.Time `gorm:"index"` // 第一次按讚的時間，改為倒讚再改回按讚時不變，熱門分數依此計算按讚時間
+}
+
+// ReactionCounts 影片的按讚 / 倒讚數
+type ReactionCounts struct {
+	Likes    int64
+	Dislikes int64
+}
+
+// VideoReactions usecase video reactions response
+type VideoReactions struct {
+	ReactionCounts
+	MyReaction ReactionType // 呼叫者自己的表態，未登入或未表態為空值
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+const (
+	// DefaultShortsFeedSize 短影音動態每次回傳的數量
+	DefaultShortsFeedSize = 10
+	// MaxShortsFeedSize 短影音動態每次回傳的上限
+	MaxShortsFeedSize = 30
+	// ShortsSeenTTL 已看過的短影音紀錄保留時間，過期後可再次出現
+	ShortsSeenTTL = 7 * 24 * time.Hour
+	// ShortsFirstSegment FFmpeg HLS 輸出的第一個分段，供客戶端預先載入
+	ShortsFirstSegment = "index0.ts"
+	// PosterFileName 轉碼時擷取的封面圖，與 HLS 檔案放在 processed/{videoID}/ 下
+	PosterFileName = "poster.jpg"
+)
+
+// ShortsSource 短影音來源
+type ShortsSource string
+
+const (
+	//ShortsFollowed 追蹤頻道的新短影音
+	ShortsFollowed ShortsSource = "followed"
+	//ShortsTrending 熱門短影音
+	ShortsTrending ShortsSource = "trending"
+	//ShortsFresh 最新上傳的短影音
+	ShortsFresh ShortsSource = "fresh"
+)
+
+// ShortsMix 各來源輪流出現的順序，某個來源沒有影片時由其他來源補上
+var ShortsMix = []ShortsSource{ShortsFollowed, ShortsTrending, ShortsFresh}
+
+// ShortsQuery 依 ID 由新到舊列出 ready 且 public 的短影音
+type ShortsQuery struct {
+	BeforeID  uint          // 只列出 ID 小於此值的影片，0 表示從最新開始
+	MemberIDs []string      // 只列出這些頻道（上傳者）的影片，nil 表示不限
+	MaxRating ContentRating // 觀看者可觀看的最高分級，空值僅列出普遍級
+	Limit     int
+}
+
+// ShortsFeedCursor 短影音動態游標，記錄各來源已讀到的位置
+// 訪客沒有 member_id，以 Session 區分已看過的短影音
+type ShortsFeedCursor struct {
+	Session        string `json:"s,omitempty"`
+	FollowedBefore uint   `json:"fo,omitempty"`
+	TrendingOffset int    `json:"tr,omitempty"`
+	FreshBefore    uint   `json:"fr,omitempty"`
+}
+
+// Encode 游標轉為 URL 可用的字串
+func (c ShortsFeedCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Restart 各來源回到起點，保留 Session
+func (c ShortsFeedCursor) Restart() ShortsFeedCursor {
+	return ShortsFeedCursor{Session: c.Session}
+}
+
+// DecodeShortsFeedCursor 解析游標，空字串回傳起點
+func DecodeShortsFeedCursor(cursor string) (ShortsFeedCursor, error) {
+	var c ShortsFeedCursor
+	if cursor == "" {
+		return c, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// ShortsItem 短影音動態中的一部影片，附上預先載入所需的網址
+type ShortsItem struct {
+	Video           Video
+	Source          ShortsSource
+	HlsURL          string
+	PosterURL       string
+	FirstSegmentURL string
+}
+
+// ShortsFeed 一頁短影音動態
+type ShortsFeed struct {
+	Items      []ShortsItem
+	NextCursor string // 動態不會結束，永遠帶回下一頁游標
+}
+
+// ChannelFollow 會員追蹤的頻道（上傳者）
+type ChannelFollow struct {
+	MemberID  string `gorm:"primaryKey;type:varchar(64)"`
+	ChannelID string `gorm:"primaryKey;type:varchar(64);index"` // 被追蹤的上傳者 member_id
+	CreatedAt time.Time
+}
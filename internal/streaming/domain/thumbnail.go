@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg" // 註冊 JPEG 解碼，供 image.DecodeConfig 使用
+	_ "image/png"  // 註冊 PNG 解碼
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ThumbnailCandidateCount 轉碼時提供上傳者挑選的候選縮圖數量
+	ThumbnailCandidateCount = 3
+	// ThumbnailMaxFrames 場景偵測最多擷取的畫面數，避免長片產生過多暫存圖片
+	ThumbnailMaxFrames = 60
+	// ThumbnailFrameInterval 場景沒有變化時，每隔多久仍擷取一格畫面；長片改用 ThumbnailFrameGap
+	ThumbnailFrameInterval = 10 * time.Second
+	// ThumbnailSceneThreshold 場景變化分數超過此值時擷取畫面
+	ThumbnailSceneThreshold = 0.3
+	// thumbnailMinGap 挑選的候選縮圖至少相隔的時間，避免三張幾乎相同
+	thumbnailMinGap = 2 * time.Second
+
+	// MaxThumbnailSize 自訂縮圖大小上限
+	MaxThumbnailSize = 2 << 20
+	// MinThumbnailWidth 自訂縮圖寬度下限
+	MinThumbnailWidth = 640
+	// MinThumbnailHeight 自訂縮圖高度下限
+	MinThumbnailHeight = 360
+	// MaxThumbnailWidth 自訂縮圖寬度上限，壓縮率極高的圖片檔案很小，解碼後仍可能佔用大量記憶體
+	MaxThumbnailWidth = 3840
+	// MaxThumbnailHeight 自訂縮圖高度上限
+	MaxThumbnailHeight = 2160
+	// MaxCustomThumbnails 每部影片可保留的自訂縮圖數量
+	MaxCustomThumbnails = 5
+
+	// 亮度（YAVG，0~255）低於或高於門檻的畫面幾乎全黑或全白，不作為候選
+	thumbnailMinBrightness = 30
+	thumbnailMaxBrightness = 235
+	// thumbnailIdealBrightness 亮度越接近此值分數越高
+	thumbnailIdealBrightness = 120
+)
+
+// ThumbnailSize 縮圖尺寸
+type ThumbnailSize string
+
+const (
+	ThumbnailLarge  ThumbnailSize = "large"
+	ThumbnailMedium ThumbnailSize = "medium"
+	ThumbnailSmall  ThumbnailSize = "small"
+)
+
+// ThumbnailDimension 縮圖的寬高，等比例縮放後不足的部分補黑邊
+type ThumbnailDimension struct {
+	Size   ThumbnailSize
+	Width  int
+	Height int
+}
+
+// ThumbnailSizes 每張縮圖產生的尺寸，由大到小
+var ThumbnailSizes = []ThumbnailDimension{
+	{Size: ThumbnailLarge, Width: 1280, Height: 720},
+	{Size: ThumbnailMedium, Width: 640, Height: 360},
+	{Size: ThumbnailSmall, Width: 320, Height: 180},
+}
+
+// ThumbnailFileName 縮圖檔名，與 HLS 檔案放在 processed/{videoID}/ 下，例如 thumb_auto1_large.jpg
+func ThumbnailFileName(name string, size ThumbnailSize) string {
+	return fmt.Sprintf("thumb_%s_%s.jpg", name, size)
+}
+
+// ThumbnailSource 縮圖來源
+type ThumbnailSource string
+
+const (
+	// ThumbnailAuto 轉碼時依場景變化與亮度挑選的畫面，重新轉碼時會被取代
+	ThumbnailAuto ThumbnailSource = "auto"
+	// ThumbnailCustom 上傳者上傳的圖片
+	ThumbnailCustom ThumbnailSource = "custom"
+)
+
+// AutoThumbnailName 第 rank 名（由 1 開始）自動縮圖的名稱
+func AutoThumbnailName(rank int) string {
+	return fmt.Sprintf("auto%d", rank)
+}
+
+// IsAutoThumbnail 縮圖名稱是否為轉碼時產生的自動縮圖，空值（舊影片的 PosterFileName）也視為自動
+func IsAutoThumbnail(name string) bool {
+	return name == "" || strings.HasPrefix(name, string(ThumbnailAuto))
+}
+
+// VideoThumbnail 影片的候選縮圖，各尺寸的圖片存於 MinIO 的 processed/{videoID}/ThumbnailFileName
+type VideoThumbnail struct {
+	ID        uint   `gorm:"primaryKey"`
+	VideoID   uint   `gorm:"index"`
+	Name      string `gorm:"type:varchar(32)"` // 檔名中的名稱，例如 auto1、custom1700000000
+	Source    string `gorm:"type:varchar(10)"` // "auto", "custom"
+	Score     float64
+	TimeMs    int64 // 擷取畫面在影片中的時間（毫秒），自訂縮圖為 0
+	CreatedAt time.Time
+}
+
+// ThumbnailItem 提供上傳者挑選的縮圖，附上各尺寸的網址
+type ThumbnailItem struct {
+	VideoThumbnail
+	URLs   []string // 順序與 ThumbnailSizes 相同
+	Active bool     // 影片目前使用此縮圖
+}
+
+// UploadThumbnailReq usecase upload custom thumbnail request
+type UploadThumbnailReq struct {
+	VideoID  string
+	MemberID string
+	Image    []byte // PNG 或 JPEG
+}
+
+// SetActiveThumbnailReq usecase set active thumbnail request
+type SetActiveThumbnailReq struct {
+	VideoID     string
+	MemberID    string
+	ThumbnailID uint
+}
+
+// FrameCandidate 場景偵測擷取的畫面與 signalstats 量測值
+type FrameCandidate struct {
+	Index      int // 擷取順序，由 0 開始，對應輸出的第 Index+1 張圖片
+	Time       time.Duration
+	SceneScore float64 // lavfi.scene_score，0~1，與前一格的差異
+	Brightness float64 // lavfi.signalstats.YAVG，平均亮度
+	Contrast   float64 // lavfi.signalstats.YHIGH - YLOW，亮部與暗部的差距，越大畫面越清晰
+}
+
+// Score 候選畫面的分數（0~1）
+// 亮度接近適中、對比大且為場景轉換的畫面較高分；接近全黑或全白的畫面為 0
+func (f FrameCandidate) Score() float64 {
+	if f.Brightness < thumbnailMinBrightness || f.Brightness > thumbnailMaxBrightness {
+		return 0
+	}
+	brightness := 1 - math.Abs(f.Brightness-thumbnailIdealBrightness)/thumbnailIdealBrightness
+	contrast := math.Min(math.Max(f.Contrast, 0)/255, 1)
+	scene := math.Min(math.Max(f.SceneScore, 0), 1)
+	return 0.4*math.Max(brightness, 0) + 0.4*contrast + 0.2*scene
+}
+
+var (
+	framePattern    = regexp.MustCompile(`frame:\s*(\d+)\s+pts:\s*\S+\s+pts_time:\s*([0-9.]+)`)
+	metadataPattern = regexp.MustCompile(`(lavfi\.[A-Za-z_.]+)=([-0-9.]+)`)
+)
+
+// ParseFrameMetadata 解析 ffmpeg metadata=print 的輸出，每一格畫面以 frame:N pts_time:T 開頭
+func ParseFrameMetadata(output string) []FrameCandidate {
+	var frames []FrameCandidate
+	var yLow, yHigh float64
+	for _, line := range strings.Split(output, "\n") {
+		if match := framePattern.FindStringSubmatch(line); match != nil {
+			index, _ := strconv.Atoi(match[1])
+			seconds, _ := strconv.ParseFloat(match[2], 64)
+			frames = append(frames, FrameCandidate{Index: index, Time: time.Duration(seconds * float64(time.Second))})
+			yLow, yHigh = 0, 0
+			continue
+		}
+		match := metadataPattern.FindStringSubmatch(line)
+		if match == nil || len(frames) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			continue
+		}
+		frame := &frames[len(frames)-1]
+		switch match[1] {
+		case "lavfi.scene_score":
+			frame.SceneScore = value
+		case "lavfi.signalstats.YAVG":
+			frame.Brightness = value
+		case "lavfi.signalstats.YLOW":
+			yLow = value
+			frame.Contrast = yHigh - yLow
+		case "lavfi.signalstats.YHIGH":
+			yHigh = value
+			frame.Contrast = yHigh - yLow
+		}
+	}
+	return frames
+}
+
+// PickThumbnailFrames 依分數由高到低挑選最多 n 格畫面，彼此至少相隔 thumbnailMinGap，分數為 0 的畫面不挑選
+func PickThumbnailFrames(frames []FrameCandidate, n int) []FrameCandidate {
+	sorted := append([]FrameCandidate(nil), frames...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Score() > sorted[j].Score() })
+
+	var picked []FrameCandidate
+	for _, frame := range sorted {
+		if len(picked) >= n || frame.Score() <= 0 {
+			break
+		}
+		tooClose := false
+		for _, p := range picked {
+			gap := frame.Time - p.Time
+			if gap < 0 {
+				gap = -gap
+			}
+			if gap < thumbnailMinGap {
+				tooClose = true
+				break
+			}
+		}
+		if !tooClose {
+			picked = append(picked, frame)
+		}
+	}
+	return picked
+}
+
+// ValidateThumbnailImage 檢查自訂縮圖：PNG 或 JPEG、不超過 MaxThumbnailSize，
+// 且解析度介於 MinThumbnailWidth x MinThumbnailHeight 與 MaxThumbnailWidth x MaxThumbnailHeight 之間
+// 只讀取圖片標頭，不解碼整張圖片
+func ValidateThumbnailImage(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("縮圖圖片不可為空")
+	}
+	if len(data) > MaxThumbnailSize {
+		return fmt.Errorf("縮圖圖片不可超過 %d bytes", MaxThumbnailSize)
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("縮圖圖片需為 PNG 或 JPEG: %v", err)
+	}
+	if format != "png" && format != "jpeg" {
+		return fmt.Errorf("縮圖圖片需為 PNG 或 JPEG: %s", format)
+	}
+	if config.Width < MinThumbnailWidth || config.Height < MinThumbnailHeight {
+		return fmt.Errorf("縮圖解析度需至少 %dx%d: %dx%d", MinThumbnailWidth, MinThumbnailHeight, config.Width, config.Height)
+	}
+	if config.Width > MaxThumbnailWidth || config.Height > MaxThumbnailHeight {
+		return fmt.Errorf("縮圖解析度不可超過 %dx%d: %dx%d", MaxThumbnailWidth, MaxThumbnailHeight, config.Width, config.Height)
+	}
+	return nil
+}
+
+// ThumbnailFrameGap 兩格候選畫面至少相隔的時間，讓最多 ThumbnailMaxFrames 格平均分布在整部影片，
+// 不會因場景變化頻繁而集中在開頭；長度未知（0）時不限制
+func ThumbnailFrameGap(duration time.Duration) time.Duration {
+	if duration <= 0 {
+		return 0
+	}
+	return duration / ThumbnailMaxFrames
+}
+
+// ThumbnailURLs 縮圖各尺寸的網址，順序與 ThumbnailSizes 相同
+func (b *PlaybackURLBuilder) ThumbnailURLs(video *Video, name string) []string {
+	names := make([]string, len(ThumbnailSizes))
+	for index, size := range ThumbnailSizes {
+		names[index] = ThumbnailFileName(name, size.Size)
+	}
+	return b.AssetURLs(video, names...)
+}
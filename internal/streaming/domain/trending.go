@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// TrendingWindow 計算熱門分數時只看最近這段時間的互動
+	TrendingWindow = 48 * time.Hour
+	// TrendingHalfLife 互動的權重每經過一個半衰期減半
+	TrendingHalfLife = 12 * time.Hour
+	// MaxTrendingSize 每個排行榜保留的影片數
+	MaxTrendingSize = 200
+	// TrendingLockKey TrendingJob 的 leader lock，同一時間只有一個 replica 重建排行榜
+	TrendingLockKey = "streaming:lock:trending"
+
+	// 各類互動的權重
+	TrendingViewWeight    = 1.0
+	TrendingLikeWeight    = 5.0
+	TrendingCommentWeight = 10.0
+)
+
+// VideoViewHourly 每部影片每小時的瀏覽次數，熱門分數依此計算最近的瀏覽
+type VideoViewHourly struct {
+	VideoID uint      `gorm:"primaryKey"`
+	Hour    time.Time `gorm:"primaryKey;index"` // 整點
+	Views   int64     `gorm:"default:0"`
+}
+
+// TableName 指定資料表名稱
+func (VideoViewHourly) TableName() string {
+	return "video_views_hourly"
+}
+
+// HourlyActivity 某部影片某小時內的互動次數
+type HourlyActivity struct {
+	VideoID uint      `bson:"video_id"`
+	Hour    time.Time `bson:"hour"`
+	Count   int64     `bson:"count"`
+}
+
+// TrendingScores 影片 ID 對應熱門分數
+type TrendingScores map[uint]float64
+
+// Add 以指數衰減累加互動：每筆互動的權重為 weight * 0.5^(距今時間 / 半衰期)
+func (s TrendingScores) Add(activities []HourlyActivity, weight float64, now time.Time) {
+	for _, activity := range activities {
+		age := now.Sub(activity.Hour)
+		if age < 0 {
+			age = 0
+		}
+		decay := math.Exp(-math.Ln2 * float64(age) / float64(TrendingHalfLife))
+		s[activity.VideoID] += weight * float64(activity.Count) * decay
+	}
+}
+
+// TrendingEntry 排行榜中的一部影片
+type TrendingEntry struct {
+	VideoID uint
+	Score   float64
+}
+
+// TrendingKey 排行榜的 Redis key，categoryID 為 0、videoType 為空值時不區分
+//   - trending:all
+//   - trending:type:{type}
+//   - trending:category:{id}
+//   - trending:category:{id}:type:{type}
+func TrendingKey(categoryID uint, videoType string) string {
+	key := "trending"
+	if categoryID > 0 {
+		key += fmt.Sprintf(":category:%d", categoryID)
+	}
+	if videoType != "" {
+		key += ":type:" + videoType
+	}
+	if key == "trending" {
+		key += ":all"
+	}
+	return key
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"time"
+)
 
 // VideoStatus definition video status
 type VideoStatus string
@@ -12,15 +16,55 @@ const (
 	VideoUpload VideoStatus = "upload"
 	//VideoProcessing video status is processing
 	VideoProcessing VideoStatus = "processing"
+	//VideoFailed video status is failed，原始檔遺失或轉碼重試次數用盡
+	VideoFailed VideoStatus = "failed"
+	//VideoBlocked video status is blocked，管理員下架，任何人（含上傳者）都無法播放，也不會出現在任何列表
+	VideoBlocked VideoStatus = "blocked"
+)
+
+const (
+	//VideoTypeShort 短影音
+	VideoTypeShort = "short"
+	//VideoTypeLong 長影片
+	VideoTypeLong = "long"
+)
+
+// VideoVisibility definition video visibility
+type VideoVisibility string
+
+const (
+	//VisibilityPublic 公開：可出現在搜尋、推薦等列表
+	VisibilityPublic VideoVisibility = "public"
+	//VisibilityUnlisted 不公開：持有連結即可播放，但不會出現在任何列表
+	VisibilityUnlisted VideoVisibility = "unlisted"
+	//VisibilityPrivate 私人：僅上傳者與分享名單可播放
+	VisibilityPrivate VideoVisibility = "private"
 )
 
+// IsValid check visibility is defined
+func (v VideoVisibility) IsValid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	}
+	return false
+}
+
 // UploadVideoReq usecase upload video request
 type UploadVideoReq struct {
+	MemberID    string
 	Title       string
 	Description string
 	Type        string
 	FileName    string
 	File        io.Reader
+	Visibility  VideoVisibility
+	PublishAt   *time.Time // 排程公開時間，nil 表示不排程
+	Tags        []string
+	CategoryID  uint          // 0 表示未分類
+	Watermark   bool          // 燒錄頻道浮水印（需先設定頻道浮水印）
+	Role        string        // 上傳者角色，免費方案會燒錄平台浮水印
+	Rating      ContentRating // 分級，未指定為普遍級
 }
 
 // UploadVideoRes usecase upload video response
@@ -31,19 +75,158 @@ type UploadVideoRes struct {
 
 // GetVideoRes usecase get video response
 type GetVideoRes struct {
-	VideoID int
-	Title   string
-	HlsURL  string
+	VideoID         int
+	Title           string
+	HlsURL          string        // PlaybackURLs 的第一個網址
+	PlaybackURLs    []PlaybackURL // 候選播放網址，客戶端依序嘗試
+	Visibility      string
+	ContentRating   string
+	Tags            []string
+	CategoryID      uint
+	Chapters        []Chapter
+	ChaptersURL     string // WebVTT chapters track，沒有章節時為空值
+	SourceVideoID   uint   // 剪輯來源影片，非剪輯的影片為 0
+	DownloadEnabled bool   // 上傳者未停用下載
+}
+
+// UpdateVisibilityReq usecase update video visibility request
+type UpdateVisibilityReq struct {
+	VideoID    string
+	MemberID   string
+	Visibility VideoVisibility
+	PublishAt  *time.Time
+}
+
+// DeleteVideoReq usecase delete video request
+type DeleteVideoReq struct {
+	VideoID  string
+	MemberID string
+	Role     string // 管理員可刪除任何影片
 }
 
 // Video 定義影片模型
 type Video struct {
-	ID          uint `gorm:"primaryKey"`
-	Title       string
-	Description string
-	FileName    string // 存於 MinIO 上的 object key
-	Type        string // "short" 或 "long"
-	Status      string // "uploaded", "processing", "ready"
-	ViewCount   uint   // 瀏覽次數
-	// 可加入 UserID、CreatedAt 等欄位
+	ID                uint   `gorm:"primaryKey"`
+	MemberID          string `gorm:"type:varchar(64);index"` // 上傳者 member_id
+	Title             string
+	Description       string
+	FileName          string     // 存於 MinIO 上的 object key
+	Type              string     // "short" 或 "long"
+	Status            string     // "uploaded", "processing", "ready"
+	ViewCount         uint       // 瀏覽次數
+	Visibility        string     `gorm:"type:varchar(20);default:public;index"` // "public", "unlisted", "private"
+	PublishAt         *time.Time `gorm:"index"`                                 // 排程公開時間，到期後由 PublishScheduler 改為 public
+	CategoryID        *uint      `gorm:"index"`                                 // 分類，nil 表示未分類
+	LikeCount         uint       `gorm:"default:0"`                             // 按讚數，與 video_reactions 同一個交易更新
+	DislikeCount      uint       `gorm:"default:0"`                             // 倒讚數
+	SourceVideoID     *uint      `gorm:"index"`                                 // 片段的來源影片，nil 表示非片段
+	ClipStartMs       int64      // 片段在來源影片中的開始時間（毫秒）
+	ClipEndMs         int64      // 片段在來源影片中的結束時間（毫秒）
+	ChannelWatermark  bool       `gorm:"default:false"`                     // 上傳時選擇燒錄頻道浮水印
+	PlatformWatermark bool       `gorm:"default:false"`                     // 免費方案上傳，燒錄平台浮水印
+	TranscodeAttempts int        `gorm:"default:0"`                         // Worker 轉碼失敗或卡住後由 StuckJobReconciler 重新發布的次數
+	ReadyAt           *time.Time `gorm:"index"`                             // 轉碼完成時間，儲存生命週期規則以此計算
+	OriginalState     string     `gorm:"type:varchar(20);default:stored"`   // 原始檔 stored / archived / deleted
+	StorageTier       string     `gorm:"type:varchar(20);default:hot"`      // 轉碼後檔案 hot / cold
+	Hidden            bool       `gorm:"default:false"`                     // 管理員隱藏：不出現在任何列表，僅上傳者可觀看
+	ContentRating     string     `gorm:"type:varchar(8);default:all;index"` // 分級 "all", "13+", "18+"
+	RatingLocked      bool       `gorm:"default:false"`                     // 管理員覆寫分級後鎖定，上傳者無法修改
+	HasPreview        bool       `gorm:"default:false"`                     // 轉碼時已產生動畫預覽 PreviewFileName
+	PreviewURL        string     `gorm:"-"`                                 // 動畫預覽網址，由 usecase 列出影片時填入，不存入資料庫
+	ThumbnailName     string     `gorm:"type:varchar(32)"`                  // 使用中的縮圖名稱，空值表示使用 PosterFileName
+	DownloadsDisabled bool       `gorm:"default:false"`                     // 上傳者停用下載，其他人無法取得下載連結
+	SizeBytes         int64      `gorm:"default:0"`                         // 上傳的原始檔大小，計入上傳者的儲存配額
+	PublicCopy        bool       `gorm:"default:false"`                     // 轉碼後檔案已複製到 PublicPrefix，CDN 與公開 bucket 只提供有副本的影片
+	CreatedAt         time.Time
+	UpdatedAt         time.Time `gorm:"index"` // 狀態停留時間以此判斷
+}
+
+// IsOwner check member is the uploader
+func (v *Video) IsOwner(memberID string) bool {
+	return memberID != "" && v.MemberID == memberID
+}
+
+// IsListed 是否可出現在搜尋、推薦、排行榜等列表（ready、public 且未被管理員隱藏）
+func (v *Video) IsListed() bool {
+	return v.Status == string(VideoReady) && v.Visibility == string(VisibilityPublic) && !v.Hidden
+}
+
+// IsBlocked 影片已被管理員下架
+func (v *Video) IsBlocked() bool {
+	return v.Status == string(VideoBlocked)
+}
+
+// Rating 影片分級，未設定視為普遍級
+func (v *Video) Rating() ContentRating {
+	if rating := ContentRating(v.ContentRating); rating.IsValid() {
+		return rating
+	}
+	return RatingAll
+}
+
+// IsRestricted 影片為普遍級以外的分級，需登入並符合年齡才能觀看
+func (v *Video) IsRestricted() bool {
+	return v.Rating() != RatingAll
+}
+
+// IsPubliclyServable 轉碼後檔案可否由 CDN 或公開 bucket 直接提供，不經觀看權限檢查
+// private、被隱藏、分級限制與下架的影片只能經由 gateway 播放
+func (v *Video) IsPubliclyServable() bool {
+	return v.Visibility != string(VisibilityPrivate) && !v.Hidden && !v.IsRestricted() && !v.IsBlocked()
+}
+
+// WantsPublicCopy 影片是否應在 PublicPrefix 下保留副本：已轉碼完成、可公開提供且不在冷儲存
+func (v *Video) WantsPublicCopy() bool {
+	return v.Status == string(VideoReady) && v.IsPubliclyServable() && StorageTier(v.StorageTier) != StorageCold
+}
+
+// PublicKey 轉碼後檔案在 PublicPrefix 下的副本 key
+func (v *Video) PublicKey(name string) string {
+	return fmt.Sprintf("%s%d/%s", PublicPrefix, v.ID, name)
+}
+
+// IsStuck 影片停留在 upload / processing 超過 timeout
+func (v *Video) IsStuck(now time.Time, timeout time.Duration) bool {
+	status := VideoStatus(v.Status)
+	return (status == VideoUpload || status == VideoProcessing) && v.UpdatedAt.Before(now.Add(-timeout))
+}
+
+// ProcessedKey 轉碼後檔案的 object key，cold 影片位於 ArchivePrefix 下
+func (v *Video) ProcessedKey(name string) string {
+	key := fmt.Sprintf("%s%d/%s", ProcessedPrefix, v.ID, name)
+	if StorageTier(v.StorageTier) == StorageCold {
+		return ArchivedKey(key)
+	}
+	return key
+}
+
+// OriginalPrefix 原始檔所在的 prefix，archived 的原始檔位於 ArchivePrefix 下
+func (v *Video) OriginalPrefix() string {
+	prefix := fmt.Sprintf("%s%d/", OriginalPrefix, v.ID)
+	if OriginalState(v.OriginalState) == OriginalArchived {
+		return ArchivedKey(prefix)
+	}
+	return prefix
+}
+
+// PosterFile 封面圖檔名：使用中縮圖的最大尺寸，沒有縮圖的舊影片為轉碼擷取的 PosterFileName
+func (v *Video) PosterFile() string {
+	if v.ThumbnailName == "" {
+		return PosterFileName
+	}
+	return ThumbnailFileName(v.ThumbnailName, ThumbnailLarge)
+}
+
+// ClipSource 剪輯來源影片 ID，非剪輯的影片回傳 0
+func (v *Video) ClipSource() uint {
+	if v.SourceVideoID == nil {
+		return 0
+	}
+	return *v.SourceVideoID
+}
+
+// VideoShare 私人影片的分享名單
+type VideoShare struct {
+	VideoID  uint   `gorm:"primaryKey"`
+	MemberID string `gorm:"primaryKey;type:varchar(64)"`
 }
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// PlatformWatermarkOwner 平台浮水印的 Owner，套用在免費方案會員上傳的影片
+	PlatformWatermarkOwner = "platform"
+	// MaxWatermarkSize 浮水印圖片大小上限
+	MaxWatermarkSize = 1 << 20
+	// DefaultWatermarkOpacity 未指定時的浮水印不透明度
+	DefaultWatermarkOpacity = 0.6
+)
+
+// WatermarkPosition 浮水印在畫面中的位置
+type WatermarkPosition string
+
+const (
+	WatermarkTopLeft     WatermarkPosition = "top-left"
+	WatermarkTopRight    WatermarkPosition = "top-right"
+	WatermarkBottomLeft  WatermarkPosition = "bottom-left"
+	WatermarkBottomRight WatermarkPosition = "bottom-right"
+)
+
+// IsValid check position is supported
+func (p WatermarkPosition) IsValid() bool {
+	switch p {
+	case WatermarkTopLeft, WatermarkTopRight, WatermarkBottomLeft, WatermarkBottomRight:
+		return true
+	}
+	return false
+}
+
+// Watermark 浮水印設定，圖片存於 MinIO
+//   - 頻道浮水印：Owner 為上傳者 member_id，上傳時選擇是否燒錄
+//   - 平台浮水印：Owner 為 PlatformWatermarkOwner，由管理員設定，燒錄在免費方案的影片
+type Watermark struct {
+	Owner     string  `gorm:"type:varchar(64);primaryKey"`
+	ObjectKey string  // 圖片在 MinIO 上的 object key
+	Position  string  `gorm:"type:varchar(20)"`
+	Opacity   float64 // 0 < Opacity <= 1
+	Enabled   bool    `gorm:"default:true"`
+	UpdatedAt time.Time
+}
+
+// SetWatermarkReq usecase set watermark request，Image 為空時只更新位置、不透明度與開關
+// Position 為空、Opacity 為 0、Enabled 為 nil 時沿用目前的設定
+type SetWatermarkReq struct {
+	MemberID string
+	Image    []byte
+	Position WatermarkPosition
+	Opacity  float64
+	Enabled  *bool
+}
+
+// ValidateWatermark 檢查位置與不透明度
+func ValidateWatermark(position WatermarkPosition, opacity float64) error {
+	if !position.IsValid() {
+		return fmt.Errorf("不支援的浮水印位置: %s", position)
+	}
+	if opacity <= 0 || opacity > 1 {
+		return fmt.Errorf("浮水印不透明度需介於 0 到 1: %v", opacity)
+	}
+	return nil
+}
+
+// WatermarkObjectKey 浮水印圖片在 MinIO 上的 object key
+func WatermarkObjectKey(owner string) string {
+	return fmt.Sprintf("watermarks/%s/watermark", owner)
+}
@@ -1,13 +1,46 @@
 package domain
 
+import "time"
+
 const (
 	//QueueName definition queue name
 	QueueName = "transcode"
+	// MaxTranscodeAttempts 轉碼失敗或卡住後最多重試幾次，超過後標記為 failed 並通知上傳者
+	MaxTranscodeAttempts = 3
+	// StuckJobLockKey StuckJobReconciler 的 leader lock，同一時間只有一個 replica 執行
+	StuckJobLockKey = "streaming:lock:stuck_job_reconciler"
+	// TranscodeHeartbeatInterval Worker 轉碼期間更新 updated_at 的間隔，stuck_jobs.timeout 需為此值的數倍
+	TranscodeHeartbeatInterval = time.Minute
 )
 
 // TranscodingJob 定義轉碼工作訊息
 type TranscodingJob struct {
-	VideoID  uint   `json:"video_id"`
-	FileName string `json:"file_name"` // 原始檔在 MinIO 上的 object key
-	Type     string `json:"type"`      // "short" 或 "long"
+	VideoID  uint     `json:"video_id"`
+	FileName string   `json:"file_name"`      // 原始檔在 MinIO 上的 object key
+	Type     string   `json:"type"`           // "short" 或 "long"
+	Clip     *ClipJob `json:"clip,omitempty"` // 剪輯工作，FileName 為來源影片的 m3u8
+}
+
+// TranscodingJobFor 依影片記錄組出轉碼工作，剪輯的片段附上剪輯範圍
+func TranscodingJobFor(video *Video) TranscodingJob {
+	job := TranscodingJob{
+		VideoID:  video.ID,
+		FileName: video.FileName,
+		Type:     video.Type,
+	}
+	if video.SourceVideoID != nil {
+		job.Clip = &ClipJob{
+			SourceVideoID: *video.SourceVideoID,
+			StartMs:       video.ClipStartMs,
+			EndMs:         video.ClipEndMs,
+		}
+	}
+	return job
+}
+
+// ReconcileResult StuckJobReconciler 一次檢查的結果
+type ReconcileResult struct {
+	Republished int
+	Failed      int
+	Skipped     int // 檢查期間 Worker 更新了 updated_at 或已完成，不再視為卡住
 }
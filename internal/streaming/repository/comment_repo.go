@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CommentRepo definition 影片留言存取（MongoDB）
+type CommentRepo interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, comment *domain.Comment) error
+	GetByID(ctx context.Context, id string) (*domain.Comment, error)
+	UpdateContent(ctx context.Context, id, content string, editedAt time.Time) error
+	Delete(ctx context.Context, id string) error
+	SoftDelete(ctx context.Context, id string) error
+	IncrReplyCount(ctx context.Context, id string, delta int64) error
+	SetPinned(ctx context.Context, videoID uint, id string, pinned bool) error
+	GetPinned(ctx context.Context, videoID uint) (*domain.Comment, error)
+	List(ctx context.Context, query domain.CommentQuery) ([]domain.Comment, error)
+	HourlyCounts(ctx context.Context, since time.Time) ([]domain.HourlyActivity, error)
+}
+
+type commentRepo struct {
+	coll *mongo.Collection
+}
+
+// NewMongoCommentRepository create CommentRepo，留言與聊天訊息分開存放於 video_comments
+func NewMongoCommentRepository(db *mongo.Database) CommentRepo {
+	return &commentRepo{
+		coll: db.Collection("video_comments"),
+	}
+}
+
+// EnsureIndexes 建立列表查詢所需的索引
+func (r *commentRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "video_id", Value: 1}, {Key: "parent_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "video_id", Value: 1}, {Key: "parent_id", Value: 1}, {Key: "reply_count", Value: -1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "video_id", Value: 1}, {Key: "pinned", Value: 1}}},
+	})
+	return err
+}
+
+// Create 新增留言，由 ObjectID 產生留言 ID
+func (r *commentRepo) Create(ctx context.Context, comment *domain.Comment) error {
+	comment.ID = primitive.NewObjectID().Hex()
+	_, err := r.coll.InsertOne(ctx, comment)
+	return err
+}
+
+// GetByID get Comment by id
+func (r *commentRepo) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	var comment domain.Comment
+	if err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// UpdateContent 修改留言內容並記錄修改時間
+func (r *commentRepo) UpdateContent(ctx context.Context, id, content string, editedAt time.Time) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"content": content, "edited_at": editedAt},
+	})
+	return err
+}
+
+// Delete 刪除留言
+func (r *commentRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// SoftDelete 清除留言內容並標記為已刪除，保留其下的回覆
+func (r *commentRepo) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"content": "", "deleted": true, "pinned": false},
+	})
+	return err
+}
+
+// IncrReplyCount 調整頂層留言的回覆數
+func (r *commentRepo) IncrReplyCount(ctx context.Context, id string, delta int64) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"reply_count": delta}})
+	return err
+}
+
+// SetPinned 置頂或取消置頂，每部影片只會有一則置頂留言
+func (r *commentRepo) SetPinned(ctx context.Context, videoID uint, id string, pinned bool) error {
+	if pinned {
+		if _, err := r.coll.UpdateMany(ctx,
+			bson.M{"video_id": videoID, "pinned": true, "_id": bson.M{"$ne": id}},
+			bson.M{"$set": bson.M{"pinned": false}}); err != nil {
+			return err
+		}
+	}
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"pinned": pinned}})
+	return err
+}
+
+// GetPinned 取得影片的置頂留言，沒有時回傳 nil, nil
+func (r *commentRepo) GetPinned(ctx context.Context, videoID uint) (*domain.Comment, error) {
+	var comment domain.Comment
+	err := r.coll.FindOne(ctx, bson.M{"video_id": videoID, "pinned": true}).Decode(&comment)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// List 以游標分頁列出留言（keyset pagination）
+//   - 頂層留言：排除置頂留言與沒有回覆的已刪除留言，依 top（回覆數、時間）或 newest（時間）降冪
+//   - 回覆：依時間先後升冪
+//
+// 排序欄位最後都以 _id 決定先後，游標記錄上一頁最後一筆的排序欄位，下一頁從其後開始
+func (r *commentRepo) List(ctx context.Context, query domain.CommentQuery) ([]domain.Comment, error) {
+	filter := bson.M{"video_id": query.VideoID, "parent_id": query.ParentID}
+	var sortKeys []string
+	direction := -1
+	switch {
+	case query.ParentID != "":
+		sortKeys = []string{"created_at", "_id"}
+		direction = 1
+	case query.Sort == domain.CommentSortTop:
+		sortKeys = []string{"reply_count", "created_at", "_id"}
+	default:
+		sortKeys = []string{"created_at", "_id"}
+	}
+	if query.ParentID == "" {
+		filter["pinned"] = false
+		filter["$or"] = bson.A{
+			bson.M{"deleted": false},
+			bson.M{"reply_count": bson.M{"$gt": 0}},
+		}
+	}
+
+	if query.Cursor != nil {
+		values := map[string]interface{}{
+			"reply_count": query.Cursor.ReplyCount,
+			"created_at":  time.UnixMilli(query.Cursor.CreatedAt),
+			"_id":         query.Cursor.ID,
+		}
+		filter = bson.M{"$and": bson.A{filter, afterCursor(sortKeys, values, direction)}}
+	}
+
+	sort := bson.D{}
+	for _, key := range sortKeys {
+		sort = append(sort, bson.E{Key: key, Value: direction})
+	}
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(sort).SetLimit(int64(query.Limit)))
+	if err != nil {
+		return nil, err
+	}
+	comments := []domain.Comment{}
+	if err := cur.All(ctx, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// HourlyCounts 取得 since 之後每部影片每小時新增的留言數（含回覆）
+func (r *commentRepo) HourlyCounts(ctx context.Context, since time.Time) ([]domain.HourlyActivity, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.M{"created_at": bson.M{"$gte": since}, "deleted": false}}},
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"video_id": "$video_id",
+				"hour":     bson.M{"$dateTrunc": bson.M{"date": "$created_at", "unit": "hour"}},
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$project", Value: bson.M{"_id": 0, "video_id": "$_id.video_id", "hour": "$_id.hour", "count": 1}}},
+	}
+	cur, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var activities []domain.HourlyActivity
+	if err := cur.All(ctx, &activities); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// afterCursor 組出 (k1, k2, ...) 在游標之後的條件：
+// k1 > v1 OR (k1 = v1 AND k2 > v2) OR ...，降冪時改用 $lt
+func afterCursor(keys []string, values map[string]interface{}, direction int) bson.M {
+	op := "$gt"
+	if direction < 0 {
+		op = "$lt"
+	}
+	or := bson.A{}
+	for i, key := range keys {
+		cond := bson.M{}
+		for _, prev := range keys[:i] {
+			cond[prev] = values[prev]
+		}
+		cond[key] = bson.M{op: values[key]}
+		or = append(or, cond)
+	}
+	return bson.M{"$or": or}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DownloadRepo definition 下載檔案與下載次數存取
+type DownloadRepo interface {
+	AutoMigrate() error
+	Get(videoID uint, quality domain.DownloadQuality) (*domain.VideoDownload, error)
+	Save(download *domain.VideoDownload) error
+	SaveWithEvents(download *domain.VideoDownload, events []domain.OutboxEvent) error
+	CreateGrant(grant *domain.DownloadGrant) error
+	CountGrantsSince(memberID string, since time.Time) (int64, error)
+	SetDownloadsDisabled(videoID uint, disabled bool) error
+}
+
+type downloadRepo struct {
+	db *gorm.DB
+}
+
+// NewDownloadRepo create DownloadRepo
+func NewDownloadRepo(db *gorm.DB) DownloadRepo {
+	return &downloadRepo{db: db}
+}
+
+// AutoMigrate 建立 video_downloads 與 download_grants 資料表
+func (r *downloadRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.VideoDownload{}, &domain.DownloadGrant{})
+}
+
+// Get 取得影片某個畫質的下載檔案，尚未產生時回傳 nil, nil
+func (r *downloadRepo) Get(videoID uint, quality domain.DownloadQuality) (*domain.VideoDownload, error) {
+	var download domain.VideoDownload
+	err := r.db.Where("video_id = ? AND quality = ?", videoID, quality).Take(&download).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &download, nil
+}
+
+// Save 新增或覆寫下載檔案的狀態
+func (r *downloadRepo) Save(download *domain.VideoDownload) error {
+	return upsertDownload(r.db, download)
+}
+
+// SaveWithEvents 與 Save 相同，並在同一個交易內寫入 outbox 事件
+func (r *downloadRepo) SaveWithEvents(download *domain.VideoDownload, events []domain.OutboxEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := upsertDownload(tx, download); err != nil {
+			return err
+		}
+		return addOutboxEvents(tx, events)
+	})
+}
+
+func upsertDownload(db *gorm.DB, download *domain.VideoDownload) error {
+	download.UpdatedAt = time.Now()
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}, {Name: "quality"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "object_key", "size_bytes", "source_ready_at", "updated_at"}),
+	}).Create(download).Error
+}
+
+// CreateGrant 記錄發出的下載連結
+func (r *downloadRepo) CreateGrant(grant *domain.DownloadGrant) error {
+	return r.db.Create(grant).Error
+}
+
+// CountGrantsSince 會員在 since 之後取得的下載連結數
+func (r *downloadRepo) CountGrantsSince(memberID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.DownloadGrant{}).
+		Where("member_id = ? AND created_at >= ?", memberID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// SetDownloadsDisabled 上傳者停用或開放下載，只更新 downloads_disabled 避免覆寫其他欄位
+func (r *downloadRepo) SetDownloadsDisabled(videoID uint, disabled bool) error {
+	return r.db.Model(&domain.Video{}).Where("id = ?", videoID).Update("downloads_disabled", disabled).Error
+}
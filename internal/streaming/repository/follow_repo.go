@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FollowRepo definition 頻道追蹤存取
+type FollowRepo interface {
+	AutoMigrate() error
+	Follow(memberID, channelID string) error
+	Unfollow(memberID, channelID string) error
+	ListFollowing(memberID string) ([]string, error)
+}
+
+type followRepo struct {
+	db *gorm.DB
+}
+
+// NewFollowRepo create FollowRepo
+func NewFollowRepo(db *gorm.DB) FollowRepo {
+	return &followRepo{db: db}
+}
+
+// AutoMigrate 建立 channel_follows 資料表
+func (r *followRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.ChannelFollow{})
+}
+
+// Follow 追蹤頻道，重複追蹤不會報錯
+func (r *followRepo) Follow(memberID, channelID string) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.ChannelFollow{
+		MemberID:  memberID,
+		ChannelID: channelID,
+	}).Error
+}
+
+// Unfollow 取消追蹤頻道
+func (r *followRepo) Unfollow(memberID, channelID string) error {
+	return r.db.Where("member_id = ? AND channel_id = ?", memberID, channelID).
+		Delete(&domain.ChannelFollow{}).Error
+}
+
+// ListFollowing 列出會員追蹤的頻道
+func (r *followRepo) ListFollowing(memberID string) ([]string, error) {
+	var channelIDs []string
+	if err := r.db.Model(&domain.ChannelFollow{}).
+		Where("member_id = ?", memberID).
+		Pluck("channel_id", &channelIDs).Error; err != nil {
+		return nil, err
+	}
+	return channelIDs, nil
+}
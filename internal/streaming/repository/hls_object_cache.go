@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// HLSObjectCache definition 多個 replica 共用的 HLS 物件快取（Redis）
+type HLSObjectCache interface {
+	// Get 取得物件內容，不存在時回傳 nil, false
+	Get(ctx context.Context, objectKey string) ([]byte, bool, error)
+	Set(ctx context.Context, objectKey string, data []byte, ttl time.Duration) error
+}
+
+type redisHLSObjectCache struct {
+	client *redis.Client
+}
+
+// NewHLSObjectCache create HLSObjectCache
+func NewHLSObjectCache(client *redis.Client) HLSObjectCache {
+	return &redisHLSObjectCache{client: client}
+}
+
+func hlsObjectKey(objectKey string) string {
+	return "hls:object:" + objectKey
+}
+
+// Get 取得物件內容
+func (c *redisHLSObjectCache) Get(ctx context.Context, objectKey string) ([]byte, bool, error) {
+	data, err := c.client.Get(ctx, hlsObjectKey(objectKey)).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// Set 寫入物件內容，ttl 到期後由 Redis 清除
+func (c *redisHLSObjectCache) Set(ctx context.Context, objectKey string, data []byte, ttl time.Duration) error {
+	return c.client.Set(ctx, hlsObjectKey(objectKey), data, ttl).Err()
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// LeaderLock definition 多個 replica 之間的 leader lock（Redis）
+type LeaderLock interface {
+	Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, key, owner string) error
+}
+
+type redisLeaderLock struct {
+	client *redis.Client
+}
+
+// NewLeaderLock create LeaderLock
+func NewLeaderLock(client *redis.Client) LeaderLock {
+	return &redisLeaderLock{client: client}
+}
+
+// acquireScript 已是 owner 時延長 TTL，否則在 key 不存在時取得
+var acquireScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+// releaseScript 只有 owner 可以釋放，避免刪除到其他 replica 取得的 lock
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Acquire 取得或續約 leader lock，回傳是否為 leader
+// leader 需在 ttl 內再次呼叫續約，停止續約（例如 replica 當機）後由其他 replica 接手
+func (l *redisLeaderLock) Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	result, err := acquireScript.Run(ctx, l.client, []string{key}, owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return result == 1, nil
+}
+
+// Release 釋放 leader lock，例如服務停止時讓其他 replica 立即接手
+func (l *redisLeaderLock) Release(ctx context.Context, key, owner string) error {
+	return releaseScript.Run(ctx, l.client, []string{key}, owner).Err()
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+)
+
+// ErrReportNotClaimed 檢舉不是由這位管理員認領中，可能已被處理或被其他管理員重新認領
+var ErrReportNotClaimed = errors.New("檢舉未由此管理員認領")
+
+// ModerationRepo definition 檢舉審核佇列與稽核紀錄存取
+type ModerationRepo interface {
+	AutoMigrate() error
+	CreateReport(report *domain.VideoReport) error
+	HasPendingReport(videoID uint, reporterID string) (bool, error)
+	GetReport(id uint) (*domain.VideoReport, error)
+	ListReports(status domain.ReportStatus, videoID uint, offset, limit int) ([]domain.VideoReport, int64, error)
+	ClaimReport(id uint, memberID string, now time.Time) (bool, error)
+	ResolveReport(report *domain.VideoReport, video *domain.Video, note string) ([]domain.Video, error)
+	ListAudits(videoID, reportID uint, offset, limit int) ([]domain.ModerationAudit, int64, error)
+	SetRating(video *domain.Video, audit *domain.ModerationAudit) ([]domain.Video, error)
+}
+
+type moderationRepo struct {
+	db *gorm.DB
+}
+
+// NewModerationRepo create ModerationRepo
+func NewModerationRepo(db *gorm.DB) ModerationRepo {
+	return &moderationRepo{db: db}
+}
+
+// AutoMigrate 建立 video_reports 與 moderation_audits 資料表
+func (r *moderationRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.VideoReport{}, &domain.ModerationAudit{})
+}
+
+// CreateReport 新增檢舉
+func (r *moderationRepo) CreateReport(report *domain.VideoReport) error {
+	return r.db.Create(report).Error
+}
+
+// HasPendingReport 會員對影片是否已有未處理的檢舉
+func (r *moderationRepo) HasPendingReport(videoID uint, reporterID string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.VideoReport{}).
+		Where("video_id = ? AND reporter_id = ? AND status IN ?", videoID, reporterID,
+			[]domain.ReportStatus{domain.ReportOpen, domain.ReportClaimed}).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetReport get VideoReport by id
+func (r *moderationRepo) GetReport(id uint) (*domain.VideoReport, error) {
+	var report domain.VideoReport
+	if err := r.db.First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// ListReports 分頁列出指定狀態的檢舉，最早的排在最前面，並回傳總筆數
+func (r *moderationRepo) ListReports(status domain.ReportStatus, videoID uint, offset, limit int) ([]domain.VideoReport, int64, error) {
+	query := func() *gorm.DB {
+		db := r.db.Model(&domain.VideoReport{}).Where("status = ?", status)
+		if videoID != 0 {
+			db = db.Where("video_id = ?", videoID)
+		}
+		return db
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var reports []domain.VideoReport
+	if err := query().Order("created_at, id").Offset(offset).Limit(limit).Find(&reports).Error; err != nil {
+		return nil, 0, err
+	}
+	return reports, total, nil
+}
+
+// ClaimReport 以條件更新認領檢舉並寫入稽核紀錄，回傳是否認領成功
+// open 的檢舉、自己認領中的檢舉，或其他管理員認領超過 ReportClaimTimeout 的檢舉可以認領
+func (r *moderationRepo) ClaimReport(id uint, memberID string, now time.Time) (bool, error) {
+	claimed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.VideoReport{}).
+			Where("id = ?", id).
+			Where("status = ? OR (status = ? AND (claimed_by = ? OR claimed_at < ?))",
+				domain.ReportOpen, domain.ReportClaimed, memberID, now.Add(-domain.ReportClaimTimeout)).
+			Updates(map[string]interface{}{
+				"status":     domain.ReportClaimed,
+				"claimed_by": memberID,
+				"claimed_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var report domain.VideoReport
+		if err := tx.First(&report, id).Error; err != nil {
+			return err
+		}
+		claimed = true
+		return tx.Create(&domain.ModerationAudit{
+			ReportID:  report.ID,
+			VideoID:   report.VideoID,
+			ActorID:   memberID,
+			Action:    string(domain.ModerationClaim),
+			CreatedAt: now,
+		}).Error
+	})
+	return claimed, err
+}
+
+// ResolveReport 在同一個交易內處理檢舉，report 需已填入 Resolution、ResolvedBy、ResolvedAt
+//   - 檢舉需仍由 ResolvedBy 認領中，否則回傳 ErrReportNotClaimed
+//   - video 不為 nil 時更新影片的審核狀態，並一併處理同一部影片其他未處理的檢舉
+//   - 下架時 status 改為 blocked；其餘動作不寫入 status，避免覆寫 Worker 的轉碼狀態
+//   - 隱藏、年齡限制與下架一併套用到由影片剪出的片段（含轉碼中的片段），回傳受影響的片段
+//   - 每筆處理的檢舉各寫入一筆稽核紀錄
+func (r *moderationRepo) ResolveReport(report *domain.VideoReport, video *domain.Video, note string) ([]domain.Video, error) {
+	var clips []domain.Video
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		resolved := map[string]interface{}{
+			"status":      domain.ReportResolved,
+			"resolution":  report.Resolution,
+			"resolved_by": report.ResolvedBy,
+			"resolved_at": report.ResolvedAt,
+		}
+		result := tx.Model(&domain.VideoReport{}).
+			Where("id = ? AND status = ? AND claimed_by = ?", report.ID, domain.ReportClaimed, report.ResolvedBy).
+			Updates(resolved)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrReportNotClaimed
+		}
+		reportIDs := []uint{report.ID}
+
+		if video != nil {
+			moderated := map[string]interface{}{
+				"hidden":         video.Hidden,
+				"content_rating": video.ContentRating,
+				"rating_locked":  video.RatingLocked,
+			}
+			if video.IsBlocked() {
+				moderated["status"] = domain.VideoBlocked
+			}
+			if err := tx.Model(&domain.Video{}).Where("id = ?", video.ID).Updates(moderated).Error; err != nil {
+				return err
+			}
+			var err error
+			if clips, err = moderateClips(tx, video.ID, clipModeration(video)); err != nil {
+				return err
+			}
+
+			var siblingIDs []uint
+			pending := tx.Model(&domain.VideoReport{}).
+				Where("video_id = ? AND id <> ? AND status IN ?", video.ID, report.ID,
+					[]domain.ReportStatus{domain.ReportOpen, domain.ReportClaimed})
+			if err := pending.Pluck("id", &siblingIDs).Error; err != nil {
+				return err
+			}
+			if len(siblingIDs) > 0 {
+				if err := tx.Model(&domain.VideoReport{}).Where("id IN ?", siblingIDs).Updates(resolved).Error; err != nil {
+					return err
+				}
+				reportIDs = append(reportIDs, siblingIDs...)
+			}
+		}
+
+		audits := make([]domain.ModerationAudit, len(reportIDs))
+		for index, reportID := range reportIDs {
+			audits[index] = domain.ModerationAudit{
+				ReportID:  reportID,
+				VideoID:   report.VideoID,
+				ActorID:   report.ResolvedBy,
+				Action:    report.Resolution,
+				Note:      note,
+				CreatedAt: *report.ResolvedAt,
+			}
+		}
+		return tx.Create(&audits).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clips, nil
+}
+
+// clipModeration 影片的審核結果中要套用到片段的欄位：只會加上隱藏或下架，不解除片段本身的審核；
+// 分級由管理員鎖定時片段沿用同一個分級
+func clipModeration(video *domain.Video) map[string]interface{} {
+	moderated := map[string]interface{}{}
+	if video.Hidden {
+		moderated["hidden"] = true
+	}
+	if video.RatingLocked {
+		moderated["content_rating"] = video.ContentRating
+		moderated["rating_locked"] = true
+	}
+	if video.IsBlocked() {
+		moderated["status"] = domain.VideoBlocked
+	}
+	return moderated
+}
+
+// moderateClips 更新由 videoID 剪出的片段並回傳更新後的片段，moderated 為空時不做任何事
+func moderateClips(tx *gorm.DB, videoID uint, moderated map[string]interface{}) ([]domain.Video, error) {
+	if len(moderated) == 0 {
+		return nil, nil
+	}
+	clips := tx.Model(&domain.Video{}).Where("source_video_id = ?", videoID)
+	if err := clips.Updates(moderated).Error; err != nil {
+		return nil, err
+	}
+	var updated []domain.Video
+	if err := tx.Where("source_video_id = ?", videoID).Find(&updated).Error; err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetRating 更新影片分級與鎖定狀態，audit 不為 nil 時在同一個交易內寫入稽核紀錄
+// 管理員鎖定的分級一併套用到由影片剪出的片段，回傳受影響的片段
+func (r *moderationRepo) SetRating(video *domain.Video, audit *domain.ModerationAudit) ([]domain.Video, error) {
+	var clips []domain.Video
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Video{}).Where("id = ?", video.ID).Updates(map[string]interface{}{
+			"content_rating": video.ContentRating,
+			"rating_locked":  video.RatingLocked,
+		}).Error; err != nil {
+			return err
+		}
+		if audit != nil {
+			if err := tx.Create(audit).Error; err != nil {
+				return err
+			}
+		}
+		if !video.RatingLocked {
+			return nil
+		}
+		var err error
+		clips, err = moderateClips(tx, video.ID, map[string]interface{}{
+			"content_rating": video.ContentRating,
+			"rating_locked":  true,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clips, nil
+}
+
+// ListAudits 分頁列出影片或檢舉的稽核紀錄，最新的排在最前面，並回傳總筆數
+func (r *moderationRepo) ListAudits(videoID, reportID uint, offset, limit int) ([]domain.ModerationAudit, int64, error) {
+	query := func() *gorm.DB {
+		db := r.db.Model(&domain.ModerationAudit{})
+		if videoID != 0 {
+			db = db.Where("video_id = ?", videoID)
+		}
+		if reportID != 0 {
+			db = db.Where("report_id = ?", reportID)
+		}
+		return db
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var audits []domain.ModerationAudit
+	if err := query().Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&audits).Error; err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
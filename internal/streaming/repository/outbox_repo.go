@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+)
+
+// OutboxRepo definition 交易式外送箱存取，事件由各 repo 在自己的交易內以 addOutboxEvents 寫入
+type OutboxRepo interface {
+	AutoMigrate() error
+	Save(events []domain.OutboxEvent) error
+	ListPending(limit int) ([]domain.OutboxEvent, error)
+	MarkSent(id uint, sentAt time.Time) error
+	MarkFailed(id uint, reason string) error
+	DeleteSentBefore(before time.Time) (int64, error)
+}
+
+type outboxRepo struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepo create OutboxRepo
+func NewOutboxRepo(db *gorm.DB) OutboxRepo {
+	return &outboxRepo{db: db}
+}
+
+// AutoMigrate 建立 outbox_events 資料表
+func (r *outboxRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.OutboxEvent{})
+}
+
+// Save 直接寫入事件，供沒有 Postgres 資料列可共用交易的事件使用（例如存在 MongoDB 的留言、QoE beacon）
+func (r *outboxRepo) Save(events []domain.OutboxEvent) error {
+	return addOutboxEvents(r.db, events)
+}
+
+// ListPending 依寫入順序列出等待發布的事件
+func (r *outboxRepo) ListPending(limit int) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+	if err := r.db.Where("status = ?", domain.OutboxPending).
+		Order("id").Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// MarkSent 標記事件已發布
+func (r *outboxRepo) MarkSent(id uint, sentAt time.Time) error {
+	return r.db.Model(&domain.OutboxEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":  domain.OutboxSent,
+		"sent_at": sentAt,
+	}).Error
+}
+
+// MarkFailed 記錄發布失敗，未達 OutboxMaxAttempts 次時維持 pending 由下一輪重試，否則標記為 dead
+func (r *outboxRepo) MarkFailed(id uint, reason string) error {
+	return r.db.Model(&domain.OutboxEvent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"attempts":   gorm.Expr("COALESCE(attempts, 0) + 1"),
+		"last_error": reason,
+		"status":     gorm.Expr("CASE WHEN COALESCE(attempts, 0) + 1 >= ? THEN ? ELSE status END", domain.OutboxMaxAttempts, domain.OutboxDead),
+	}).Error
+}
+
+// DeleteSentBefore 清除 before 之前已發布的事件，回傳清除筆數
+func (r *outboxRepo) DeleteSentBefore(before time.Time) (int64, error) {
+	result := r.db.Where("status = ? AND sent_at < ?", domain.OutboxSent, before).Delete(&domain.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// addOutboxEvents 在呼叫端的交易內寫入事件，與領域資料一起 commit 或 rollback
+func addOutboxEvents(tx *gorm.DB, events []domain.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return tx.Create(&events).Error
+}
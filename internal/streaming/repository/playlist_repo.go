@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlaylistRepo definition playlist & playlist item 存取
+type PlaylistRepo interface {
+	AutoMigrate() error
+	Create(playlist *domain.Playlist) error
+	GetByID(id uint) (*domain.Playlist, error)
+	Update(playlist *domain.Playlist) error
+	Delete(id uint) error
+	ListByMember(memberID string) ([]domain.Playlist, error)
+	GetOrCreateWatchLater(memberID string) (*domain.Playlist, error)
+	CountItems(playlistID uint) (int64, error)
+	AddItem(playlistID, videoID uint) error
+	RemoveItem(playlistID, videoID uint) error
+	ListItems(playlistID uint) ([]domain.PlaylistItem, error)
+	ReorderItems(playlistID uint, videoIDs []uint) error
+}
+
+type playlistRepo struct {
+	db *gorm.DB
+}
+
+// NewPlaylistRepo create PlaylistRepo
+func NewPlaylistRepo(db *gorm.DB) PlaylistRepo {
+	return &playlistRepo{db: db}
+}
+
+// AutoMigrate 建立 playlists、playlist_items 資料表
+// GORM tag 無法表達部分索引，每位會員唯一的「稍後觀看」索引另外建立，與 migration 005 相同
+func (r *playlistRepo) AutoMigrate() error {
+	if err := r.db.AutoMigrate(&domain.Playlist{}, &domain.PlaylistItem{}); err != nil {
+		return err
+	}
+	return r.db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_playlists_watch_later ON playlists(member_id) WHERE kind = '" +
+		string(domain.PlaylistWatchLater) + "'").Error
+}
+
+// Create 建立播放清單
+func (r *playlistRepo) Create(playlist *domain.Playlist) error {
+	return r.db.Create(playlist).Error
+}
+
+// GetByID get Playlist by id
+func (r *playlistRepo) GetByID(id uint) (*domain.Playlist, error) {
+	var p domain.Playlist
+	if err := r.db.First(&p, id).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Update 更新播放清單名稱、可見度
+func (r *playlistRepo) Update(playlist *domain.Playlist) error {
+	return r.db.Save(playlist).Error
+}
+
+// Delete 刪除播放清單與其所有項目
+func (r *playlistRepo) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("playlist_id = ?", id).Delete(&domain.PlaylistItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Playlist{}, id).Error
+	})
+}
+
+// ListByMember 列出會員的播放清單，「稍後觀看」排在最前面
+func (r *playlistRepo) ListByMember(memberID string) ([]domain.Playlist, error) {
+	var playlists []domain.Playlist
+	if err := r.db.Where("member_id = ?", memberID).
+		Order(clause.Expr{SQL: "kind = ? DESC, id", Vars: []interface{}{domain.PlaylistWatchLater}}).
+		Find(&playlists).Error; err != nil {
+		return nil, err
+	}
+	return playlists, nil
+}
+
+// GetOrCreateWatchLater 取得會員的「稍後觀看」清單，不存在時自動建立
+func (r *playlistRepo) GetOrCreateWatchLater(memberID string) (*domain.Playlist, error) {
+	p := domain.Playlist{
+		MemberID:   memberID,
+		Title:      domain.WatchLaterTitle,
+		Kind:       string(domain.PlaylistWatchLater),
+		Visibility: string(domain.VisibilityPrivate),
+	}
+	if err := r.db.Where("member_id = ? AND kind = ?", memberID, domain.PlaylistWatchLater).
+		FirstOrCreate(&p).Error; err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CountItems 取得播放清單影片數
+func (r *playlistRepo) CountItems(playlistID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&domain.PlaylistItem{}).Where("playlist_id = ?", playlistID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AddItem 將影片加到播放清單最後，已存在則略過
+func (r *playlistRepo) AddItem(playlistID, videoID uint) error {
+	return r.db.Exec(
+		`INSERT INTO playlist_items (playlist_id, video_id, position, created_at)
+		 SELECT ?, ?, COALESCE(MAX(position) + 1, 0), NOW() FROM playlist_items WHERE playlist_id = ?
+		 ON CONFLICT (playlist_id, video_id) DO NOTHING`,
+		playlistID, videoID, playlistID,
+	).Error
+}
+
+// RemoveItem 將影片從播放清單移除
+func (r *playlistRepo) RemoveItem(playlistID, videoID uint) error {
+	return r.db.Where("playlist_id = ? AND video_id = ?", playlistID, videoID).Delete(&domain.PlaylistItem{}).Error
+}
+
+// ListItems 依播放順序列出播放清單項目
+func (r *playlistRepo) ListItems(playlistID uint) ([]domain.PlaylistItem, error) {
+	var items []domain.PlaylistItem
+	if err := r.db.Where("playlist_id = ?", playlistID).Order("position, created_at").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ReorderItems 依 videoIDs 的順序重設 position
+func (r *playlistRepo) ReorderItems(playlistID uint, videoIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for position, videoID := range videoIDs {
+			if err := tx.Model(&domain.PlaylistItem{}).
+				Where("playlist_id = ? AND video_id = ?", playlistID, videoID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
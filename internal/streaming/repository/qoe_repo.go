@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QoERepo definition 播放 QoE 統計存取
+type QoERepo interface {
+	AutoMigrate() error
+	Accumulate(stats []domain.VideoQoEDaily) error
+	SumByRendition(videoID uint, since time.Time) ([]domain.VideoQoEDaily, error)
+}
+
+type qoeRepo struct {
+	db *gorm.DB
+}
+
+// NewQoERepo create QoERepo
+func NewQoERepo(db *gorm.DB) QoERepo {
+	return &qoeRepo{db: db}
+}
+
+// AutoMigrate 建立 video_qoe_daily 資料表
+func (r *qoeRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.VideoQoEDaily{})
+}
+
+// Accumulate 在同一個交易內將每筆統計累加到當天的記錄
+func (r *qoeRepo) Accumulate(stats []domain.VideoQoEDaily) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for index := range stats {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "video_id"}, {Name: "rendition"}, {Name: "day"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"plays":            gorm.Expr("video_qoe_daily.plays + excluded.plays"),
+					"startup_ms_sum":   gorm.Expr("video_qoe_daily.startup_ms_sum + excluded.startup_ms_sum"),
+					"rebuffer_count":   gorm.Expr("video_qoe_daily.rebuffer_count + excluded.rebuffer_count"),
+					"rebuffer_ms_sum":  gorm.Expr("video_qoe_daily.rebuffer_ms_sum + excluded.rebuffer_ms_sum"),
+					"bitrate_switches": gorm.Expr("video_qoe_daily.bitrate_switches + excluded.bitrate_switches"),
+					"errors":           gorm.Expr("video_qoe_daily.errors + excluded.errors"),
+					"dropped_frames":   gorm.Expr("video_qoe_daily.dropped_frames + excluded.dropped_frames"),
+				}),
+			}).Create(&stats[index]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SumByRendition 加總 since 之後每個 rendition 的統計，依 rendition 名稱排序
+func (r *qoeRepo) SumByRendition(videoID uint, since time.Time) ([]domain.VideoQoEDaily, error) {
+	var totals []domain.VideoQoEDaily
+	if err := r.db.Model(&domain.VideoQoEDaily{}).
+		Select(`rendition, SUM(plays) AS plays, SUM(startup_ms_sum) AS startup_ms_sum,
+			SUM(rebuffer_count) AS rebuffer_count, SUM(rebuffer_ms_sum) AS rebuffer_ms_sum,
+			SUM(bitrate_switches) AS bitrate_switches, SUM(errors) AS errors, SUM(dropped_frames) AS dropped_frames`).
+		Where("video_id = ? AND day >= ?", videoID, since.UTC().Truncate(24*time.Hour)).
+		Group("rendition").
+		Order("rendition").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QuotaRepo definition 上傳配額與用量存取
+type QuotaRepo interface {
+	AutoMigrate() error
+	GetMemberQuota(memberID string) (*domain.MemberQuota, error)
+	SetMemberQuota(quota *domain.MemberQuota) error
+	GetUsage(memberID string) (*domain.QuotaUsage, error)
+	CountUploadsSince(memberID string, since time.Time) (int64, error)
+	ReserveUpload(memberID string, limits domain.QuotaLimits, sizeBytes int64, now time.Time) (*domain.UploadRecord, error)
+	ResizeUpload(record *domain.UploadRecord, sizeBytes int64, limits domain.QuotaLimits) error
+	ReleaseUpload(record *domain.UploadRecord) error
+	CompleteUpload(recordID, videoID uint) error
+}
+
+type quotaRepo struct {
+	db *gorm.DB
+}
+
+// NewQuotaRepo create QuotaRepo
+func NewQuotaRepo(db *gorm.DB) QuotaRepo {
+	return &quotaRepo{db: db}
+}
+
+// AutoMigrate 建立 member_quotas、quota_usages 與 upload_records 資料表
+func (r *quotaRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.MemberQuota{}, &domain.QuotaUsage{}, &domain.UploadRecord{})
+}
+
+// GetMemberQuota 取得會員個別設定的配額，沒有設定時回傳 nil, nil
+func (r *quotaRepo) GetMemberQuota(memberID string) (*domain.MemberQuota, error) {
+	var quota domain.MemberQuota
+	err := r.db.Where("member_id = ?", memberID).Take(&quota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// SetMemberQuota 新增或覆寫會員個別設定的配額
+func (r *quotaRepo) SetMemberQuota(quota *domain.MemberQuota) error {
+	quota.UpdatedAt = time.Now()
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "member_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_storage_bytes", "max_videos", "max_uploads_per_day", "updated_at"}),
+	}).Create(quota).Error
+}
+
+// GetUsage 取得會員目前的用量，尚未上傳過時回傳零值
+func (r *quotaRepo) GetUsage(memberID string) (*domain.QuotaUsage, error) {
+	usage := domain.QuotaUsage{MemberID: memberID}
+	err := r.db.Where("member_id = ?", memberID).Take(&usage).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// CountUploadsSince 會員在 since 之後預留或完成的上傳次數
+func (r *quotaRepo) CountUploadsSince(memberID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.UploadRecord{}).
+		Where("member_id = ? AND created_at >= ?", memberID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// ReserveUpload 接收檔案前在同一個交易內預留配額：以條件式更新增加用量，更新到資料列才建立上傳記錄
+// 同一會員的並行上傳會在 quota_usages 的資料列上排隊，不會同時通過檢查；超過配額時回傳 *domain.QuotaExceededError
+func (r *quotaRepo) ReserveUpload(memberID string, limits domain.QuotaLimits, sizeBytes int64, now time.Time) (*domain.UploadRecord, error) {
+	record := &domain.UploadRecord{MemberID: memberID, SizeBytes: sizeBytes, CreatedAt: now}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&domain.QuotaUsage{MemberID: memberID, UpdatedAt: now}).Error; err != nil {
+			return err
+		}
+		query := tx.Model(&domain.QuotaUsage{}).Where("member_id = ?", memberID)
+		if limits.MaxStorageBytes > 0 {
+			query = query.Where("stored_bytes + ? <= ?", sizeBytes, limits.MaxStorageBytes)
+		}
+		if limits.MaxVideos > 0 {
+			query = query.Where("video_count < ?", limits.MaxVideos)
+		}
+		result := query.Updates(map[string]interface{}{
+			"stored_bytes": gorm.Expr("stored_bytes + ?", sizeBytes),
+			"video_count":  gorm.Expr("video_count + 1"),
+			"updated_at":   now,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return quotaExceeded(tx, memberID, limits, sizeBytes, now)
+		}
+		// 已鎖住用量資料列，同一會員的其他預留需等此交易結束，次數不會超過上限
+		if limits.MaxUploadsPerDay > 0 {
+			var uploads int64
+			if err := tx.Model(&domain.UploadRecord{}).
+				Where("member_id = ? AND created_at >= ?", memberID, now.Add(-domain.QuotaUploadWindow)).
+				Count(&uploads).Error; err != nil {
+				return err
+			}
+			if uploads >= limits.MaxUploadsPerDay {
+				return quotaExceeded(tx, memberID, limits, sizeBytes, now)
+			}
+		}
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ResizeUpload 收完檔案後將預留的大小改為實際大小，增加的部分同樣以條件式更新檢查儲存配額
+func (r *quotaRepo) ResizeUpload(record *domain.UploadRecord, sizeBytes int64, limits domain.QuotaLimits) error {
+	delta := sizeBytes - record.SizeBytes
+	if delta == 0 {
+		return nil
+	}
+	now := time.Now()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&domain.QuotaUsage{}).Where("member_id = ?", record.MemberID)
+		if delta > 0 && limits.MaxStorageBytes > 0 {
+			query = query.Where("stored_bytes + ? <= ?", delta, limits.MaxStorageBytes)
+		}
+		result := query.Updates(map[string]interface{}{
+			"stored_bytes": gorm.Expr("GREATEST(stored_bytes + ?, 0)", delta),
+			"updated_at":   now,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var usage domain.QuotaUsage
+			if err := tx.Where("member_id = ?", record.MemberID).Take(&usage).Error; err != nil {
+				return err
+			}
+			// 預留的大小已計入用量，回報時扣除以免重複計算
+			return storageExceeded(record.MemberID, usage.StoredBytes-record.SizeBytes, limits, sizeBytes)
+		}
+		return tx.Model(record).Update("size_bytes", sizeBytes).Error
+	})
+	if err != nil {
+		return err
+	}
+	record.SizeBytes = sizeBytes
+	return nil
+}
+
+// ReleaseUpload 上傳失敗時刪除尚未關聯影片的上傳記錄並歸還預留的用量，重複呼叫不會重複歸還
+func (r *quotaRepo) ReleaseUpload(record *domain.UploadRecord) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND video_id = 0", record.ID).Delete(&domain.UploadRecord{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&domain.QuotaUsage{}).Where("member_id = ?", record.MemberID).Updates(map[string]interface{}{
+			"stored_bytes": gorm.Expr("GREATEST(stored_bytes - ?, 0)", record.SizeBytes),
+			"video_count":  gorm.Expr("GREATEST(video_count - 1, 0)"),
+			"updated_at":   time.Now(),
+		}).Error
+	})
+}
+
+// CompleteUpload 影片建立後將上傳記錄關聯到影片，之後用量改由刪除影片時釋放
+func (r *quotaRepo) CompleteUpload(recordID, videoID uint) error {
+	return r.db.Model(&domain.UploadRecord{}).Where("id = ?", recordID).Update("video_id", videoID).Error
+}
+
+// quotaExceeded 條件式更新未通過時，在同一個交易內讀取用量組出超過的配額項目
+func quotaExceeded(tx *gorm.DB, memberID string, limits domain.QuotaLimits, sizeBytes int64, now time.Time) error {
+	var usage domain.QuotaUsage
+	if err := tx.Where("member_id = ?", memberID).Take(&usage).Error; err != nil {
+		return err
+	}
+	var uploads int64
+	if err := tx.Model(&domain.UploadRecord{}).
+		Where("member_id = ? AND created_at >= ?", memberID, now.Add(-domain.QuotaUploadWindow)).
+		Count(&uploads).Error; err != nil {
+		return err
+	}
+	report := domain.QuotaReport{
+		Limits:       limits,
+		StoredBytes:  usage.StoredBytes,
+		VideoCount:   usage.VideoCount,
+		UploadsToday: uploads,
+	}
+	if exceeded := report.CheckUpload(memberID, sizeBytes); exceeded != nil {
+		return exceeded
+	}
+	return storageExceeded(memberID, usage.StoredBytes, limits, sizeBytes)
+}
+
+// storageExceeded 儲存空間不足
+func storageExceeded(memberID string, storedBytes int64, limits domain.QuotaLimits, sizeBytes int64) error {
+	return &domain.QuotaExceededError{Kind: domain.QuotaStorage,
+		Msg: fmt.Sprintf("memberID[%s] 已使用 %d / %d bytes，無法再上傳 %d bytes",
+			memberID, storedBytes, limits.MaxStorageBytes, sizeBytes)}
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// reactionCacheTTL 按讚數快取過期時間，過期後由 PostgreSQL 重建
+	// 表態時會刪除快取，TTL 只用來限制「重建讀到舊值後才寫入」這類競態留下舊值的時間
+	reactionCacheTTL = 5 * time.Minute
+	likesField       = "likes"
+	dislikesField    = "dislikes"
+)
+
+// setIfNotExists 僅在快取不存在時寫入，避免較慢的重建覆蓋其他請求已重建的快取
+var setIfNotExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+redis.call("HSET", KEYS[1], "likes", ARGV[1], "dislikes", ARGV[2])
+redis.call("EXPIRE", KEYS[1], ARGV[3])
+return 1
+`)
+
+// ReactionCache definition 影片按讚 / 倒讚數 Redis 快取
+type ReactionCache interface {
+	Get(ctx context.Context, videoID uint) (*domain.ReactionCounts, error)
+	SetNX(ctx context.Context, videoID uint, counts domain.ReactionCounts) error
+	Invalidate(ctx context.Context, videoID uint) error
+}
+
+type redisReactionCache struct {
+	client *redis.Client
+}
+
+// NewReactionCache create ReactionCache
+func NewReactionCache(client *redis.Client) ReactionCache {
+	return &redisReactionCache{client: client}
+}
+
+func reactionKey(videoID uint) string {
+	return fmt.Sprintf("video:reactions:%d", videoID)
+}
+
+// Get 取得快取的按讚 / 倒讚數，快取不存在時回傳 nil, nil
+func (c *redisReactionCache) Get(ctx context.Context, videoID uint) (*domain.ReactionCounts, error) {
+	values, err := c.client.HGetAll(ctx, reactionKey(videoID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	likes, err := strconv.ParseInt(values[likesField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	dislikes, err := strconv.ParseInt(values[dislikesField], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ReactionCounts{Likes: likes, Dislikes: dislikes}, nil
+}
+
+// SetNX 快取不存在時寫入按讚 / 倒讚數，已存在時不做任何事
+func (c *redisReactionCache) SetNX(ctx context.Context, videoID uint, counts domain.ReactionCounts) error {
+	ttl := int64(reactionCacheTTL / time.Second)
+	return setIfNotExists.Run(ctx, c.client, []string{reactionKey(videoID)}, counts.Likes, counts.Dislikes, ttl).Err()
+}
+
+// Invalidate 刪除快取，下次讀取時再由 PostgreSQL 重建
+func (c *redisReactionCache) Invalidate(ctx context.Context, videoID uint) error {
+	return c.client.Del(ctx, reactionKey(videoID)).Err()
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReactionRepo definition 影片按讚 / 倒讚存取
+type ReactionRepo interface {
+	AutoMigrate() error
+	SetReaction(videoID uint, memberID string, reaction domain.ReactionType) (domain.ReactionType, error)
+	GetReaction(videoID uint, memberID string) (domain.ReactionType, error)
+	GetCounts(videoID uint) (*domain.ReactionCounts, error)
+	ListLikedVideos(memberID string, offset, limit int) ([]domain.Video, int64, error)
+	HourlyLikes(since time.Time) ([]domain.HourlyActivity, error)
+}
+
+type reactionRepo struct {
+	db *gorm.DB
+}
+
+// NewReactionRepo create ReactionRepo
+func NewReactionRepo(db *gorm.DB) ReactionRepo {
+	return &reactionRepo{db: db}
+}
+
+// AutoMigrate 建立 video_reactions 資料表
+func (r *reactionRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.VideoReaction{})
+}
+
+// SetReaction 設定會員對影片的表態並回傳原本的表態
+// 與原本相同時不做任何事（重複按讚不會重複計數），不同時於同一個交易內更新 video_reactions 與 videos 的按讚 / 倒讚數
+func (r *reactionRepo) SetReaction(videoID uint, memberID string, reaction domain.ReactionType) (domain.ReactionType, error) {
+	prev := domain.ReactionNone
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		prev, err = lockReaction(tx, videoID, memberID)
+		if err != nil {
+			return err
+		}
+		if prev == reaction {
+			return nil
+		}
+
+		switch {
+		case reaction == domain.ReactionNone:
+			if err := tx.Where("video_id = ? AND member_id = ?", videoID, memberID).
+				Delete(&domain.VideoReaction{}).Error; err != nil {
+				return err
+			}
+		case prev == domain.ReactionNone:
+			// 同一位會員同時送出兩次時，後到的 INSERT 會被忽略，改以已存在的那筆為準
+			now := time.Now()
+			row := &domain.VideoReaction{
+				VideoID:   videoID,
+				MemberID:  memberID,
+				Reaction:  string(reaction),
+				UpdatedAt: now,
+			}
+			if reaction == domain.ReactionLike {
+				row.LikedAt = &now
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				if prev, err = lockReaction(tx, videoID, memberID); err != nil {
+					return err
+				}
+				if prev == reaction {
+					return nil
+				}
+				if err := updateReaction(tx, videoID, memberID, reaction); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := updateReaction(tx, videoID, memberID, reaction); err != nil {
+				return err
+			}
+		}
+
+		likes, dislikes := domain.ReactionDelta(prev, reaction)
+		return tx.Model(&domain.Video{}).Where("id = ?", videoID).Updates(map[string]interface{}{
+			"like_count":    gorm.Expr("like_count + ?", likes),
+			"dislike_count": gorm.Expr("dislike_count + ?", dislikes),
+		}).Error
+	})
+	return prev, err
+}
+
+// lockReaction 以 SELECT ... FOR UPDATE 取得目前的表態，不存在時回傳 ReactionNone
+func lockReaction(tx *gorm.DB, videoID uint, memberID string) (domain.ReactionType, error) {
+	var existing domain.VideoReaction
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("video_id = ? AND member_id = ?", videoID, memberID).
+		Take(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ReactionNone, nil
+	}
+	if err != nil {
+		return domain.ReactionNone, err
+	}
+	return domain.ReactionType(existing.Reaction), nil
+}
+
+// updateReaction 更新表態，改為按讚時只在第一次按讚時寫入 liked_at
+func updateReaction(tx *gorm.DB, videoID uint, memberID string, reaction domain.ReactionType) error {
+	now := time.Now()
+	updates := map[string]interface{}{"reaction": string(reaction), "updated_at": now}
+	if reaction == domain.ReactionLike {
+		updates["liked_at"] = gorm.Expr("COALESCE(liked_at, ?)", now)
+	}
+	return tx.Model(&domain.VideoReaction{}).
+		Where("video_id = ? AND member_id = ?", videoID, memberID).
+		Updates(updates).Error
+}
+
+// GetReaction 取得會員對影片的表態，未表態時回傳 ReactionNone
+func (r *reactionRepo) GetReaction(videoID uint, memberID string) (domain.ReactionType, error) {
+	var reaction domain.VideoReaction
+	err := r.db.Where("video_id = ? AND member_id = ?", videoID, memberID).Take(&reaction).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ReactionNone, nil
+	}
+	if err != nil {
+		return domain.ReactionNone, err
+	}
+	return domain.ReactionType(reaction.Reaction), nil
+}
+
+// GetCounts 由 videos 取得按讚 / 倒讚數
+func (r *reactionRepo) GetCounts(videoID uint) (*domain.ReactionCounts, error) {
+	var video domain.Video
+	if err := r.db.Select("like_count", "dislike_count").First(&video, videoID).Error; err != nil {
+		return nil, err
+	}
+	return &domain.ReactionCounts{
+		Likes:    int64(video.LikeCount),
+		Dislikes: int64(video.DislikeCount),
+	}, nil
+}
+
+// ListLikedVideos 列出會員按讚過的影片，最近按讚的排在最前面
+// 僅列出 ready 且會員仍可觀看的影片（非私人、自己上傳或被分享）
+func (r *reactionRepo) ListLikedVideos(memberID string, offset, limit int) ([]domain.Video, int64, error) {
+	var videos []domain.Video
+	var total int64
+	query := r.db.Model(&domain.Video{}).
+		Joins("JOIN video_reactions ON video_reactions.video_id = videos.id").
+		Where("video_reactions.member_id = ? AND video_reactions.reaction = ?", memberID, domain.ReactionLike).
+		Where("videos.status = ?", domain.VideoReady).
+		Where("(videos.visibility <> ? OR videos.member_id = ? OR videos.id IN (?))",
+			domain.VisibilityPrivate, memberID,
+			r.db.Model(&domain.VideoShare{}).Select("video_id").Where("member_id = ?", memberID))
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := query.Select("videos.*").
+		Order("video_reactions.updated_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	return videos, total, nil
+}
+
+// HourlyLikes 取得 since 之後每部影片每小時新增的按讚數（依第一次按讚的時間）
+// 改為倒讚再改回按讚不會把舊的按讚移到目前的小時
+func (r *reactionRepo) HourlyLikes(since time.Time) ([]domain.HourlyActivity, error) {
+	var activities []domain.HourlyActivity
+	if err := r.db.Model(&domain.VideoReaction{}).
+		Select("video_id, date_trunc('hour', liked_at) AS hour, COUNT(*) AS count").
+		Where("reaction = ? AND liked_at >= ?", domain.ReactionLike, since).
+		Group("video_id, date_trunc('hour', liked_at)").
+		Scan(&activities).Error; err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ShortsSeenCache definition 記錄會員（或訪客 session）已看過的短影音
+type ShortsSeenCache interface {
+	Seen(ctx context.Context, viewer string, videoIDs []uint) (map[uint]bool, error)
+	Add(ctx context.Context, viewer string, videoIDs []uint, ttl time.Duration) error
+	Reset(ctx context.Context, viewer string) error
+}
+
+type redisShortsSeenCache struct {
+	client *redis.Client
+}
+
+// NewShortsSeenCache create ShortsSeenCache
+func NewShortsSeenCache(client *redis.Client) ShortsSeenCache {
+	return &redisShortsSeenCache{client: client}
+}
+
+func shortsSeenKey(viewer string) string {
+	return fmt.Sprintf("shorts:seen:%s", viewer)
+}
+
+// Seen 回傳 videoIDs 中已看過的影片
+func (c *redisShortsSeenCache) Seen(ctx context.Context, viewer string, videoIDs []uint) (map[uint]bool, error) {
+	seen := make(map[uint]bool, len(videoIDs))
+	if len(videoIDs) == 0 {
+		return seen, nil
+	}
+	members := make([]interface{}, len(videoIDs))
+	for index, id := range videoIDs {
+		members[index] = id
+	}
+	result, err := c.client.SMIsMember(ctx, shortsSeenKey(viewer), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for index, ok := range result {
+		if ok {
+			seen[videoIDs[index]] = true
+		}
+	}
+	return seen, nil
+}
+
+// Add 記錄已看過的短影音，並延長紀錄的保留時間
+func (c *redisShortsSeenCache) Add(ctx context.Context, viewer string, videoIDs []uint, ttl time.Duration) error {
+	if len(videoIDs) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(videoIDs))
+	for index, id := range videoIDs {
+		members[index] = id
+	}
+	key := shortsSeenKey(viewer)
+	pipe := c.client.TxPipeline()
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Reset 清除已看過的紀錄，短影音全部看完後重新開始
+func (c *redisShortsSeenCache) Reset(ctx context.Context, viewer string) error {
+	return c.client.Del(ctx, shortsSeenKey(viewer)).Err()
+}
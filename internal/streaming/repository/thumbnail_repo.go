@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+)
+
+// ThumbnailRepo definition 影片候選縮圖存取
+type ThumbnailRepo interface {
+	AutoMigrate() error
+	ReplaceAuto(videoID uint, thumbnails []domain.VideoThumbnail) error
+	Create(thumbnail *domain.VideoThumbnail) error
+	Get(id uint) (*domain.VideoThumbnail, error)
+	ListByVideo(videoID uint) ([]domain.VideoThumbnail, error)
+	CountBySource(videoID uint, source domain.ThumbnailSource) (int64, error)
+	SetActive(videoID uint, name string) error
+}
+
+type thumbnailRepo struct {
+	db *gorm.DB
+}
+
+// NewThumbnailRepo create ThumbnailRepo
+func NewThumbnailRepo(db *gorm.DB) ThumbnailRepo {
+	return &thumbnailRepo{db: db}
+}
+
+// AutoMigrate 建立 video_thumbnails 資料表
+func (r *thumbnailRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.VideoThumbnail{})
+}
+
+// ReplaceAuto 以轉碼時挑選的候選縮圖取代影片原有的自動縮圖，自訂縮圖不受影響
+func (r *thumbnailRepo) ReplaceAuto(videoID uint, thumbnails []domain.VideoThumbnail) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ? AND source = ?", videoID, domain.ThumbnailAuto).
+			Delete(&domain.VideoThumbnail{}).Error; err != nil {
+			return err
+		}
+		if len(thumbnails) == 0 {
+			return nil
+		}
+		return tx.Create(&thumbnails).Error
+	})
+}
+
+// Create 新增縮圖
+func (r *thumbnailRepo) Create(thumbnail *domain.VideoThumbnail) error {
+	return r.db.Create(thumbnail).Error
+}
+
+// Get get VideoThumbnail by id
+func (r *thumbnailRepo) Get(id uint) (*domain.VideoThumbnail, error) {
+	var thumbnail domain.VideoThumbnail
+	if err := r.db.First(&thumbnail, id).Error; err != nil {
+		return nil, err
+	}
+	return &thumbnail, nil
+}
+
+// ListByVideo 列出影片的縮圖，自動縮圖依分數排在前面，自訂縮圖依上傳順序排在後面
+func (r *thumbnailRepo) ListByVideo(videoID uint) ([]domain.VideoThumbnail, error) {
+	var thumbnails []domain.VideoThumbnail
+	err := r.db.Where("video_id = ?", videoID).
+		Order("source ASC, score DESC, id ASC").
+		Find(&thumbnails).Error
+	return thumbnails, err
+}
+
+// CountBySource 影片某個來源的縮圖數量
+func (r *thumbnailRepo) CountBySource(videoID uint, source domain.ThumbnailSource) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.VideoThumbnail{}).
+		Where("video_id = ? AND source = ?", videoID, source).
+		Count(&count).Error
+	return count, err
+}
+
+// SetActive 設定影片使用中的縮圖，只更新 thumbnail_name 避免覆寫其他欄位
+func (r *thumbnailRepo) SetActive(videoID uint, name string) error {
+	return r.db.Model(&domain.Video{}).Where("id = ?", videoID).Update("thumbnail_name", name).Error
+}
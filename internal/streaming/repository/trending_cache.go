@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// trendingKeysSet 記錄目前所有排行榜的 key，重建時刪除已不存在的排行榜
+const trendingKeysSet = "trending:keys"
+
+// TrendingCache definition 熱門排行榜（Redis sorted set）
+type TrendingCache interface {
+	Replace(ctx context.Context, boards map[string][]domain.TrendingEntry, ttl time.Duration) error
+	Top(ctx context.Context, key string, offset, limit int) ([]uint, int64, error)
+}
+
+type redisTrendingCache struct {
+	client *redis.Client
+}
+
+// NewTrendingCache create TrendingCache
+func NewTrendingCache(client *redis.Client) TrendingCache {
+	return &redisTrendingCache{client: client}
+}
+
+// Replace 以新的排行榜整批取代舊的：先寫入暫存 key 再 RENAME，讀取端不會看到寫到一半的排行榜
+func (c *redisTrendingCache) Replace(ctx context.Context, boards map[string][]domain.TrendingEntry, ttl time.Duration) error {
+	oldKeys, err := c.client.SMembers(ctx, trendingKeysSet).Result()
+	if err != nil {
+		return err
+	}
+
+	pipe := c.client.TxPipeline()
+	for key, entries := range boards {
+		members := make([]*redis.Z, len(entries))
+		for index, entry := range entries {
+			members[index] = &redis.Z{Score: entry.Score, Member: entry.VideoID}
+		}
+		tmp := key + ":tmp"
+		pipe.Del(ctx, tmp)
+		pipe.ZAdd(ctx, tmp, members...)
+		pipe.Rename(ctx, tmp, key)
+		pipe.Expire(ctx, key, ttl)
+	}
+	for _, key := range oldKeys {
+		if _, ok := boards[key]; !ok {
+			pipe.Del(ctx, key)
+		}
+	}
+	pipe.Del(ctx, trendingKeysSet)
+	if len(boards) > 0 {
+		keys := make([]interface{}, 0, len(boards))
+		for key := range boards {
+			keys = append(keys, key)
+		}
+		pipe.SAdd(ctx, trendingKeysSet, keys...)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Top 依分數由高到低取得排行榜中的影片 ID，並回傳排行榜總數
+func (c *redisTrendingCache) Top(ctx context.Context, key string, offset, limit int) ([]uint, int64, error) {
+	total, err := c.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	members, err := c.client.ZRevRange(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]uint, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseUint(member, 10, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, total, nil
+}
@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"time"
+
 	"streaming_video_service/internal/streaming/domain"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // VideoRepo definition get video info
@@ -11,18 +14,45 @@ type VideoRepo interface {
 	AutoMigrate() error
 	Create(video *domain.Video) error
 	GetByID(id uint) (*domain.Video, error)
+	GetByIDs(ids []uint) ([]domain.Video, error)
 	Update(video *domain.Video) error
+	Delete(video *domain.Video) error
+	SaveWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) error
+	SaveReadyWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error)
+	UpdateStorageState(video *domain.Video) error
+	SetPublicCopy(videoID uint, published bool) error
+	FindPublicCopyMismatches(limit int) ([]domain.Video, error)
+	ClaimTranscode(videoID uint) (bool, error)
+	TouchTranscode(videoID uint) error
+	ReleaseTranscode(videoID uint, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error)
+	SaveStuckWithEvents(video *domain.Video, status string, stuckBefore time.Time, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error)
 	FindByStatus(status string) ([]domain.Video, error)
-	SearchVideos(keyword string) ([]domain.Video, error)
-	RecommendVideos(limit int) ([]domain.Video, error)
+	SearchVideos(filter domain.SearchFilter) ([]domain.Video, error)
+	RecommendVideos(filter domain.RecommendFilter) ([]domain.Video, error)
+	RelatedVideos(videoID uint, maxRating domain.ContentRating, limit int) ([]domain.Video, error)
+	AddShares(videoID uint, memberIDs []string) error
+	RemoveShares(videoID uint, memberIDs []string) error
+	IsSharedWith(videoID uint, memberID string) (bool, error)
+	PublishDue(now time.Time) (int64, error)
+	ListCategories(offset, limit int) ([]domain.Category, int64, error)
+	GetCategory(id uint) (*domain.Category, error)
+	ListByCategory(categoryID uint, maxRating domain.ContentRating, offset, limit int) ([]domain.Video, int64, error)
+	SetVideoTags(videoID uint, tags []string) error
+	GetVideoTags(videoID uint) ([]string, error)
+	RecordView(videoID uint, at time.Time) error
+	HourlyViews(since time.Time) ([]domain.HourlyActivity, error)
+	ListShorts(query domain.ShortsQuery) ([]domain.Video, error)
+	SetChapters(videoID uint, chapters []domain.Chapter) error
+	GetChapters(videoID uint) ([]domain.Chapter, error)
+	ListByMember(memberID string, offset, limit int) ([]domain.Video, int64, error)
+	FindOriginalsReadyBefore(before time.Time) ([]domain.Video, error)
+	FindColdCandidates(before time.Time) ([]domain.Video, error)
 	// 其他 CRUD ...
 }
 
 // CDN 整合說明：
-// 在正式上線時，我們可將 MinIO 的 Bucket 設為 Public Read，或利用 Presigned URL 產生短時間有效的存取連結。
-// 例如：如果我們的 CDN 域名為 cdn.example.com，則播放 URL 可由以下方式產生：
-// hlsURL = "https://cdn.example.com/" + BucketName + "/processed/{videoID}/index.m3u8"
-// 這樣播放器就能藉由 CDN 快速取得影片分段檔。
+// processed/ 不可公開讀取；可公開提供的影片複製到 public/{videoID}/，bucket 只對 public/ 開放 public read，
+// CDN 以 public/ 為 origin，播放網址由 domain.PlaybackURLBuilder 產生
 
 // VideoRepo definition video repo
 type videoRepo struct {
@@ -44,7 +74,7 @@ func NewVideoRepo(db *gorm.DB) VideoRepo {
 //   - AutoMigrate 并不会自动删除数据库中的字段或表。如果你从模型中删除某些字段，AutoMigrate 不会自动删除数据库中的这些字段。
 //   - 它适用于开发阶段的数据库迁移，但在生产环境中使用时，需要小心，因为它不适合进行复杂的迁移操作（比如数据转换或字段删除）。
 func (r *videoRepo) AutoMigrate() error {
-	return r.db.AutoMigrate(&domain.Video{})
+	return r.db.AutoMigrate(&domain.Video{}, &domain.VideoShare{}, &domain.Category{}, &domain.Tag{}, &domain.VideoTag{}, &domain.VideoViewHourly{}, &domain.VideoChapter{})
 }
 
 // Create (video)：这行代码调用了 GORM 的 Create 方法，它会尝试将传入的 video 对象插入到数据库中。如果 video 对象的字段与 Video 表中的字段匹配，GORM 会自动将它们对应并插入数据库。
@@ -68,6 +98,18 @@ func (r *videoRepo) GetByID(id uint) (*domain.Video, error) {
 	return &v, nil
 }
 
+// GetByIDs 依 id 批次取得影片，不保證順序，不存在的 id 會被略過
+func (r *videoRepo) GetByIDs(ids []uint) ([]domain.Video, error) {
+	var videos []domain.Video
+	if len(ids) == 0 {
+		return videos, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
 // Update 可以使用save和updates
 // 1.	如果 video 的 ID 字段已经存在（即记录已经存在于数据库中），则会进行更新操作，更新该记录的所有字段。
 // 2.	如果 video 的 ID 不存在（即这是一个新的对象），则会进行插入操作（类似于 INSERT）。
@@ -80,8 +122,190 @@ func (r *videoRepo) GetByID(id uint) (*domain.Video, error) {
 //  1. 使用 Update 更新单个字段：
 //     •	Update 只会更新指定的单个字段，比较高效。
 //     •	例如，如果你只想更新 title 字段： r.DB.Model(&video).Update("title", video.Title)
+//
+// 審核狀態（status、moderatedColumns）由各自的條件更新維護，Update 不覆寫，避免讀取後才被下架的影片被改回原本的狀態
 func (r *videoRepo) Update(video *domain.Video) error {
-	return r.db.Save(video).Error
+	return r.db.Omit(append([]string{"status"}, moderatedColumns...)...).Save(video).Error
+}
+
+// moderatedColumns 由管理員審核與公開副本同步以條件更新維護的欄位，全欄位儲存時不覆寫
+var moderatedColumns = []string{"hidden", "content_rating", "rating_locked", "public_copy"}
+
+// SaveWithEvents 與 Update 相同以 Save 新增或更新影片，並在同一個交易內寫入 outbox 事件
+// events 在影片寫入後呼叫，新增的影片此時已有 ID
+func (r *videoRepo) SaveWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(video).Error; err != nil {
+			return err
+		}
+		outbox, err := events(video)
+		if err != nil {
+			return err
+		}
+		return addOutboxEvents(tx, outbox)
+	})
+}
+
+// SaveReadyWithEvents Worker 轉碼完成後儲存影片並寫入 outbox 事件，只有仍為 processing 的影片會被更新，回傳是否更新成功
+// 轉碼期間被下架（blocked）或已由 reconciler 改回 upload 的影片不會被覆寫為 ready
+func (r *videoRepo) SaveReadyWithEvents(video *domain.Video, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	saved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(video).Where("status = ?", domain.VideoProcessing).
+			Select("*").Omit(append([]string{"created_at"}, moderatedColumns...)...).
+			Updates(video)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		saved = true
+		outbox, err := events(video)
+		if err != nil {
+			return err
+		}
+		return addOutboxEvents(tx, outbox)
+	})
+	return saved && err == nil, err
+}
+
+// UpdateStorageState 只更新儲存層級、原始檔狀態與原始檔位置，不覆寫其他欄位
+// StorageLifecycleJob 處理期間上傳者或 Worker 可能已修改同一部影片
+func (r *videoRepo) UpdateStorageState(video *domain.Video) error {
+	return r.db.Model(&domain.Video{}).Where("id = ?", video.ID).Updates(map[string]interface{}{
+		"storage_tier":   video.StorageTier,
+		"original_state": video.OriginalState,
+		"file_name":      video.FileName,
+	}).Error
+}
+
+// videoOwnedModels 以 video_id 關聯影片、刪除影片時一併刪除的資料表
+var videoOwnedModels = []interface{}{
+	&domain.VideoTag{}, &domain.VideoShare{}, &domain.VideoChapter{},
+	&domain.PlaylistItem{}, &domain.VideoReaction{}, &domain.VideoThumbnail{},
+	&domain.VideoDownload{}, &domain.VideoViewHourly{}, &domain.VideoQoEDaily{},
+}
+
+// Delete 在同一個交易內刪除影片記錄與所有關聯資料，並釋放上傳者的儲存量與影片數
+// MinIO 上的物件由 OrphanGC 回收
+func (r *videoRepo) Delete(video *domain.Video) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", video.ID).Delete(&domain.Video{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 已被其他請求刪除，用量已釋放
+			return nil
+		}
+		for _, model := range videoOwnedModels {
+			if err := tx.Where("video_id = ?", video.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&domain.QuotaUsage{}).Where("member_id = ?", video.MemberID).Updates(map[string]interface{}{
+			"stored_bytes": gorm.Expr("GREATEST(stored_bytes - ?, 0)", video.SizeBytes),
+			"video_count":  gorm.Expr("GREATEST(video_count - 1, 0)"),
+			"updated_at":   time.Now(),
+		}).Error
+	})
+}
+
+// SetPublicCopy 記錄 public/{videoID}/ 是否有副本
+func (r *videoRepo) SetPublicCopy(videoID uint, published bool) error {
+	return r.db.Model(&domain.Video{}).Where("id = ?", videoID).Update("public_copy", published).Error
+}
+
+// publicCopyWanted 與 domain.Video.WantsPublicCopy 相同的條件
+const publicCopyWanted = "status = 'ready' AND visibility <> 'private' AND hidden = false " +
+	"AND (content_rating = 'all' OR content_rating = '') AND storage_tier <> 'cold'"
+
+// FindPublicCopyMismatches 找出應有副本卻沒有，或有副本但已不可公開提供的影片
+func (r *videoRepo) FindPublicCopyMismatches(limit int) ([]domain.Video, error) {
+	var videos []domain.Video
+	if err := r.db.
+		Where("(public_copy = false AND " + publicCopyWanted + ") OR (public_copy = true AND NOT (" + publicCopyWanted + "))").
+		Order("id").Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// ClaimTranscode Worker 以條件更新認領轉碼工作：只有 upload 的影片會改為 processing，回傳是否認領成功
+// 重複投遞的訊息、或已由其他 Worker 認領的影片會認領失敗，避免同一部影片同時被轉碼兩次
+func (r *videoRepo) ClaimTranscode(videoID uint) (bool, error) {
+	result := r.db.Model(&domain.Video{}).
+		Where("id = ? AND status = ?", videoID, domain.VideoUpload).
+		Updates(map[string]interface{}{"status": domain.VideoProcessing, "updated_at": time.Now()})
+	return result.RowsAffected == 1, result.Error
+}
+
+// TouchTranscode 轉碼期間定期更新 updated_at，StuckJobReconciler 依此判斷 Worker 是否仍在處理
+func (r *videoRepo) TouchTranscode(videoID uint) error {
+	return r.db.Model(&domain.Video{}).
+		Where("id = ? AND status = ?", videoID, domain.VideoProcessing).
+		Update("updated_at", time.Now()).Error
+}
+
+// ReleaseTranscode 轉碼失敗時累加重試次數並將 processing 改回 upload，讓重試的訊息可以再次認領
+// 重試次數達 MaxTranscodeAttempts 時改為 failed，並在同一個交易內寫入 events 產生的事件，回傳是否已標記為 failed
+func (r *videoRepo) ReleaseTranscode(videoID uint, events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	failed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Video{}).
+			Where("id = ? AND status = ?", videoID, domain.VideoProcessing).
+			Updates(map[string]interface{}{
+				"transcode_attempts": gorm.Expr("transcode_attempts + 1"),
+				"status": gorm.Expr("CASE WHEN transcode_attempts + 1 >= ? THEN ? ELSE ? END",
+					domain.MaxTranscodeAttempts, domain.VideoFailed, domain.VideoUpload),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		var video domain.Video
+		if err := tx.First(&video, videoID).Error; err != nil {
+			return err
+		}
+		if video.Status != string(domain.VideoFailed) {
+			return nil
+		}
+		outbox, err := events(&video)
+		if err != nil {
+			return err
+		}
+		failed = true
+		return addOutboxEvents(tx, outbox)
+	})
+	return failed && err == nil, err
+}
+
+// SaveStuckWithEvents 影片仍停留在 status 且 updated_at 早於 stuckBefore 時，更新狀態與重試次數並寫入 outbox 事件
+// 回傳 false 表示影片已不再卡住（例如 Worker 仍在更新 updated_at 或已完成），不做任何事
+func (r *videoRepo) SaveStuckWithEvents(video *domain.Video, status string, stuckBefore time.Time,
+	events func(video *domain.Video) ([]domain.OutboxEvent, error)) (bool, error) {
+	saved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Video{}).
+			Where("id = ? AND status = ? AND updated_at < ?", video.ID, status, stuckBefore).
+			Updates(map[string]interface{}{
+				"status":             video.Status,
+				"transcode_attempts": video.TranscodeAttempts,
+				"updated_at":         time.Now(),
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		outbox, err := events(video)
+		if err != nil {
+			return err
+		}
+		saved = true
+		return addOutboxEvents(tx, outbox)
+	})
+	return saved && err == nil, err
 }
 
 // FindByStatus find videos by status
@@ -93,26 +317,329 @@ func (r *videoRepo) FindByStatus(status string) ([]domain.Video, error) {
 	return videos, nil
 }
 
-// SearchVideos 利用 PostgreSQL 的 ILIKE 實作模糊搜尋（標題或描述包含 keyword）
+// FindOriginalsReadyBefore 找出 before 之前轉碼完成、原始檔仍在 original/ 的影片
+// 剪輯的片段沒有原始檔，不列入
+func (r *videoRepo) FindOriginalsReadyBefore(before time.Time) ([]domain.Video, error) {
+	var videos []domain.Video
+	if err := r.db.Where("status = ? AND ready_at < ? AND original_state = ? AND source_video_id IS NULL",
+		domain.VideoReady, before, domain.OriginalStored).
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// FindColdCandidates 找出 before 之前轉碼完成、且 before 之後沒有瀏覽的 hot 影片
+func (r *videoRepo) FindColdCandidates(before time.Time) ([]domain.Video, error) {
+	var videos []domain.Video
+	recentViews := r.db.Model(&domain.VideoViewHourly{}).Select("1").
+		Where("video_views_hourly.video_id = videos.id AND video_views_hourly.hour >= ?", before.UTC().Truncate(time.Hour))
+	if err := r.db.Where("status = ? AND ready_at < ? AND storage_tier = ?", domain.VideoReady, before, domain.StorageHot).
+		Where("NOT EXISTS (?)", recentViews).
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// publicListed 列表查詢共用條件：僅列出 ready、public 且未被管理員隱藏的影片，blocked 影片不是 ready
+func publicListed(db *gorm.DB) *gorm.DB {
+	return db.Where("videos.status = ? AND videos.visibility = ? AND NOT videos.hidden", domain.VideoReady, domain.VisibilityPublic)
+}
+
+// SearchVideos 利用 PostgreSQL 的 ILIKE 實作模糊搜尋（標題或描述包含 keyword），僅列出 public 影片
+// 與觀看者可觀看的分級，可再依標籤、分類過濾
 // LIKE：区分大小写的模糊匹配。在使用 LIKE 时，查询会区分字母的大小写。例如，如果你搜索 "hello"，它只能匹配 "hello"，而不会匹配 "HELLO" 或 "Hello"。
 // ILIKE：不区分大小写的模糊匹配。使用 ILIKE 时，它会忽略大小写，能匹配 "hello", "HELLO", "Hello" 等不同大小写的情况。
-func (r *videoRepo) SearchVideos(keyword string) ([]domain.Video, error) {
+func (r *videoRepo) SearchVideos(filter domain.SearchFilter) ([]domain.Video, error) {
 	var videos []domain.Video
-	like := "%" + keyword + "%"
-	if err := r.db.Where("(title ILIKE ? OR description ILIKE ?) AND status = ?", like, like, domain.VideoReady).Find(&videos).Error; err != nil {
+	like := "%" + filter.Keyword + "%"
+	query := r.db.Scopes(publicListed).Where("(title ILIKE ? OR description ILIKE ?)", like, like).
+		Where("videos.content_rating IN ?", filter.MaxRating.UpTo())
+	if filter.CategoryID > 0 {
+		query = query.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.Tag != "" {
+		query = query.Where("id IN (?)", r.db.Table("video_tags").
+			Select("video_tags.video_id").
+			Joins("JOIN tags ON tags.id = video_tags.tag_id").
+			Where("tags.name = ?", filter.Tag))
+	}
+	if err := query.Find(&videos).Error; err != nil {
 		return nil, err
 	}
 	return videos, nil
 }
 
-// RecommendVideos 依照 ViewCount 降序排序，返回熱門影片（簡單推薦）
+// popularityOrder 熱門度排序：瀏覽次數加上按讚數加權，並扣除倒讚數
+const popularityOrder = "(videos.view_count + videos.like_count * 10 - videos.dislike_count * 5) DESC"
+
+// RecommendVideos 依照熱門度（瀏覽次數與按讚 / 倒讚數）降序排序，返回熱門影片（簡單推薦），僅列出 ready 且 public 的影片
+// 與觀看者可觀看的分級
+// 帶入 Tags 時，先依命中的標籤數排序，再依熱門度排序
 // 在 GORM 中，Order 方法用于对查询结果进行排序。它接收一个表示排序规则的字符串，并将其应用到查询中。排序规则可以是升序 (ASC) 或降序 (DESC)。
 // 先按 view_count 降序，再按 created_at 升序排序：r.DB.Order("view_count DESC, created_at ASC").Find(&videos)
-func (r *videoRepo) RecommendVideos(limit int) ([]domain.Video, error) {
+func (r *videoRepo) RecommendVideos(filter domain.RecommendFilter) ([]domain.Video, error) {
+	var videos []domain.Video
+	query := r.db.Scopes(publicListed).Where("videos.content_rating IN ?", filter.MaxRating.UpTo())
+	if len(filter.Tags) > 0 {
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL: "(SELECT COUNT(*) FROM video_tags JOIN tags ON tags.id = video_tags.tag_id " +
+				"WHERE video_tags.video_id = videos.id AND tags.name IN ?) DESC, " + popularityOrder,
+			Vars:               []interface{}{filter.Tags},
+			WithoutParentheses: true,
+		}})
+	} else {
+		// 获取最热门的前10个视频
+		query = query.Order(popularityOrder)
+	}
+	if err := query.Limit(filter.Limit).Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// RelatedVideos 找出與 videoID 共用最多標籤的影片，相同數量時依 ViewCount 排序，僅列出 maxRating（含）以下的分級
+func (r *videoRepo) RelatedVideos(videoID uint, maxRating domain.ContentRating, limit int) ([]domain.Video, error) {
+	var videos []domain.Video
+	tagIDs := r.db.Model(&domain.VideoTag{}).Select("tag_id").Where("video_id = ?", videoID)
+	if err := r.db.Scopes(publicListed).
+		Select("videos.*").
+		Joins("JOIN video_tags ON video_tags.video_id = videos.id").
+		Where("video_tags.tag_id IN (?) AND videos.id <> ?", tagIDs, videoID).
+		Where("videos.content_rating IN ?", maxRating.UpTo()).
+		Group("videos.id").
+		Order("COUNT(video_tags.tag_id) DESC, videos.view_count DESC").
+		Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
+
+// AddShares 將 memberIDs 加入私人影片的分享名單，已存在者略過
+func (r *videoRepo) AddShares(videoID uint, memberIDs []string) error {
+	if len(memberIDs) == 0 {
+		return nil
+	}
+	shares := make([]domain.VideoShare, len(memberIDs))
+	for i, memberID := range memberIDs {
+		shares[i] = domain.VideoShare{VideoID: videoID, MemberID: memberID}
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&shares).Error
+}
+
+// RemoveShares 將 memberIDs 從分享名單移除
+func (r *videoRepo) RemoveShares(videoID uint, memberIDs []string) error {
+	if len(memberIDs) == 0 {
+		return nil
+	}
+	return r.db.Where("video_id = ? AND member_id IN ?", videoID, memberIDs).Delete(&domain.VideoShare{}).Error
+}
+
+// IsSharedWith check memberID 是否在影片分享名單中
+func (r *videoRepo) IsSharedWith(videoID uint, memberID string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&domain.VideoShare{}).Where("video_id = ? AND member_id = ?", videoID, memberID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// PublishDue 將 publish_at 已到期的影片改為 public，並清除排程時間，回傳更新筆數
+// 排程中的影片一律為 private，其他可見度的影片不會被公開
+func (r *videoRepo) PublishDue(now time.Time) (int64, error) {
+	res := r.db.Model(&domain.Video{}).
+		Where("publish_at IS NOT NULL AND publish_at <= ? AND visibility = ?", now, domain.VisibilityPrivate).
+		Updates(map[string]interface{}{
+			"visibility": domain.VisibilityPublic,
+			"publish_at": nil,
+		})
+	return res.RowsAffected, res.Error
+}
+
+// ListCategories 依 id 排序分頁列出分類，並回傳總筆數
+func (r *videoRepo) ListCategories(offset, limit int) ([]domain.Category, int64, error) {
+	var total int64
+	if err := r.db.Model(&domain.Category{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var categories []domain.Category
+	if err := r.db.Order("id").Offset(offset).Limit(limit).Find(&categories).Error; err != nil {
+		return nil, 0, err
+	}
+	return categories, total, nil
+}
+
+// GetCategory get Category by id
+func (r *videoRepo) GetCategory(id uint) (*domain.Category, error) {
+	var c domain.Category
+	if err := r.db.First(&c, id).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListByCategory 分頁列出分類下 maxRating（含）以下分級的 public 影片（依 ViewCount 降序），並回傳總筆數
+func (r *videoRepo) ListByCategory(categoryID uint, maxRating domain.ContentRating, offset, limit int) ([]domain.Video, int64, error) {
+	query := func() *gorm.DB {
+		return r.db.Model(&domain.Video{}).Scopes(publicListed).Where("category_id = ?", categoryID).
+			Where("videos.content_rating IN ?", maxRating.UpTo())
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var videos []domain.Video
+	if err := query().Order("view_count DESC, id DESC").Offset(offset).Limit(limit).Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	return videos, total, nil
+}
+
+// SetVideoTags 以 tags 取代影片現有標籤，不存在的標籤會自動建立
+func (r *videoRepo) SetVideoTags(videoID uint, tags []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&domain.VideoTag{}).Error; err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		newTags := make([]domain.Tag, len(tags))
+		for i, name := range tags {
+			newTags[i] = domain.Tag{Name: name}
+		}
+		if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).
+			Create(&newTags).Error; err != nil {
+			return err
+		}
+
+		var tagIDs []uint
+		if err := tx.Model(&domain.Tag{}).Where("name IN ?", tags).Pluck("id", &tagIDs).Error; err != nil {
+			return err
+		}
+		videoTags := make([]domain.VideoTag, len(tagIDs))
+		for i, tagID := range tagIDs {
+			videoTags[i] = domain.VideoTag{VideoID: videoID, TagID: tagID}
+		}
+		return tx.Create(&videoTags).Error
+	})
+}
+
+// GetVideoTags 取得影片的標籤名稱（依名稱排序）
+func (r *videoRepo) GetVideoTags(videoID uint) ([]string, error) {
+	var names []string
+	if err := r.db.Model(&domain.Tag{}).
+		Joins("JOIN video_tags ON video_tags.tag_id = tags.id").
+		Where("video_tags.video_id = ?", videoID).
+		Order("tags.name").
+		Pluck("tags.name", &names).Error; err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// RecordView 瀏覽次數加一，並累加到該小時的瀏覽統計
+func (r *videoRepo) RecordView(videoID uint, at time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.Video{}).Where("id = ?", videoID).
+			UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "video_id"}, {Name: "hour"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"views": gorm.Expr("video_views_hourly.views + 1")}),
+		}).Create(&domain.VideoViewHourly{
+			VideoID: videoID,
+			Hour:    at.UTC().Truncate(time.Hour),
+			Views:   1,
+		}).Error
+	})
+}
+
+// HourlyViews 取得 since 之後每部影片每小時的瀏覽次數
+func (r *videoRepo) HourlyViews(since time.Time) ([]domain.HourlyActivity, error) {
+	var activities []domain.HourlyActivity
+	if err := r.db.Model(&domain.VideoViewHourly{}).
+		Select("video_id, hour, views AS count").
+		Where("hour >= ?", since.UTC().Truncate(time.Hour)).
+		Scan(&activities).Error; err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// ListShorts 依 ID 由新到舊列出 query.MaxRating（含）以下分級的 public 短影音，可限定頻道
+func (r *videoRepo) ListShorts(query domain.ShortsQuery) ([]domain.Video, error) {
 	var videos []domain.Video
-	// 获取播放次数最多的前10个视频
-	if err := r.db.Order("view_count DESC").Limit(limit).Find(&videos).Error; err != nil {
+	db := r.db.Scopes(publicListed).Where("videos.type = ?", domain.VideoTypeShort).
+		Where("videos.content_rating IN ?", query.MaxRating.UpTo())
+	if query.BeforeID > 0 {
+		db = db.Where("videos.id < ?", query.BeforeID)
+	}
+	if query.MemberIDs != nil {
+		db = db.Where("videos.member_id IN ?", query.MemberIDs)
+	}
+	if err := db.Order("videos.id DESC").Limit(query.Limit).Find(&videos).Error; err != nil {
 		return nil, err
 	}
 	return videos, nil
 }
+
+// ListByMember 分頁列出會員上傳的所有影片（不限狀態與可見度），依 ID 由新到舊，並回傳總筆數
+func (r *videoRepo) ListByMember(memberID string, offset, limit int) ([]domain.Video, int64, error) {
+	query := func() *gorm.DB {
+		return r.db.Model(&domain.Video{}).Where("member_id = ?", memberID)
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var videos []domain.Video
+	if err := query().Order("id DESC").Offset(offset).Limit(limit).Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	return videos, total, nil
+}
+
+// SetChapters 以 chapters 取代影片原本的章節，傳入空值代表清除
+func (r *videoRepo) SetChapters(videoID uint, chapters []domain.Chapter) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&domain.VideoChapter{}).Error; err != nil {
+			return err
+		}
+		if len(chapters) == 0 {
+			return nil
+		}
+		rows := make([]domain.VideoChapter, len(chapters))
+		for index, chapter := range chapters {
+			rows[index] = domain.VideoChapter{
+				VideoID: videoID,
+				Seq:     index,
+				StartMs: chapter.Start.Milliseconds(),
+				Title:   chapter.Title,
+			}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// GetChapters 依順序取得上傳者設定的章節
+func (r *videoRepo) GetChapters(videoID uint) ([]domain.Chapter, error) {
+	var rows []domain.VideoChapter
+	if err := r.db.Where("video_id = ?", videoID).Order("seq").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	chapters := make([]domain.Chapter, len(rows))
+	for index, row := range rows {
+		chapters[index] = domain.Chapter{
+			Start: time.Duration(row.StartMs) * time.Millisecond,
+			Title: row.Title,
+		}
+	}
+	return chapters, nil
+}
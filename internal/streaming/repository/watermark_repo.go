@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"streaming_video_service/internal/streaming/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WatermarkRepo definition 浮水印設定存取
+type WatermarkRepo interface {
+	AutoMigrate() error
+	Get(owner string) (*domain.Watermark, error)
+	Save(watermark *domain.Watermark) error
+}
+
+type watermarkRepo struct {
+	db *gorm.DB
+}
+
+// NewWatermarkRepo create WatermarkRepo
+func NewWatermarkRepo(db *gorm.DB) WatermarkRepo {
+	return &watermarkRepo{db: db}
+}
+
+// AutoMigrate 建立 watermarks 資料表
+func (r *watermarkRepo) AutoMigrate() error {
+	return r.db.AutoMigrate(&domain.Watermark{})
+}
+
+// Get 取得浮水印設定，未設定時回傳 nil, nil
+func (r *watermarkRepo) Get(owner string) (*domain.Watermark, error) {
+	var watermark domain.Watermark
+	err := r.db.Where("owner = ?", owner).Take(&watermark).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &watermark, nil
+}
+
+// Save 新增或覆寫浮水印設定
+func (r *watermarkRepo) Save(watermark *domain.Watermark) error {
+	watermark.UpdatedAt = time.Now()
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "owner"}},
+		DoUpdates: clause.AssignmentColumns([]string{"object_key", "position", "opacity", "enabled", "updated_at"}),
+	}).Create(watermark).Error
+}
@@ -25,6 +25,11 @@ type Chat struct {
 	MongoSQL      DatabaseConfig `mapstructure:"mongo"`
 	Redis         RedisConfig    `mapstructure:"redis"`
 	MemberService ServiceConfig  `mapstructure:"member"`
+
+	// 消費 streaming_service 的影片狀態事件
+	RabbitMQ RabbitMQConfig `mapstructure:"rabbit_mq"`
+	Kafka    KafkaConfig    `mapstructure:"kafka"`
+	JobQueue JobQueueConfig `mapstructure:"job_queue"`
 }
 
 // Streaming definition streaming_service YAML structure
@@ -33,9 +38,102 @@ type Streaming struct {
 	IP   string `mapstructure:"ip"`
 
 	PostgreSQL DatabaseConfig `mapstructure:"pg"`
+	MongoSQL   DatabaseConfig `mapstructure:"mongo"`
 	MinIO      MinIOConfig    `mapstructure:"minio"`
+	Storage    StorageConfig  `mapstructure:"storage"`
 	RabbitMQ   RabbitMQConfig `mapstructure:"rabbit_mq"`
-	// KafKa      KafkaConfig    `mapstructure:"kafka"`
+	Redis      RedisConfig    `mapstructure:"redis"`
+	Kafka      KafkaConfig    `mapstructure:"kafka"`
+	JobQueue   JobQueueConfig `mapstructure:"job_queue"`
+
+	PublishScheduler JobConfig       `mapstructure:"publish_scheduler"`
+	Trending         JobConfig       `mapstructure:"trending"`
+	StuckJobs        StuckJobConfig  `mapstructure:"stuck_jobs"`
+	Outbox           OutboxConfig    `mapstructure:"outbox"`
+	Lifecycle        LifecycleConfig `mapstructure:"lifecycle"`
+	OrphanGC         OrphanGCConfig  `mapstructure:"orphan_gc"`
+	HLSCache         HLSCacheConfig  `mapstructure:"hls_cache"`
+	Metrics          MetricsConfig   `mapstructure:"metrics"`
+	Playback         PlaybackConfig  `mapstructure:"playback"`
+	Quota            QuotaConfig     `mapstructure:"quota"`
+}
+
+// JobConfig definition background job setting
+type JobConfig struct {
+	Enable   bool          `mapstructure:"enable"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// StuckJobConfig definition stuck transcoding job reconciler setting
+type StuckJobConfig struct {
+	Enable   bool          `mapstructure:"enable"`
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"` // 停留在 upload / processing 超過此時間視為卡住
+}
+
+// OutboxConfig definition outbox relay setting，relay 一律啟動，否則轉碼工作不會送出
+type OutboxConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`
+	Retention time.Duration `mapstructure:"retention"` // 已發布的事件保留多久
+}
+
+// LifecycleConfig definition storage lifecycle rules，天數為 0 表示停用該規則
+type LifecycleConfig struct {
+	Enable            bool          `mapstructure:"enable"`
+	Interval          time.Duration `mapstructure:"interval"`
+	OriginalAction    string        `mapstructure:"original_action"`     // keep | delete | archive
+	OriginalAfterDays int           `mapstructure:"original_after_days"` // ready 後幾天處理原始檔
+	ColdAfterDays     int           `mapstructure:"cold_after_days"`     // 幾天沒有瀏覽移到冷儲存
+}
+
+// OrphanGCConfig definition orphan object garbage collection setting
+type OrphanGCConfig struct {
+	Enable      bool          `mapstructure:"enable"`
+	Interval    time.Duration `mapstructure:"interval"`
+	GracePeriod time.Duration `mapstructure:"grace_period"` // 最後修改時間在此期間內的物件不回收
+	DryRun      bool          `mapstructure:"dry_run"`      // 只產生報告，不刪除
+}
+
+// HLSCacheConfig definition hot playlist / segment cache setting
+type HLSCacheConfig struct {
+	Enable         bool          `mapstructure:"enable"`
+	MaxBytes       int64         `mapstructure:"max_bytes"`        // 本機 LRU 容量（bytes）
+	MaxObjectBytes int64         `mapstructure:"max_object_bytes"` // 超過此大小的物件不快取（bytes）
+	PlaylistTTL    time.Duration `mapstructure:"playlist_ttl"`
+	SegmentTTL     time.Duration `mapstructure:"segment_ttl"`
+	Redis          bool          `mapstructure:"redis"` // 多個 replica 共用 Redis 快取
+}
+
+// MetricsConfig definition metrics endpoint（expvar /debug/vars）
+type MetricsConfig struct {
+	Listen string `mapstructure:"listen"`
+}
+
+// PlaybackConfig definition playback URL setting
+type PlaybackConfig struct {
+	GatewayURL string      `mapstructure:"gateway_url"` // api_gateway 的 /streaming 路由
+	StorageURL string      `mapstructure:"storage_url"` // 公開 bucket 的網址，空值停用
+	CDN        []CDNConfig `mapstructure:"cdn"`
+}
+
+// CDNConfig definition CDN host setting
+type CDNConfig struct {
+	URL     string   `mapstructure:"url"`
+	Weight  int      `mapstructure:"weight"`  // 加權選擇的權重，0 視為 1
+	Regions []string `mapstructure:"regions"` // 只提供給這些地區，空值為一般 CDN
+}
+
+// QuotaConfig definition upload quota setting，會員個別設定的配額優先
+type QuotaConfig struct {
+	Default QuotaLimitConfig            `mapstructure:"default"` // 未列在 roles 的角色
+	Roles   map[string]QuotaLimitConfig `mapstructure:"roles"`
+}
+
+// QuotaLimitConfig definition upload quota limits，0 表示不限制
+type QuotaLimitConfig struct {
+	MaxStorageBytes  int64 `mapstructure:"max_storage_bytes"`
+	MaxVideos        int64 `mapstructure:"max_videos"`
+	MaxUploadsPerDay int64 `mapstructure:"max_uploads_per_day"`
 }
 
 // ServiceConfig definition service port & name
@@ -73,6 +171,16 @@ type MinIOConfig struct {
 	RetryCount    int           `mapstructure:"retry_count"`
 }
 
+// StorageConfig definition object storage backend: minio | filesystem
+// filesystem 將物件存放在本機 Root，presign URL 由 Listen 上的 handler 提供，BaseURL 為其對外位址
+type StorageConfig struct {
+	Backend string `mapstructure:"backend"`
+	Root    string `mapstructure:"root"`
+	Listen  string `mapstructure:"listen"`
+	BaseURL string `mapstructure:"base_url"`
+	Secret  string `mapstructure:"secret"` // presign URL 的 HMAC 密鑰
+}
+
 // RabbitMQConfig definition rabbit setting
 type RabbitMQConfig struct {
 	Port          string        `mapstructure:"port"`
@@ -83,10 +191,17 @@ type RabbitMQConfig struct {
 	RetryCount    int           `mapstructure:"retry_count"`
 }
 
-// KafkaConfig definition kafka setting
-type KafkaConfig struct {
-	Brokers       []string      `mapstructure:"brokers"`
-	Topic         string        `mapstructure:"topic"`
+// JobQueueConfig definition job queue backend: rabbitmq | kafka | memory
+// RetryCount 為每則訊息最多處理幾次，RetryInterval 為重試的間隔基準（s）
+type JobQueueConfig struct {
+	Backend       string        `mapstructure:"backend"`
 	RetryInterval time.Duration `mapstructure:"retry_interval"`
 	RetryCount    int           `mapstructure:"retry_count"`
 }
+
+// KafkaConfig definition kafka setting
+type KafkaConfig struct {
+	Brokers []string `mapstructure:"brokers"`
+	Topic   string   `mapstructure:"topic"`
+	GroupID string   `mapstructure:"group_id"`
+}
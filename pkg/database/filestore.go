@@ -0,0 +1,380 @@
+package database
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// 物件儲存 backend 名稱，對應 streaming_service.yaml 的 storage.backend
+const (
+	StorageMinIO      = "minio"
+	StorageFilesystem = "filesystem"
+)
+
+// presign URL 的 query 參數
+const (
+	fileURLExpires   = "X-Expires"
+	fileURLSignature = "X-Signature"
+)
+
+// 上傳中的暫存檔前綴，列出物件時略過
+const fileUploadTempPrefix = ".upload-"
+
+// ErrInvalidObjectKey object key 不合法（空字串、絕對路徑或含 ..）
+var ErrInvalidObjectKey = errors.New("不合法的 object key")
+
+// FileStorage 以本機檔案系統實作 MinIOClientRepo，供本機開發與 CI 使用
+//   - 物件存放在 {root}/objects/{key}，key 與 MinIO 相同以 / 分隔
+//   - Content-Type 存放在 sidecar {root}/meta/{key}.json
+//   - PresignGetURL 產生帶有效期限與 HMAC 簽章的 URL，由 FileStorage 本身（http.Handler）提供下載
+type FileStorage struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// fileMeta sidecar metadata
+type fileMeta struct {
+	ContentType string `json:"content_type"`
+}
+
+// NewFileStorage create filesystem storage
+// baseURL 為 FileStorage handler 對外的位址，例如 http://127.0.0.1:8084/storage
+func NewFileStorage(root, baseURL, secret string) (*FileStorage, error) {
+	if secret == "" {
+		return nil, errors.New("filesystem storage 未設定 URL 簽章密鑰")
+	}
+	for _, dir := range []string{"objects", "meta"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			return nil, fmt.Errorf("建立儲存目錄 [%s] 失敗: %v", root, err)
+		}
+	}
+	return &FileStorage{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+		now:     time.Now,
+	}, nil
+}
+
+// objectPath 回傳物件與 sidecar 的檔案路徑，拒絕跳出 root 的 key
+func (f *FileStorage) objectPath(objectName string) (string, string, error) {
+	if objectName == "" || strings.HasPrefix(objectName, "/") || path.Clean(objectName) != objectName ||
+		objectName == ".." || strings.HasPrefix(objectName, "../") {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidObjectKey, objectName)
+	}
+	key := filepath.FromSlash(objectName)
+	return filepath.Join(f.root, "objects", key), filepath.Join(f.root, "meta", key+".json"), nil
+}
+
+// UploadFile 複製檔案到 {root}/objects/{key}，先寫入暫存檔再 rename，讀取端不會看到寫到一半的物件
+func (f *FileStorage) UploadFile(ctx context.Context, objectName, filePath, contentType string) error {
+	dest, metaPath, err := f.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("開啟檔案失敗: %v", err)
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return fmt.Errorf("建立目錄失敗: %v", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dest), fileUploadTempPrefix+"*")
+	if err != nil {
+		return fmt.Errorf("建立暫存檔失敗: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		return fmt.Errorf("寫入物件 [%s] 失敗: %v", objectName, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("寫入物件 [%s] 失敗: %v", objectName, err)
+	}
+
+	meta, err := json.Marshal(fileMeta{ContentType: contentType})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(metaPath), 0o755); err != nil {
+		return fmt.Errorf("建立目錄失敗: %v", err)
+	}
+	if err := os.WriteFile(metaPath, meta, 0o644); err != nil {
+		return fmt.Errorf("寫入物件 [%s] metadata 失敗: %v", objectName, err)
+	}
+	return os.Rename(tmp.Name(), dest)
+}
+
+// DownloadFile 複製物件到 destPath
+func (f *FileStorage) DownloadFile(ctx context.Context, objectName, destPath string) error {
+	src, _, err := f.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+	obj, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("取得物件失敗: %v", err)
+	}
+	defer obj.Close()
+
+	destFile, err := os.Create(destPath)
+	if err != nil {
+		return fmt.Errorf("建立檔案失敗: %v", err)
+	}
+	defer destFile.Close()
+
+	_, err = io.Copy(destFile, obj)
+	return err
+}
+
+// PresignGetURL 產生 {baseURL}/{key}?X-Expires=...&X-Signature=...，到期或簽章不符時 handler 拒絕
+func (f *FileStorage) PresignGetURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	if _, _, err := f.objectPath(objectName); err != nil {
+		return "", fmt.Errorf("生成 Presigned URL 失敗: %w", err)
+	}
+	expires := strconv.FormatInt(f.now().Add(expiry).Unix(), 10)
+	query := url.Values{}
+	query.Set(fileURLExpires, expires)
+	query.Set(fileURLSignature, f.sign(objectName, expires))
+	return f.baseURL + (&url.URL{Path: "/" + objectName}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+func (f *FileStorage) sign(objectName, expires string) string {
+	mac := hmac.New(sha256.New, f.secret)
+	mac.Write([]byte(objectName + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetObject 開啟物件，支援 opts.SetRange 設定的 Range
+// 回傳的 reader 同時實作 io.Closer，讀取完畢後由呼叫端關閉
+func (f *FileStorage) GetObject(ctx context.Context, objectName string, opts minio.GetObjectOptions) (io.Reader, error) {
+	src, _, err := f.objectPath(objectName)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := os.Open(src)
+	if err != nil {
+		return nil, fmt.Errorf("取得物件 [%s] 失敗: %w", objectName, err)
+	}
+	byteRange := opts.Header().Get("Range")
+	if byteRange == "" {
+		return obj, nil
+	}
+
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, err
+	}
+	start, end, err := parseByteRange(byteRange, info.Size())
+	if err != nil {
+		obj.Close()
+		return nil, fmt.Errorf("取得物件 [%s] 失敗: %w", objectName, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.NewSectionReader(obj, start, end-start+1), obj}, nil
+}
+
+// parseByteRange 解析 minio SetRange 產生的 bytes=start-end、bytes=start-、bytes=-suffix
+func parseByteRange(byteRange string, size int64) (int64, int64, error) {
+	spec := strings.TrimPrefix(byteRange, "bytes=")
+	parts := strings.SplitN(spec, "-", 2)
+	if len(parts) != 2 || spec == byteRange {
+		return 0, 0, fmt.Errorf("不支援的 Range: %s", byteRange)
+	}
+	start, end := int64(0), size-1
+	var err error
+	switch {
+	case parts[0] == "":
+		suffix, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("不支援的 Range: %s", byteRange)
+		}
+		if suffix < size {
+			start = size - suffix
+		}
+	default:
+		if start, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("不支援的 Range: %s", byteRange)
+		}
+		if parts[1] != "" {
+			if end, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+				return 0, 0, fmt.Errorf("不支援的 Range: %s", byteRange)
+			}
+			if end > size-1 {
+				end = size - 1
+			}
+		}
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("Range 超出物件大小: %s", byteRange)
+	}
+	return start, end, nil
+}
+
+// ObjectExists 確認物件檔案是否存在
+func (f *FileStorage) ObjectExists(ctx context.Context, objectName string) (bool, error) {
+	src, _, err := f.objectPath(objectName)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(src); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ListObjects 列出 prefix 底下所有物件，依 key 排序，與 MinIO 遞迴列出相同
+func (f *FileStorage) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	objectsDir := filepath.Join(f.root, "objects")
+	// 只走訪 prefix 最後一個 / 之前的目錄
+	startDir := objectsDir
+	if index := strings.LastIndex(prefix, "/"); index >= 0 {
+		startDir = filepath.Join(objectsDir, filepath.FromSlash(prefix[:index]))
+	}
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(startDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), fileUploadTempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(objectsDir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			ContentType:  f.contentType(key),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("列出物件 [%s] 失敗: %w", prefix, err)
+	}
+	return objects, nil
+}
+
+// CopyObject 複製物件與 sidecar
+func (f *FileStorage) CopyObject(ctx context.Context, srcObject, destObject string) error {
+	src, _, err := f.objectPath(srcObject)
+	if err != nil {
+		return err
+	}
+	if err := f.UploadFile(ctx, destObject, src, f.contentType(srcObject)); err != nil {
+		return fmt.Errorf("複製物件 [%s] 到 [%s] 失敗: %w", srcObject, destObject, err)
+	}
+	return nil
+}
+
+// RemoveObject 刪除物件與 sidecar，物件不存在時不回傳錯誤
+func (f *FileStorage) RemoveObject(ctx context.Context, objectName string) error {
+	src, metaPath, err := f.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+	for _, filePath := range []string{src, metaPath} {
+		if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("刪除物件 [%s] 失敗: %w", objectName, err)
+		}
+	}
+	return nil
+}
+
+// contentType 讀取 sidecar 的 Content-Type，沒有 sidecar 時回傳 application/octet-stream
+func (f *FileStorage) contentType(objectName string) string {
+	_, metaPath, err := f.objectPath(objectName)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	data, err := os.ReadFile(metaPath)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	var meta fileMeta
+	if err := json.Unmarshal(data, &meta); err != nil || meta.ContentType == "" {
+		return "application/octet-stream"
+	}
+	return meta.ContentType
+}
+
+// ServeHTTP 提供 PresignGetURL 產生的下載連結，掛載時以 http.StripPrefix 去掉 baseURL 的路徑
+// 支援 Range 與 If-Modified-Since（http.ServeContent）
+func (f *FileStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	objectName := strings.TrimPrefix(r.URL.Path, "/")
+	expires := r.URL.Query().Get(fileURLExpires)
+	signature := r.URL.Query().Get(fileURLSignature)
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !hmac.Equal([]byte(signature), []byte(f.sign(objectName, expires))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if f.now().Unix() > expiresAt {
+		http.Error(w, "url expired", http.StatusForbidden)
+		return
+	}
+
+	src, _, err := f.objectPath(objectName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	obj, err := os.Open(src)
+	if err != nil {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	defer obj.Close()
+	info, err := obj.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", f.contentType(objectName))
+	http.ServeContent(w, r, path.Base(objectName), info.ModTime(), obj)
+}
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"streaming_video_service/pkg/config"
+)
+
+// JobQueue backend 名稱，對應 streaming_service.yaml 的 job_queue.backend
+const (
+	JobQueueRabbitMQ = "rabbitmq"
+	JobQueueKafka    = "kafka"
+	JobQueueMemory   = "memory"
+)
+
+// ErrJobQueueClosed JobQueue 已關閉
+var ErrJobQueueClosed = errors.New("job queue 已關閉")
+
+// QueueMessage 與 broker 無關的佇列訊息
+type QueueMessage struct {
+	ID        string // 訊息 ID（outbox 事件 ID），消費端可依此去除重複
+	Type      string // 事件類型
+	Body      []byte
+	Timestamp time.Time
+}
+
+// JobHandler 處理一則訊息，回傳錯誤時依 RetryPolicy 重試；無法處理的訊息（例如格式錯誤）應回傳 nil 直接確認
+type JobHandler func(ctx context.Context, msg QueueMessage) error
+
+// JobQueue definition 工作佇列（RabbitMQ / Kafka / in-memory）
+//   - Publish 在 broker 確認收到後才回傳
+//   - Consume 阻塞直到 ctx 結束，每則訊息處理成功後才確認（at-least-once）
+//   - 處理失敗時依 RetryPolicy 等待後重試，次數用盡後轉送到 DeadLetterTopic 並確認，不會無限重試
+type JobQueue interface {
+	Publish(ctx context.Context, topic string, msg QueueMessage) error
+	Consume(ctx context.Context, topic string, handler JobHandler) error
+	Close() error
+}
+
+// RetryPolicy 訊息處理失敗時的重試策略
+type RetryPolicy struct {
+	MaxAttempts int           // 每則訊息最多處理幾次（含第一次），未設定時使用 DefaultRetryPolicy
+	Backoff     time.Duration // 第 n 次重試前等待 n * Backoff
+}
+
+// DefaultRetryPolicy job_queue 未設定重試次數時使用
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, Backoff: 5 * time.Second}
+
+// DeadLetterTopic 處理失敗達 MaxAttempts 次的訊息轉送的 topic，保留供人工排查
+func DeadLetterTopic(topic string) string {
+	return topic + ".dead"
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if p.Backoff < 0 {
+		p.Backoff = 0
+	}
+	return p
+}
+
+// handle 處理一則訊息，失敗時等待後重試，回傳最後一次的錯誤；ctx 結束時回傳 ctx.Err()
+func (p RetryPolicy) handle(ctx context.Context, msg QueueMessage, handler JobHandler) error {
+	var err error
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		if err = handler(ctx, msg); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("處理訊息 %s 失敗 (嘗試 %d/%d): %v", msg.ID, attempt, p.MaxAttempts, err)
+		if attempt == p.MaxAttempts {
+			break
+		}
+		select {
+		case <-time.After(time.Duration(attempt) * p.Backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// NewJobQueue 依 job_queue.backend（rabbitmq | kafka | memory）建立 JobQueue，回傳關閉佇列與連線的函式
+// 未設定 backend 時使用 RabbitMQ，重試次數與間隔取自 job_queue.retry_count / retry_interval
+func NewJobQueue(jobQueue config.JobQueueConfig, rabbit config.RabbitMQConfig, kafka config.KafkaConfig,
+	confirmTimeout time.Duration) (JobQueue, func(), error) {
+	retry := RetryPolicy{MaxAttempts: jobQueue.RetryCount, Backoff: jobQueue.RetryInterval * time.Second}
+	if jobQueue.RetryCount <= 0 {
+		retry = DefaultRetryPolicy
+	}
+	switch jobQueue.Backend {
+	case JobQueueKafka:
+		queue := NewKafkaJobQueue(kafka.Brokers, kafka.GroupID, retry)
+		return queue, func() { queue.Close() }, nil
+	case JobQueueMemory:
+		queue := NewMemoryJobQueue(retry)
+		return queue, func() { queue.Close() }, nil
+	case JobQueueRabbitMQ, "":
+	default:
+		return nil, nil, fmt.Errorf("不支援的 job queue backend: %s", jobQueue.Backend)
+	}
+
+	conn, err := ConnectRabbitMQWithRetry(Connection{
+		ConnectStr:    fmt.Sprintf("amqp://%s:%s@%s:%s/", rabbit.User, rabbit.Password, rabbit.IP, rabbit.Port),
+		RetryCount:    rabbit.RetryCount,
+		RetryInterval: rabbit.RetryInterval,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	queue, err := NewRabbitJobQueue(conn, confirmTimeout, retry)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return queue, func() {
+		queue.Close()
+		conn.Close()
+	}, nil
+}
+
+// memoryQueueSize 每個 topic 可暫存的訊息數
+const memoryQueueSize = 1024
+
+type memoryJobQueue struct {
+	mu     sync.Mutex
+	topics map[string]chan QueueMessage
+	retry  RetryPolicy
+	closed chan struct{}
+	once   sync.Once
+}
+
+// NewMemoryJobQueue create in-memory JobQueue，訊息只存在於同一個 process，供測試與本機開發使用
+// 同一個 topic 的多個 Consume 互相競爭訊息，與 RabbitMQ queue / Kafka consumer group 相同
+func NewMemoryJobQueue(retry RetryPolicy) JobQueue {
+	return &memoryJobQueue{
+		topics: map[string]chan QueueMessage{},
+		retry:  retry.withDefaults(),
+		closed: make(chan struct{}),
+	}
+}
+
+func (q *memoryJobQueue) topic(name string) chan QueueMessage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	ch, ok := q.topics[name]
+	if !ok {
+		ch = make(chan QueueMessage, memoryQueueSize)
+		q.topics[name] = ch
+	}
+	return ch
+}
+
+// Publish 放入 topic，佇列已滿時等待到有空間或 ctx 結束
+func (q *memoryJobQueue) Publish(ctx context.Context, topic string, msg QueueMessage) error {
+	select {
+	case <-q.closed:
+		return ErrJobQueueClosed
+	default:
+	}
+	select {
+	case q.topic(topic) <- msg:
+		return nil
+	case <-q.closed:
+		return ErrJobQueueClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Consume 依序處理 topic 的訊息，處理失敗時依 RetryPolicy 原地重試
+// 不放回自己的佇列，避免佇列已滿時 Consume 等待自己而卡住；同一個 process 沒有 dead-letter 的消費者，次數用盡後捨棄
+func (q *memoryJobQueue) Consume(ctx context.Context, topic string, handler JobHandler) error {
+	ch := q.topic(topic)
+	for {
+		select {
+		case msg := <-ch:
+			if err := q.retry.handle(ctx, msg, handler); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				log.Printf("topic[%s] 訊息 %s 重試 %d 次仍失敗，捨棄: %v", topic, msg.ID, q.retry.MaxAttempts, err)
+			}
+		case <-q.closed:
+			return ErrJobQueueClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (q *memoryJobQueue) Close() error {
+	q.once.Do(func() { close(q.closed) })
+	return nil
+}
@@ -39,3 +39,86 @@ func NewKafkaWriterWithRetry(k KafkaConnection) (*kafka.Writer, error) {
 
 	return nil, fmt.Errorf("無法建立 Kafka Writer，經過 %d 次嘗試: %v", k.RetryCount, err)
 }
+
+type kafkaJobQueue struct {
+	brokers []string
+	groupID string
+	retry   RetryPolicy
+	writer  *kafka.Writer
+}
+
+// NewKafkaJobQueue create Kafka JobQueue，topic 為 Kafka topic，同一個 groupID 的 consumer 分攤 partition
+func NewKafkaJobQueue(brokers []string, groupID string, retry RetryPolicy) JobQueue {
+	return &kafkaJobQueue{
+		brokers: brokers,
+		groupID: groupID,
+		retry:   retry.withDefaults(),
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll, // 所有 in-sync replica 寫入後才回傳
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Publish 以訊息 ID 作為 key 寫入 topic，WriteMessages 為同步寫入，回傳時 broker 已確認
+func (q *kafkaJobQueue) Publish(ctx context.Context, topic string, msg QueueMessage) error {
+	return q.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(msg.ID),
+		Value: msg.Body,
+		Time:  msg.Timestamp,
+		Headers: []kafka.Header{
+			{Key: "message_id", Value: []byte(msg.ID)},
+			{Key: "type", Value: []byte(msg.Type)},
+		},
+	})
+}
+
+// Consume 以 consumer group 消費 topic，處理成功後才 commit offset
+// Kafka 無法單獨拒絕一則訊息，處理失敗時依 RetryPolicy 重試同一則訊息，維持 partition 內的順序；
+// 次數用盡後轉送到 DeadLetterTopic 再 commit，避免單一訊息擋住整個 partition
+func (q *kafkaJobQueue) Consume(ctx context.Context, topic string, handler JobHandler) error {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  q.brokers,
+		GroupID:  q.groupID,
+		Topic:    topic,
+		MinBytes: 1,
+		MaxBytes: 10e6,
+	})
+	defer reader.Close()
+
+	for {
+		m, err := reader.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+		msg := QueueMessage{Body: m.Value, Timestamp: m.Time}
+		for _, header := range m.Headers {
+			switch header.Key {
+			case "message_id":
+				msg.ID = string(header.Value)
+			case "type":
+				msg.Type = string(header.Value)
+			}
+		}
+		if err := q.retry.handle(ctx, msg, handler); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// 轉送失敗時不 commit，重新啟動後再處理
+			if err := q.Publish(ctx, DeadLetterTopic(topic), msg); err != nil {
+				return fmt.Errorf("訊息 %s 轉送到 dead-letter topic 失敗: %v", msg.ID, err)
+			}
+			log.Printf("topic[%s] 訊息 %s 重試 %d 次仍失敗，已轉送到 %s: %v", topic, msg.ID, q.retry.MaxAttempts, DeadLetterTopic(topic), err)
+		}
+		if err := reader.CommitMessages(ctx, m); err != nil {
+			return fmt.Errorf("commit Kafka topic[%s] offset 失敗: %v", topic, err)
+		}
+	}
+}
+
+func (q *kafkaJobQueue) Close() error {
+	return q.writer.Close()
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"net/url"
 	"os"
 	"time"
 
+	"streaming_video_service/pkg/config"
+
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
@@ -22,6 +25,18 @@ type MinIOClientRepo interface {
 	DownloadFile(ctx context.Context, objectName, destPath string) error
 	PresignGetURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
 	GetObject(ctx context.Context, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
+	ObjectExists(ctx context.Context, objectName string) (bool, error)
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	CopyObject(ctx context.Context, srcObject, destObject string) error
+	RemoveObject(ctx context.Context, objectName string) error
+}
+
+// ObjectInfo 物件的 key、大小、Content-Type 與最後修改時間
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
 }
 
 // MinIOClient definition minio client
@@ -63,6 +78,35 @@ func NewMinIOConnection(d MinIOConnection) (MinIOClientRepo, error) {
 	}, nil
 }
 
+// NewObjectStorage 依 storage.backend（minio | filesystem）建立物件儲存
+// filesystem 另外回傳提供 presign URL 下載的 http.Handler，minio 回傳 nil
+// 未設定 backend 時使用 MinIO
+func NewObjectStorage(storage config.StorageConfig, minIO config.MinIOConfig) (MinIOClientRepo, http.Handler, error) {
+	switch storage.Backend {
+	case StorageFilesystem:
+		fileStorage, err := NewFileStorage(storage.Root, storage.BaseURL, storage.Secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		return fileStorage, fileStorage, nil
+	case StorageMinIO, "":
+	default:
+		return nil, nil, fmt.Errorf("不支援的 storage backend: %s", storage.Backend)
+	}
+
+	client, err := NewMinIOConnection(MinIOConnection{
+		Endpoint:   fmt.Sprintf("%s:%d", minIO.Host, minIO.Port),
+		User:       minIO.User,
+		Password:   minIO.Password,
+		BucketName: minIO.BucketName,
+		UseSSL:     minIO.UseSSL,
+
+		RetryCount:    minIO.RetryCount,
+		RetryInterval: minIO.RetryInterval,
+	})
+	return client, nil, err
+}
+
 // func (m *minIOClient) GetClient() *minio.Client {
 // 	return m.client
 // }
@@ -117,3 +161,51 @@ func (m *minIOClient) PresignGetURL(ctx context.Context, objectName string, expi
 func (m *minIOClient) GetObject(ctx context.Context, objectName string, opts minio.GetObjectOptions) (io.Reader, error) {
 	return m.client.GetObject(ctx, m.bucketName, objectName, opts)
 }
+
+// ObjectExists 以 StatObject 確認物件是否存在
+func (m *minIOClient) ObjectExists(ctx context.Context, objectName string) (bool, error) {
+	_, err := m.client.StatObject(ctx, m.bucketName, objectName, minio.StatObjectOptions{})
+	if err == nil {
+		return true, nil
+	}
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return false, nil
+	}
+	return false, err
+}
+
+// ListObjects 列出 prefix 底下所有物件（遞迴）
+func (m *minIOClient) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	for obj := range m.client.ListObjects(ctx, m.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("列出物件 [%s] 失敗: %w", prefix, obj.Err)
+		}
+		objects = append(objects, ObjectInfo{
+			Key:          obj.Key,
+			Size:         obj.Size,
+			ContentType:  obj.ContentType,
+			LastModified: obj.LastModified,
+		})
+	}
+	return objects, nil
+}
+
+// CopyObject 在 bucket 內複製物件（server-side copy），保留 Content-Type
+func (m *minIOClient) CopyObject(ctx context.Context, srcObject, destObject string) error {
+	_, err := m.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: m.bucketName, Object: destObject},
+		minio.CopySrcOptions{Bucket: m.bucketName, Object: srcObject})
+	if err != nil {
+		return fmt.Errorf("複製物件 [%s] 到 [%s] 失敗: %w", srcObject, destObject, err)
+	}
+	return nil
+}
+
+// RemoveObject 刪除物件，物件不存在時不回傳錯誤
+func (m *minIOClient) RemoveObject(ctx context.Context, objectName string) error {
+	if err := m.client.RemoveObject(ctx, m.bucketName, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("刪除物件 [%s] 失敗: %w", objectName, err)
+	}
+	return nil
+}
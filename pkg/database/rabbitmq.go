@@ -1,8 +1,10 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/streadway/amqp"
@@ -68,3 +70,171 @@ func (r *rabbitRepo) GetRabbit() *amqp.Channel {
 func (r *rabbitRepo) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
 	return r.channel.Publish(exchange, key, mandatory, immediate, msg)
 }
+
+// confirmRabbitRepo publisher confirms 模式的 channel，Publish 等待 broker 確認後才回傳
+type confirmRabbitRepo struct {
+	channel  *amqp.Channel
+	confirms chan amqp.Confirmation
+	timeout  time.Duration
+
+	mu  sync.Mutex
+	seq uint64 // 最後一則訊息的 delivery tag
+}
+
+// NewConfirmRabbitRepository 將 channel 切換為 publisher confirms 模式
+// 切換後該 channel 上的每則訊息都會收到確認，應使用專用的 channel，不要與其他 Publish 共用
+func NewConfirmRabbitRepository(ch *amqp.Channel, timeout time.Duration) (RabbitRepo, error) {
+	if err := ch.Confirm(false); err != nil {
+		return nil, fmt.Errorf("RabbitMQ Channel 切換 confirm 模式失敗: %v", err)
+	}
+	return &confirmRabbitRepo{
+		channel:  ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, 64)), // 保留逾時後才到達的確認，避免阻塞連線
+		timeout:  timeout,
+	}, nil
+}
+
+func (r *confirmRabbitRepo) GetRabbit() *amqp.Channel {
+	return r.channel
+}
+
+// Publish 發布訊息並等待 broker 的 ack，收到 nack 或逾時回傳錯誤
+// 逾時後才到達的確認，delivery tag 小於目前訊息，會在下一次 Publish 時略過
+func (r *confirmRabbitRepo) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.channel.Publish(exchange, key, mandatory, immediate, msg); err != nil {
+		return err
+	}
+	r.seq++
+
+	timer := time.NewTimer(r.timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case confirm, ok := <-r.confirms:
+			if !ok {
+				return fmt.Errorf("RabbitMQ Channel 已關閉，未收到 delivery tag %d 的確認", r.seq)
+			}
+			if confirm.DeliveryTag < r.seq {
+				continue
+			}
+			if !confirm.Ack {
+				return fmt.Errorf("RabbitMQ 拒絕訊息 (delivery tag %d)", confirm.DeliveryTag)
+			}
+			return nil
+		case <-timer.C:
+			return fmt.Errorf("等待 RabbitMQ 確認逾時 (delivery tag %d)", r.seq)
+		}
+	}
+}
+
+type rabbitJobQueue struct {
+	publisher RabbitRepo    // publisher confirms 模式的 channel
+	consumer  *amqp.Channel // 消費用的 channel
+	retry     RetryPolicy
+
+	mu       sync.Mutex
+	declared map[string]bool
+}
+
+// NewRabbitJobQueue create RabbitMQ JobQueue，topic 為 durable queue 名稱，使用預設 exchange
+// 發布與消費使用不同的 channel，發布的 channel 切換為 publisher confirms 模式
+func NewRabbitJobQueue(conn *amqp.Connection, confirmTimeout time.Duration, retry RetryPolicy) (JobQueue, error) {
+	publishChannel, err := conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("建立 RabbitMQ Channel 失敗: %v", err)
+	}
+	publisher, err := NewConfirmRabbitRepository(publishChannel, confirmTimeout)
+	if err != nil {
+		publishChannel.Close()
+		return nil, err
+	}
+	consumeChannel, err := conn.Channel()
+	if err != nil {
+		publishChannel.Close()
+		return nil, fmt.Errorf("建立 RabbitMQ Channel 失敗: %v", err)
+	}
+	return &rabbitJobQueue{
+		publisher: publisher,
+		consumer:  consumeChannel,
+		retry:     retry.withDefaults(),
+		declared:  map[string]bool{},
+	}, nil
+}
+
+// declare 第一次使用 topic 時宣告 durable queue
+func (q *rabbitJobQueue) declare(topic string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.declared[topic] {
+		return nil
+	}
+	if _, err := q.consumer.QueueDeclare(topic, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("宣告 RabbitMQ queue[%s] 失敗: %v", topic, err)
+	}
+	q.declared[topic] = true
+	return nil
+}
+
+// Publish 以持久化訊息發布到 topic，broker 確認後才回傳
+func (q *rabbitJobQueue) Publish(ctx context.Context, topic string, msg QueueMessage) error {
+	if err := q.declare(topic); err != nil {
+		return err
+	}
+	return q.publisher.Publish("", topic, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    msg.ID,
+		Type:         msg.Type,
+		Timestamp:    msg.Timestamp,
+		Body:         msg.Body,
+	})
+}
+
+// Consume 手動確認：處理成功 Ack，失敗時依 RetryPolicy 重試，次數用盡後轉送到 DeadLetterTopic 再 Ack
+// 只有轉送失敗時才 Nack 重新排入佇列
+func (q *rabbitJobQueue) Consume(ctx context.Context, topic string, handler JobHandler) error {
+	if err := q.declare(topic); err != nil {
+		return err
+	}
+	deliveries, err := q.consumer.Consume(topic, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("無法開始消費 RabbitMQ queue[%s]: %v", topic, err)
+	}
+	for {
+		select {
+		case d, ok := <-deliveries:
+			if !ok {
+				return fmt.Errorf("RabbitMQ queue[%s] 消費 channel 已關閉", topic)
+			}
+			msg := QueueMessage{ID: d.MessageId, Type: d.Type, Body: d.Body, Timestamp: d.Timestamp}
+			if err := q.retry.handle(ctx, msg, handler); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if publishErr := q.Publish(ctx, DeadLetterTopic(topic), msg); publishErr != nil {
+					log.Printf("訊息 %s 轉送到 dead-letter queue 失敗: %v", msg.ID, publishErr)
+					if err := d.Nack(false, true); err != nil {
+						log.Printf("Nack 訊息失敗: %v", err)
+					}
+					continue
+				}
+				log.Printf("queue[%s] 訊息 %s 重試 %d 次仍失敗，已轉送到 %s: %v", topic, msg.ID, q.retry.MaxAttempts, DeadLetterTopic(topic), err)
+			}
+			if err := d.Ack(false); err != nil {
+				log.Printf("確認訊息失敗: %v", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (q *rabbitJobQueue) Close() error {
+	if err := q.consumer.Close(); err != nil {
+		return err
+	}
+	return q.publisher.GetRabbit().Close()
+}
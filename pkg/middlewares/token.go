@@ -18,6 +18,10 @@ const (
 	TokenMemberID = "MemberID"
 	//TokenRole get role form token, set c.locals name
 	TokenRole = "role"
+	//TokenBirthdate get birthdate form token, set c.locals name
+	TokenBirthdate = "birthdate"
+	//TokenAgeVerified get age verified form token, set c.locals name
+	TokenAgeVerified = "age_verified"
 )
 
 // JWTMiddleware validates JWT in the Authorization header
@@ -56,6 +60,8 @@ func JWTMiddleware() fiber.Handler {
 		if claims, ok := token.Claims.(*t_token.Claims); ok && token.Valid {
 			c.Locals(TokenMemberID, claims.MemberID)
 			c.Locals(TokenRole, claims.Role)
+			c.Locals(TokenBirthdate, claims.Birthdate)
+			c.Locals(TokenAgeVerified, claims.AgeVerified)
 		} else {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"error": "Invalid token claims",
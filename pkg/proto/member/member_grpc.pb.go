@@ -27,6 +27,8 @@ const (
 	MemberService_ForceLogout_FullMethodName         = "/member.MemberService/ForceLogout"
 	MemberService_CheckSessionTimeout_FullMethodName = "/member.MemberService/CheckSessionTimeout"
 	MemberService_ReconnectSession_FullMethodName    = "/member.MemberService/ReconnectSession"
+	MemberService_SetBirthdate_FullMethodName        = "/member.MemberService/SetBirthdate"
+	MemberService_SetAgeVerified_FullMethodName      = "/member.MemberService/SetAgeVerified"
 )
 
 // MemberServiceClient is the client API for MemberService service.
@@ -41,6 +43,9 @@ type MemberServiceClient interface {
 	ForceLogout(ctx context.Context, in *ForceLogoutReq, opts ...grpc.CallOption) (*ForceLogoutRes, error)
 	CheckSessionTimeout(ctx context.Context, in *CheckSessionTimeoutReq, opts ...grpc.CallOption) (*CheckSessionTimeoutRes, error)
 	ReconnectSession(ctx context.Context, in *ReconnectSessionReq, opts ...grpc.CallOption) (*ReconnectSessionRes, error)
+	// 年齡資料：會員填寫生日（填寫後不可修改），管理員驗證成年；寫入 JWT，下次登入後生效
+	SetBirthdate(ctx context.Context, in *SetBirthdateReq, opts ...grpc.CallOption) (*SetBirthdateRes, error)
+	SetAgeVerified(ctx context.Context, in *SetAgeVerifiedReq, opts ...grpc.CallOption) (*SetAgeVerifiedRes, error)
 }
 
 type memberServiceClient struct {
@@ -131,6 +136,26 @@ func (c *memberServiceClient) ReconnectSession(ctx context.Context, in *Reconnec
 	return out, nil
 }
 
+func (c *memberServiceClient) SetBirthdate(ctx context.Context, in *SetBirthdateReq, opts ...grpc.CallOption) (*SetBirthdateRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBirthdateRes)
+	err := c.cc.Invoke(ctx, MemberService_SetBirthdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SetAgeVerified(ctx context.Context, in *SetAgeVerifiedReq, opts ...grpc.CallOption) (*SetAgeVerifiedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAgeVerifiedRes)
+	err := c.cc.Invoke(ctx, MemberService_SetAgeVerified_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemberServiceServer is the server API for MemberService service.
 // All implementations must embed UnimplementedMemberServiceServer
 // for forward compatibility.
@@ -143,6 +168,9 @@ type MemberServiceServer interface {
 	ForceLogout(context.Context, *ForceLogoutReq) (*ForceLogoutRes, error)
 	CheckSessionTimeout(context.Context, *CheckSessionTimeoutReq) (*CheckSessionTimeoutRes, error)
 	ReconnectSession(context.Context, *ReconnectSessionReq) (*ReconnectSessionRes, error)
+	// 年齡資料：會員填寫生日（填寫後不可修改），管理員驗證成年；寫入 JWT，下次登入後生效
+	SetBirthdate(context.Context, *SetBirthdateReq) (*SetBirthdateRes, error)
+	SetAgeVerified(context.Context, *SetAgeVerifiedReq) (*SetAgeVerifiedRes, error)
 	mustEmbedUnimplementedMemberServiceServer()
 }
 
@@ -177,6 +205,12 @@ func (UnimplementedMemberServiceServer) CheckSessionTimeout(context.Context, *Ch
 func (UnimplementedMemberServiceServer) ReconnectSession(context.Context, *ReconnectSessionReq) (*ReconnectSessionRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReconnectSession not implemented")
 }
+func (UnimplementedMemberServiceServer) SetBirthdate(context.Context, *SetBirthdateReq) (*SetBirthdateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBirthdate not implemented")
+}
+func (UnimplementedMemberServiceServer) SetAgeVerified(context.Context, *SetAgeVerifiedReq) (*SetAgeVerifiedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAgeVerified not implemented")
+}
 func (UnimplementedMemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {}
 func (UnimplementedMemberServiceServer) testEmbeddedByValue()                       {}
 
@@ -342,6 +376,42 @@ func _MemberService_ReconnectSession_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemberService_SetBirthdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBirthdateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SetBirthdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SetBirthdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SetBirthdate(ctx, req.(*SetBirthdateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_SetAgeVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAgeVerifiedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SetAgeVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SetAgeVerified_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SetAgeVerified(ctx, req.(*SetAgeVerifiedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +451,14 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReconnectSession",
 			Handler:    _MemberService_ReconnectSession_Handler,
 		},
+		{
+			MethodName: "SetBirthdate",
+			Handler:    _MemberService_SetBirthdate_Handler,
+		},
+		{
+			MethodName: "SetAgeVerified",
+			Handler:    _MemberService_SetAgeVerified_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "member/member.proto",
@@ -19,12 +19,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StreamingService_UploadVideo_FullMethodName        = "/streaming.StreamingService/UploadVideo"
-	StreamingService_GetVideo_FullMethodName           = "/streaming.StreamingService/GetVideo"
-	StreamingService_Search_FullMethodName             = "/streaming.StreamingService/Search"
-	StreamingService_GetRecommendations_FullMethodName = "/streaming.StreamingService/GetRecommendations"
-	StreamingService_GetIndexM3U8_FullMethodName       = "/streaming.StreamingService/GetIndexM3U8"
-	StreamingService_GetHlsSegment_FullMethodName      = "/streaming.StreamingService/GetHlsSegment"
+	StreamingService_UploadVideo_FullMethodName          = "/streaming.StreamingService/UploadVideo"
+	StreamingService_GetVideo_FullMethodName             = "/streaming.StreamingService/GetVideo"
+	StreamingService_Search_FullMethodName               = "/streaming.StreamingService/Search"
+	StreamingService_GetRecommendations_FullMethodName   = "/streaming.StreamingService/GetRecommendations"
+	StreamingService_GetIndexM3U8_FullMethodName         = "/streaming.StreamingService/GetIndexM3U8"
+	StreamingService_GetHlsSegment_FullMethodName        = "/streaming.StreamingService/GetHlsSegment"
+	StreamingService_UpdateVisibility_FullMethodName     = "/streaming.StreamingService/UpdateVisibility"
+	StreamingService_ShareVideo_FullMethodName           = "/streaming.StreamingService/ShareVideo"
+	StreamingService_UnshareVideo_FullMethodName         = "/streaming.StreamingService/UnshareVideo"
+	StreamingService_ListCategories_FullMethodName       = "/streaming.StreamingService/ListCategories"
+	StreamingService_BrowseCategory_FullMethodName       = "/streaming.StreamingService/BrowseCategory"
+	StreamingService_GetRelatedVideos_FullMethodName     = "/streaming.StreamingService/GetRelatedVideos"
+	StreamingService_SetChapters_FullMethodName          = "/streaming.StreamingService/SetChapters"
+	StreamingService_GetChaptersVTT_FullMethodName       = "/streaming.StreamingService/GetChaptersVTT"
+	StreamingService_CreateClip_FullMethodName           = "/streaming.StreamingService/CreateClip"
+	StreamingService_ListUploads_FullMethodName          = "/streaming.StreamingService/ListUploads"
+	StreamingService_SetChannelWatermark_FullMethodName  = "/streaming.StreamingService/SetChannelWatermark"
+	StreamingService_GetChannelWatermark_FullMethodName  = "/streaming.StreamingService/GetChannelWatermark"
+	StreamingService_SetPlatformWatermark_FullMethodName = "/streaming.StreamingService/SetPlatformWatermark"
+	StreamingService_GetPlatformWatermark_FullMethodName = "/streaming.StreamingService/GetPlatformWatermark"
+	StreamingService_ReportQoE_FullMethodName            = "/streaming.StreamingService/ReportQoE"
+	StreamingService_GetQoESummary_FullMethodName        = "/streaming.StreamingService/GetQoESummary"
+	StreamingService_ReportVideo_FullMethodName          = "/streaming.StreamingService/ReportVideo"
+	StreamingService_ListReports_FullMethodName          = "/streaming.StreamingService/ListReports"
+	StreamingService_ClaimReport_FullMethodName          = "/streaming.StreamingService/ClaimReport"
+	StreamingService_ResolveReport_FullMethodName        = "/streaming.StreamingService/ResolveReport"
+	StreamingService_ListModerationAudit_FullMethodName  = "/streaming.StreamingService/ListModerationAudit"
+	StreamingService_SetContentRating_FullMethodName     = "/streaming.StreamingService/SetContentRating"
+	StreamingService_ListThumbnails_FullMethodName       = "/streaming.StreamingService/ListThumbnails"
+	StreamingService_UploadThumbnail_FullMethodName      = "/streaming.StreamingService/UploadThumbnail"
+	StreamingService_SetActiveThumbnail_FullMethodName   = "/streaming.StreamingService/SetActiveThumbnail"
+	StreamingService_RequestDownload_FullMethodName      = "/streaming.StreamingService/RequestDownload"
+	StreamingService_SetDownloadEnabled_FullMethodName   = "/streaming.StreamingService/SetDownloadEnabled"
+	StreamingService_GetQuotaUsage_FullMethodName        = "/streaming.StreamingService/GetQuotaUsage"
+	StreamingService_SetMemberQuota_FullMethodName       = "/streaming.StreamingService/SetMemberQuota"
+	StreamingService_DeleteVideo_FullMethodName          = "/streaming.StreamingService/DeleteVideo"
+	StreamingService_CreatePlaylist_FullMethodName       = "/streaming.StreamingService/CreatePlaylist"
+	StreamingService_UpdatePlaylist_FullMethodName       = "/streaming.StreamingService/UpdatePlaylist"
+	StreamingService_DeletePlaylist_FullMethodName       = "/streaming.StreamingService/DeletePlaylist"
+	StreamingService_ListPlaylists_FullMethodName        = "/streaming.StreamingService/ListPlaylists"
+	StreamingService_GetPlaylist_FullMethodName          = "/streaming.StreamingService/GetPlaylist"
+	StreamingService_AddPlaylistItem_FullMethodName      = "/streaming.StreamingService/AddPlaylistItem"
+	StreamingService_RemovePlaylistItem_FullMethodName   = "/streaming.StreamingService/RemovePlaylistItem"
+	StreamingService_ReorderPlaylist_FullMethodName      = "/streaming.StreamingService/ReorderPlaylist"
+	StreamingService_ReactToVideo_FullMethodName         = "/streaming.StreamingService/ReactToVideo"
+	StreamingService_GetVideoReactions_FullMethodName    = "/streaming.StreamingService/GetVideoReactions"
+	StreamingService_ListLikedVideos_FullMethodName      = "/streaming.StreamingService/ListLikedVideos"
+	StreamingService_PostComment_FullMethodName          = "/streaming.StreamingService/PostComment"
+	StreamingService_EditComment_FullMethodName          = "/streaming.StreamingService/EditComment"
+	StreamingService_DeleteComment_FullMethodName        = "/streaming.StreamingService/DeleteComment"
+	StreamingService_PinComment_FullMethodName           = "/streaming.StreamingService/PinComment"
+	StreamingService_ListComments_FullMethodName         = "/streaming.StreamingService/ListComments"
+	StreamingService_ListCommentReplies_FullMethodName   = "/streaming.StreamingService/ListCommentReplies"
+	StreamingService_GetTrending_FullMethodName          = "/streaming.StreamingService/GetTrending"
+	StreamingService_GetShortsFeed_FullMethodName        = "/streaming.StreamingService/GetShortsFeed"
+	StreamingService_FollowChannel_FullMethodName        = "/streaming.StreamingService/FollowChannel"
+	StreamingService_UnfollowChannel_FullMethodName      = "/streaming.StreamingService/UnfollowChannel"
 )
 
 // StreamingServiceClient is the client API for StreamingService service.
@@ -47,6 +98,73 @@ type StreamingServiceClient interface {
 	GetRecommendations(ctx context.Context, in *GetRecommendationsReq, opts ...grpc.CallOption) (*GetRecommendationsRes, error)
 	GetIndexM3U8(ctx context.Context, in *GetIndexM3U8Req, opts ...grpc.CallOption) (*GetIndexM3U8Res, error)
 	GetHlsSegment(ctx context.Context, in *GetHlsSegmentReq, opts ...grpc.CallOption) (*GetHlsSegmentRes, error)
+	// 影片可見度與分享名單（僅上傳者可操作）
+	UpdateVisibility(ctx context.Context, in *UpdateVisibilityReq, opts ...grpc.CallOption) (*UpdateVisibilityRes, error)
+	ShareVideo(ctx context.Context, in *ShareVideoReq, opts ...grpc.CallOption) (*ShareVideoRes, error)
+	UnshareVideo(ctx context.Context, in *UnshareVideoReq, opts ...grpc.CallOption) (*UnshareVideoRes, error)
+	// 分類瀏覽與相關影片
+	ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesRes, error)
+	BrowseCategory(ctx context.Context, in *BrowseCategoryReq, opts ...grpc.CallOption) (*BrowseCategoryRes, error)
+	GetRelatedVideos(ctx context.Context, in *GetRelatedVideosReq, opts ...grpc.CallOption) (*GetRelatedVideosRes, error)
+	// 章節：上傳者設定，或由說明欄解析；另提供 WebVTT chapters track
+	SetChapters(ctx context.Context, in *SetChaptersReq, opts ...grpc.CallOption) (*SetChaptersRes, error)
+	GetChaptersVTT(ctx context.Context, in *GetChaptersVTTReq, opts ...grpc.CallOption) (*GetChaptersVTTRes, error)
+	// 剪輯：由已轉碼的影片剪出片段成為新影片；上傳影片列表含剪輯的片段
+	CreateClip(ctx context.Context, in *CreateClipReq, opts ...grpc.CallOption) (*CreateClipRes, error)
+	ListUploads(ctx context.Context, in *ListUploadsReq, opts ...grpc.CallOption) (*ListUploadsRes, error)
+	// 浮水印：頻道浮水印於上傳時選擇燒錄；平台浮水印由管理員設定，燒錄在免費方案的影片
+	SetChannelWatermark(ctx context.Context, in *SetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error)
+	GetChannelWatermark(ctx context.Context, in *GetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error)
+	SetPlatformWatermark(ctx context.Context, in *SetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error)
+	GetPlatformWatermark(ctx context.Context, in *GetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error)
+	// 播放 QoE：播放器批次回報 beacon，非同步彙整為每部影片、每個 rendition 的統計；統計僅上傳者與管理員可查詢
+	ReportQoE(ctx context.Context, in *ReportQoEReq, opts ...grpc.CallOption) (*ReportQoERes, error)
+	GetQoESummary(ctx context.Context, in *GetQoESummaryReq, opts ...grpc.CallOption) (*GetQoESummaryRes, error)
+	// 檢舉與審核：會員檢舉影片，管理員認領並處理（dismiss、age_restrict、hide、remove），每個動作寫入稽核紀錄
+	ReportVideo(ctx context.Context, in *ReportVideoReq, opts ...grpc.CallOption) (*ReportVideoRes, error)
+	ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListReportsRes, error)
+	ClaimReport(ctx context.Context, in *ClaimReportReq, opts ...grpc.CallOption) (*ReportRes, error)
+	ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*ReportRes, error)
+	ListModerationAudit(ctx context.Context, in *ListModerationAuditReq, opts ...grpc.CallOption) (*ListModerationAuditRes, error)
+	// 影片分級：上傳者可修改未鎖定的分級，管理員覆寫後鎖定並寫入稽核紀錄
+	SetContentRating(ctx context.Context, in *SetContentRatingReq, opts ...grpc.CallOption) (*SetContentRatingRes, error)
+	// 縮圖：轉碼時依場景變化與亮度挑選候選縮圖，上傳者可上傳自訂縮圖並選擇使用中的縮圖
+	ListThumbnails(ctx context.Context, in *ListThumbnailsReq, opts ...grpc.CallOption) (*ListThumbnailsRes, error)
+	UploadThumbnail(ctx context.Context, in *UploadThumbnailReq, opts ...grpc.CallOption) (*ThumbnailRes, error)
+	SetActiveThumbnail(ctx context.Context, in *SetActiveThumbnailReq, opts ...grpc.CallOption) (*ThumbnailRes, error)
+	// 離線下載：依畫質產生 faststart MP4 並快取在 MinIO，回傳短效簽章連結；上傳者可停用下載
+	RequestDownload(ctx context.Context, in *RequestDownloadReq, opts ...grpc.CallOption) (*RequestDownloadRes, error)
+	SetDownloadEnabled(ctx context.Context, in *SetDownloadEnabledReq, opts ...grpc.CallOption) (*SetDownloadEnabledRes, error)
+	// 上傳配額：依會員個別設定或角色限制總儲存量、影片數與每日上傳次數，刪除影片時釋放用量
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageReq, opts ...grpc.CallOption) (*GetQuotaUsageRes, error)
+	SetMemberQuota(ctx context.Context, in *SetMemberQuotaReq, opts ...grpc.CallOption) (*SetMemberQuotaRes, error)
+	DeleteVideo(ctx context.Context, in *DeleteVideoReq, opts ...grpc.CallOption) (*DeleteVideoRes, error)
+	// 播放清單，playlist_id 帶入 "watch_later" 代表呼叫者的「稍後觀看」
+	CreatePlaylist(ctx context.Context, in *CreatePlaylistReq, opts ...grpc.CallOption) (*CreatePlaylistRes, error)
+	UpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq, opts ...grpc.CallOption) (*UpdatePlaylistRes, error)
+	DeletePlaylist(ctx context.Context, in *DeletePlaylistReq, opts ...grpc.CallOption) (*DeletePlaylistRes, error)
+	ListPlaylists(ctx context.Context, in *ListPlaylistsReq, opts ...grpc.CallOption) (*ListPlaylistsRes, error)
+	GetPlaylist(ctx context.Context, in *GetPlaylistReq, opts ...grpc.CallOption) (*GetPlaylistRes, error)
+	AddPlaylistItem(ctx context.Context, in *AddPlaylistItemReq, opts ...grpc.CallOption) (*AddPlaylistItemRes, error)
+	RemovePlaylistItem(ctx context.Context, in *RemovePlaylistItemReq, opts ...grpc.CallOption) (*RemovePlaylistItemRes, error)
+	ReorderPlaylist(ctx context.Context, in *ReorderPlaylistReq, opts ...grpc.CallOption) (*ReorderPlaylistRes, error)
+	// 按讚 / 倒讚
+	ReactToVideo(ctx context.Context, in *ReactToVideoReq, opts ...grpc.CallOption) (*ReactToVideoRes, error)
+	GetVideoReactions(ctx context.Context, in *GetVideoReactionsReq, opts ...grpc.CallOption) (*GetVideoReactionsRes, error)
+	ListLikedVideos(ctx context.Context, in *ListLikedVideosReq, opts ...grpc.CallOption) (*ListLikedVideosRes, error)
+	// 留言：頂層留言與一層回覆，僅能修改 / 刪除自己的留言，影片上傳者可置頂
+	PostComment(ctx context.Context, in *PostCommentReq, opts ...grpc.CallOption) (*PostCommentRes, error)
+	EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*EditCommentRes, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error)
+	PinComment(ctx context.Context, in *PinCommentReq, opts ...grpc.CallOption) (*PinCommentRes, error)
+	ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsRes, error)
+	ListCommentReplies(ctx context.Context, in *ListCommentRepliesReq, opts ...grpc.CallOption) (*ListCommentsRes, error)
+	// 熱門排行榜：依最近瀏覽、按讚、留言的時間衰減分數排序，可依分類與類型篩選
+	GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*GetTrendingRes, error)
+	// 短影音動態：不會結束、不重複的游標分頁，穿插追蹤頻道、熱門與最新的短影音
+	GetShortsFeed(ctx context.Context, in *GetShortsFeedReq, opts ...grpc.CallOption) (*GetShortsFeedRes, error)
+	FollowChannel(ctx context.Context, in *FollowChannelReq, opts ...grpc.CallOption) (*FollowChannelRes, error)
+	UnfollowChannel(ctx context.Context, in *FollowChannelReq, opts ...grpc.CallOption) (*FollowChannelRes, error)
 }
 
 type streamingServiceClient struct {
@@ -120,6 +238,516 @@ func (c *streamingServiceClient) GetHlsSegment(ctx context.Context, in *GetHlsSe
 	return out, nil
 }
 
+func (c *streamingServiceClient) UpdateVisibility(ctx context.Context, in *UpdateVisibilityReq, opts ...grpc.CallOption) (*UpdateVisibilityRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVisibilityRes)
+	err := c.cc.Invoke(ctx, StreamingService_UpdateVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ShareVideo(ctx context.Context, in *ShareVideoReq, opts ...grpc.CallOption) (*ShareVideoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareVideoRes)
+	err := c.cc.Invoke(ctx, StreamingService_ShareVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) UnshareVideo(ctx context.Context, in *UnshareVideoReq, opts ...grpc.CallOption) (*UnshareVideoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareVideoRes)
+	err := c.cc.Invoke(ctx, StreamingService_UnshareVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListCategories(ctx context.Context, in *ListCategoriesReq, opts ...grpc.CallOption) (*ListCategoriesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) BrowseCategory(ctx context.Context, in *BrowseCategoryReq, opts ...grpc.CallOption) (*BrowseCategoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrowseCategoryRes)
+	err := c.cc.Invoke(ctx, StreamingService_BrowseCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetRelatedVideos(ctx context.Context, in *GetRelatedVideosReq, opts ...grpc.CallOption) (*GetRelatedVideosRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedVideosRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetRelatedVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetChapters(ctx context.Context, in *SetChaptersReq, opts ...grpc.CallOption) (*SetChaptersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChaptersRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetChaptersVTT(ctx context.Context, in *GetChaptersVTTReq, opts ...grpc.CallOption) (*GetChaptersVTTRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChaptersVTTRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetChaptersVTT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) CreateClip(ctx context.Context, in *CreateClipReq, opts ...grpc.CallOption) (*CreateClipRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClipRes)
+	err := c.cc.Invoke(ctx, StreamingService_CreateClip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListUploads(ctx context.Context, in *ListUploadsReq, opts ...grpc.CallOption) (*ListUploadsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUploadsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListUploads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetChannelWatermark(ctx context.Context, in *SetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatermarkRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetChannelWatermark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetChannelWatermark(ctx context.Context, in *GetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatermarkRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetChannelWatermark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetPlatformWatermark(ctx context.Context, in *SetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatermarkRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetPlatformWatermark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetPlatformWatermark(ctx context.Context, in *GetWatermarkReq, opts ...grpc.CallOption) (*WatermarkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatermarkRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetPlatformWatermark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ReportQoE(ctx context.Context, in *ReportQoEReq, opts ...grpc.CallOption) (*ReportQoERes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportQoERes)
+	err := c.cc.Invoke(ctx, StreamingService_ReportQoE_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetQoESummary(ctx context.Context, in *GetQoESummaryReq, opts ...grpc.CallOption) (*GetQoESummaryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQoESummaryRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetQoESummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ReportVideo(ctx context.Context, in *ReportVideoReq, opts ...grpc.CallOption) (*ReportVideoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportVideoRes)
+	err := c.cc.Invoke(ctx, StreamingService_ReportVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ListReportsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ClaimReport(ctx context.Context, in *ClaimReportReq, opts ...grpc.CallOption) (*ReportRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportRes)
+	err := c.cc.Invoke(ctx, StreamingService_ClaimReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*ReportRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportRes)
+	err := c.cc.Invoke(ctx, StreamingService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListModerationAudit(ctx context.Context, in *ListModerationAuditReq, opts ...grpc.CallOption) (*ListModerationAuditRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationAuditRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListModerationAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetContentRating(ctx context.Context, in *SetContentRatingReq, opts ...grpc.CallOption) (*SetContentRatingRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetContentRatingRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetContentRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListThumbnails(ctx context.Context, in *ListThumbnailsReq, opts ...grpc.CallOption) (*ListThumbnailsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListThumbnailsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListThumbnails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) UploadThumbnail(ctx context.Context, in *UploadThumbnailReq, opts ...grpc.CallOption) (*ThumbnailRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThumbnailRes)
+	err := c.cc.Invoke(ctx, StreamingService_UploadThumbnail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetActiveThumbnail(ctx context.Context, in *SetActiveThumbnailReq, opts ...grpc.CallOption) (*ThumbnailRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThumbnailRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetActiveThumbnail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) RequestDownload(ctx context.Context, in *RequestDownloadReq, opts ...grpc.CallOption) (*RequestDownloadRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDownloadRes)
+	err := c.cc.Invoke(ctx, StreamingService_RequestDownload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetDownloadEnabled(ctx context.Context, in *SetDownloadEnabledReq, opts ...grpc.CallOption) (*SetDownloadEnabledRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDownloadEnabledRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetDownloadEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageReq, opts ...grpc.CallOption) (*GetQuotaUsageRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaUsageRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetQuotaUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetMemberQuota(ctx context.Context, in *SetMemberQuotaReq, opts ...grpc.CallOption) (*SetMemberQuotaRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberQuotaRes)
+	err := c.cc.Invoke(ctx, StreamingService_SetMemberQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) DeleteVideo(ctx context.Context, in *DeleteVideoReq, opts ...grpc.CallOption) (*DeleteVideoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVideoRes)
+	err := c.cc.Invoke(ctx, StreamingService_DeleteVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistReq, opts ...grpc.CallOption) (*CreatePlaylistRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlaylistRes)
+	err := c.cc.Invoke(ctx, StreamingService_CreatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) UpdatePlaylist(ctx context.Context, in *UpdatePlaylistReq, opts ...grpc.CallOption) (*UpdatePlaylistRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlaylistRes)
+	err := c.cc.Invoke(ctx, StreamingService_UpdatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistReq, opts ...grpc.CallOption) (*DeletePlaylistRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlaylistRes)
+	err := c.cc.Invoke(ctx, StreamingService_DeletePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListPlaylists(ctx context.Context, in *ListPlaylistsReq, opts ...grpc.CallOption) (*ListPlaylistsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlaylistsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListPlaylists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetPlaylist(ctx context.Context, in *GetPlaylistReq, opts ...grpc.CallOption) (*GetPlaylistRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlaylistRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) AddPlaylistItem(ctx context.Context, in *AddPlaylistItemReq, opts ...grpc.CallOption) (*AddPlaylistItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPlaylistItemRes)
+	err := c.cc.Invoke(ctx, StreamingService_AddPlaylistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) RemovePlaylistItem(ctx context.Context, in *RemovePlaylistItemReq, opts ...grpc.CallOption) (*RemovePlaylistItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePlaylistItemRes)
+	err := c.cc.Invoke(ctx, StreamingService_RemovePlaylistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ReorderPlaylist(ctx context.Context, in *ReorderPlaylistReq, opts ...grpc.CallOption) (*ReorderPlaylistRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPlaylistRes)
+	err := c.cc.Invoke(ctx, StreamingService_ReorderPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ReactToVideo(ctx context.Context, in *ReactToVideoReq, opts ...grpc.CallOption) (*ReactToVideoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactToVideoRes)
+	err := c.cc.Invoke(ctx, StreamingService_ReactToVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetVideoReactions(ctx context.Context, in *GetVideoReactionsReq, opts ...grpc.CallOption) (*GetVideoReactionsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoReactionsRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetVideoReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListLikedVideos(ctx context.Context, in *ListLikedVideosReq, opts ...grpc.CallOption) (*ListLikedVideosRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLikedVideosRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListLikedVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) PostComment(ctx context.Context, in *PostCommentReq, opts ...grpc.CallOption) (*PostCommentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostCommentRes)
+	err := c.cc.Invoke(ctx, StreamingService_PostComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*EditCommentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentRes)
+	err := c.cc.Invoke(ctx, StreamingService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentRes)
+	err := c.cc.Invoke(ctx, StreamingService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) PinComment(ctx context.Context, in *PinCommentReq, opts ...grpc.CallOption) (*PinCommentRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinCommentRes)
+	err := c.cc.Invoke(ctx, StreamingService_PinComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListCommentReplies(ctx context.Context, in *ListCommentRepliesReq, opts ...grpc.CallOption) (*ListCommentsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsRes)
+	err := c.cc.Invoke(ctx, StreamingService_ListCommentReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*GetTrendingRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetShortsFeed(ctx context.Context, in *GetShortsFeedReq, opts ...grpc.CallOption) (*GetShortsFeedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShortsFeedRes)
+	err := c.cc.Invoke(ctx, StreamingService_GetShortsFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) FollowChannel(ctx context.Context, in *FollowChannelReq, opts ...grpc.CallOption) (*FollowChannelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowChannelRes)
+	err := c.cc.Invoke(ctx, StreamingService_FollowChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) UnfollowChannel(ctx context.Context, in *FollowChannelReq, opts ...grpc.CallOption) (*FollowChannelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowChannelRes)
+	err := c.cc.Invoke(ctx, StreamingService_UnfollowChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamingServiceServer is the server API for StreamingService service.
 // All implementations must embed UnimplementedStreamingServiceServer
 // for forward compatibility.
@@ -140,148 +768,1286 @@ type StreamingServiceServer interface {
 	GetRecommendations(context.Context, *GetRecommendationsReq) (*GetRecommendationsRes, error)
 	GetIndexM3U8(context.Context, *GetIndexM3U8Req) (*GetIndexM3U8Res, error)
 	GetHlsSegment(context.Context, *GetHlsSegmentReq) (*GetHlsSegmentRes, error)
+	// 影片可見度與分享名單（僅上傳者可操作）
+	UpdateVisibility(context.Context, *UpdateVisibilityReq) (*UpdateVisibilityRes, error)
+	ShareVideo(context.Context, *ShareVideoReq) (*ShareVideoRes, error)
+	UnshareVideo(context.Context, *UnshareVideoReq) (*UnshareVideoRes, error)
+	// 分類瀏覽與相關影片
+	ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesRes, error)
+	BrowseCategory(context.Context, *BrowseCategoryReq) (*BrowseCategoryRes, error)
+	GetRelatedVideos(context.Context, *GetRelatedVideosReq) (*GetRelatedVideosRes, error)
+	// 章節：上傳者設定，或由說明欄解析；另提供 WebVTT chapters track
+	SetChapters(context.Context, *SetChaptersReq) (*SetChaptersRes, error)
+	GetChaptersVTT(context.Context, *GetChaptersVTTReq) (*GetChaptersVTTRes, error)
+	// 剪輯：由已轉碼的影片剪出片段成為新影片；上傳影片列表含剪輯的片段
+	CreateClip(context.Context, *CreateClipReq) (*CreateClipRes, error)
+	ListUploads(context.Context, *ListUploadsReq) (*ListUploadsRes, error)
+	// 浮水印：頻道浮水印於上傳時選擇燒錄；平台浮水印由管理員設定，燒錄在免費方案的影片
+	SetChannelWatermark(context.Context, *SetWatermarkReq) (*WatermarkRes, error)
+	GetChannelWatermark(context.Context, *GetWatermarkReq) (*WatermarkRes, error)
+	SetPlatformWatermark(context.Context, *SetWatermarkReq) (*WatermarkRes, error)
+	GetPlatformWatermark(context.Context, *GetWatermarkReq) (*WatermarkRes, error)
+	// 播放 QoE：播放器批次回報 beacon，非同步彙整為每部影片、每個 rendition 的統計；統計僅上傳者與管理員可查詢
+	ReportQoE(context.Context, *ReportQoEReq) (*ReportQoERes, error)
+	GetQoESummary(context.Context, *GetQoESummaryReq) (*GetQoESummaryRes, error)
+	// 檢舉與審核：會員檢舉影片，管理員認領並處理（dismiss、age_restrict、hide、remove），每個動作寫入稽核紀錄
+	ReportVideo(context.Context, *ReportVideoReq) (*ReportVideoRes, error)
+	ListReports(context.Context, *ListReportsReq) (*ListReportsRes, error)
+	ClaimReport(context.Context, *ClaimReportReq) (*ReportRes, error)
+	ResolveReport(context.Context, *ResolveReportReq) (*ReportRes, error)
+	ListModerationAudit(context.Context, *ListModerationAuditReq) (*ListModerationAuditRes, error)
+	// 影片分級：上傳者可修改未鎖定的分級，管理員覆寫後鎖定並寫入稽核紀錄
+	SetContentRating(context.Context, *SetContentRatingReq) (*SetContentRatingRes, error)
+	// 縮圖：轉碼時依場景變化與亮度挑選候選縮圖，上傳者可上傳自訂縮圖並選擇使用中的縮圖
+	ListThumbnails(context.Context, *ListThumbnailsReq) (*ListThumbnailsRes, error)
+	UploadThumbnail(context.Context, *UploadThumbnailReq) (*ThumbnailRes, error)
+	SetActiveThumbnail(context.Context, *SetActiveThumbnailReq) (*ThumbnailRes, error)
+	// 離線下載：依畫質產生 faststart MP4 並快取在 MinIO，回傳短效簽章連結；上傳者可停用下載
+	RequestDownload(context.Context, *RequestDownloadReq) (*RequestDownloadRes, error)
+	SetDownloadEnabled(context.Context, *SetDownloadEnabledReq) (*SetDownloadEnabledRes, error)
+	// 上傳配額：依會員個別設定或角色限制總儲存量、影片數與每日上傳次數，刪除影片時釋放用量
+	GetQuotaUsage(context.Context, *GetQuotaUsageReq) (*GetQuotaUsageRes, error)
+	SetMemberQuota(context.Context, *SetMemberQuotaReq) (*SetMemberQuotaRes, error)
+	DeleteVideo(context.Context, *DeleteVideoReq) (*DeleteVideoRes, error)
+	// 播放清單，playlist_id 帶入 "watch_later" 代表呼叫者的「稍後觀看」
+	CreatePlaylist(context.Context, *CreatePlaylistReq) (*CreatePlaylistRes, error)
+	UpdatePlaylist(context.Context, *UpdatePlaylistReq) (*UpdatePlaylistRes, error)
+	DeletePlaylist(context.Context, *DeletePlaylistReq) (*DeletePlaylistRes, error)
+	ListPlaylists(context.Context, *ListPlaylistsReq) (*ListPlaylistsRes, error)
+	GetPlaylist(context.Context, *GetPlaylistReq) (*GetPlaylistRes, error)
+	AddPlaylistItem(context.Context, *AddPlaylistItemReq) (*AddPlaylistItemRes, error)
+	RemovePlaylistItem(context.Context, *RemovePlaylistItemReq) (*RemovePlaylistItemRes, error)
+	ReorderPlaylist(context.Context, *ReorderPlaylistReq) (*ReorderPlaylistRes, error)
+	// 按讚 / 倒讚
+	ReactToVideo(context.Context, *ReactToVideoReq) (*ReactToVideoRes, error)
+	GetVideoReactions(context.Context, *GetVideoReactionsReq) (*GetVideoReactionsRes, error)
+	ListLikedVideos(context.Context, *ListLikedVideosReq) (*ListLikedVideosRes, error)
+	// 留言：頂層留言與一層回覆，僅能修改 / 刪除自己的留言，影片上傳者可置頂
+	PostComment(context.Context, *PostCommentReq) (*PostCommentRes, error)
+	EditComment(context.Context, *EditCommentReq) (*EditCommentRes, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error)
+	PinComment(context.Context, *PinCommentReq) (*PinCommentRes, error)
+	ListComments(context.Context, *ListCommentsReq) (*ListCommentsRes, error)
+	ListCommentReplies(context.Context, *ListCommentRepliesReq) (*ListCommentsRes, error)
+	// 熱門排行榜：依最近瀏覽、按讚、留言的時間衰減分數排序，可依分類與類型篩選
+	GetTrending(context.Context, *GetTrendingReq) (*GetTrendingRes, error)
+	// 短影音動態：不會結束、不重複的游標分頁，穿插追蹤頻道、熱門與最新的短影音
+	GetShortsFeed(context.Context, *GetShortsFeedReq) (*GetShortsFeedRes, error)
+	FollowChannel(context.Context, *FollowChannelReq) (*FollowChannelRes, error)
+	UnfollowChannel(context.Context, *FollowChannelReq) (*FollowChannelRes, error)
+	mustEmbedUnimplementedStreamingServiceServer()
+}
+
+// UnimplementedStreamingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStreamingServiceServer struct{}
+
+func (UnimplementedStreamingServiceServer) UploadVideo(grpc.ClientStreamingServer[UploadVideoReq, UploadVideoRes]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetVideo(context.Context, *GetVideoReq) (*GetVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) Search(context.Context, *SearchReq) (*SearchRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetRecommendations(context.Context, *GetRecommendationsReq) (*GetRecommendationsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetIndexM3U8(context.Context, *GetIndexM3U8Req) (*GetIndexM3U8Res, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexM3U8 not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetHlsSegment(context.Context, *GetHlsSegmentReq) (*GetHlsSegmentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHlsSegment not implemented")
+}
+func (UnimplementedStreamingServiceServer) UpdateVisibility(context.Context, *UpdateVisibilityReq) (*UpdateVisibilityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisibility not implemented")
+}
+func (UnimplementedStreamingServiceServer) ShareVideo(context.Context, *ShareVideoReq) (*ShareVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) UnshareVideo(context.Context, *UnshareVideoReq) (*UnshareVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListCategories(context.Context, *ListCategoriesReq) (*ListCategoriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedStreamingServiceServer) BrowseCategory(context.Context, *BrowseCategoryReq) (*BrowseCategoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrowseCategory not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetRelatedVideos(context.Context, *GetRelatedVideosReq) (*GetRelatedVideosRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedVideos not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetChapters(context.Context, *SetChaptersReq) (*SetChaptersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChapters not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetChaptersVTT(context.Context, *GetChaptersVTTReq) (*GetChaptersVTTRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChaptersVTT not implemented")
+}
+func (UnimplementedStreamingServiceServer) CreateClip(context.Context, *CreateClipReq) (*CreateClipRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClip not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListUploads(context.Context, *ListUploadsReq) (*ListUploadsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUploads not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetChannelWatermark(context.Context, *SetWatermarkReq) (*WatermarkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelWatermark not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetChannelWatermark(context.Context, *GetWatermarkReq) (*WatermarkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelWatermark not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetPlatformWatermark(context.Context, *SetWatermarkReq) (*WatermarkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformWatermark not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetPlatformWatermark(context.Context, *GetWatermarkReq) (*WatermarkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformWatermark not implemented")
+}
+func (UnimplementedStreamingServiceServer) ReportQoE(context.Context, *ReportQoEReq) (*ReportQoERes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQoE not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetQoESummary(context.Context, *GetQoESummaryReq) (*GetQoESummaryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQoESummary not implemented")
+}
+func (UnimplementedStreamingServiceServer) ReportVideo(context.Context, *ReportVideoReq) (*ReportVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListReports(context.Context, *ListReportsReq) (*ListReportsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedStreamingServiceServer) ClaimReport(context.Context, *ClaimReportReq) (*ReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReport not implemented")
+}
+func (UnimplementedStreamingServiceServer) ResolveReport(context.Context, *ResolveReportReq) (*ReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListModerationAudit(context.Context, *ListModerationAuditReq) (*ListModerationAuditRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationAudit not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetContentRating(context.Context, *SetContentRatingReq) (*SetContentRatingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContentRating not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListThumbnails(context.Context, *ListThumbnailsReq) (*ListThumbnailsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThumbnails not implemented")
+}
+func (UnimplementedStreamingServiceServer) UploadThumbnail(context.Context, *UploadThumbnailReq) (*ThumbnailRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadThumbnail not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetActiveThumbnail(context.Context, *SetActiveThumbnailReq) (*ThumbnailRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActiveThumbnail not implemented")
+}
+func (UnimplementedStreamingServiceServer) RequestDownload(context.Context, *RequestDownloadReq) (*RequestDownloadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDownload not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetDownloadEnabled(context.Context, *SetDownloadEnabledReq) (*SetDownloadEnabledRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDownloadEnabled not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageReq) (*GetQuotaUsageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetMemberQuota(context.Context, *SetMemberQuotaReq) (*SetMemberQuotaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberQuota not implemented")
+}
+func (UnimplementedStreamingServiceServer) DeleteVideo(context.Context, *DeleteVideoReq) (*DeleteVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) CreatePlaylist(context.Context, *CreatePlaylistReq) (*CreatePlaylistRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedStreamingServiceServer) UpdatePlaylist(context.Context, *UpdatePlaylistReq) (*UpdatePlaylistRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylist not implemented")
+}
+func (UnimplementedStreamingServiceServer) DeletePlaylist(context.Context, *DeletePlaylistReq) (*DeletePlaylistRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListPlaylists(context.Context, *ListPlaylistsReq) (*ListPlaylistsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetPlaylist(context.Context, *GetPlaylistReq) (*GetPlaylistRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedStreamingServiceServer) AddPlaylistItem(context.Context, *AddPlaylistItemReq) (*AddPlaylistItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlaylistItem not implemented")
+}
+func (UnimplementedStreamingServiceServer) RemovePlaylistItem(context.Context, *RemovePlaylistItemReq) (*RemovePlaylistItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaylistItem not implemented")
+}
+func (UnimplementedStreamingServiceServer) ReorderPlaylist(context.Context, *ReorderPlaylistReq) (*ReorderPlaylistRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPlaylist not implemented")
+}
+func (UnimplementedStreamingServiceServer) ReactToVideo(context.Context, *ReactToVideoReq) (*ReactToVideoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToVideo not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetVideoReactions(context.Context, *GetVideoReactionsReq) (*GetVideoReactionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoReactions not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListLikedVideos(context.Context, *ListLikedVideosReq) (*ListLikedVideosRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLikedVideos not implemented")
+}
+func (UnimplementedStreamingServiceServer) PostComment(context.Context, *PostCommentReq) (*PostCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostComment not implemented")
+}
+func (UnimplementedStreamingServiceServer) EditComment(context.Context, *EditCommentReq) (*EditCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedStreamingServiceServer) DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedStreamingServiceServer) PinComment(context.Context, *PinCommentReq) (*PinCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListComments(context.Context, *ListCommentsReq) (*ListCommentsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListCommentReplies(context.Context, *ListCommentRepliesReq) (*ListCommentsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentReplies not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetTrending(context.Context, *GetTrendingReq) (*GetTrendingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetShortsFeed(context.Context, *GetShortsFeedReq) (*GetShortsFeedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortsFeed not implemented")
+}
+func (UnimplementedStreamingServiceServer) FollowChannel(context.Context, *FollowChannelReq) (*FollowChannelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowChannel not implemented")
+}
+func (UnimplementedStreamingServiceServer) UnfollowChannel(context.Context, *FollowChannelReq) (*FollowChannelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowChannel not implemented")
+}
+func (UnimplementedStreamingServiceServer) mustEmbedUnimplementedStreamingServiceServer() {}
+func (UnimplementedStreamingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeStreamingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingServiceServer will
+// result in compilation errors.
+type UnsafeStreamingServiceServer interface {
 	mustEmbedUnimplementedStreamingServiceServer()
 }
 
-// UnimplementedStreamingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedStreamingServiceServer struct{}
+func RegisterStreamingServiceServer(s grpc.ServiceRegistrar, srv StreamingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStreamingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StreamingService_ServiceDesc, srv)
+}
+
+func _StreamingService_UploadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingServiceServer).UploadVideo(&grpc.GenericServerStream[UploadVideoReq, UploadVideoRes]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StreamingService_UploadVideoServer = grpc.ClientStreamingServer[UploadVideoReq, UploadVideoRes]
+
+func _StreamingService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetVideo(ctx, req.(*GetVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).Search(ctx, req.(*SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetIndexM3U8_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexM3U8Req)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetIndexM3U8(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetIndexM3U8_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetIndexM3U8(ctx, req.(*GetIndexM3U8Req))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetHlsSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHlsSegmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetHlsSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetHlsSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetHlsSegment(ctx, req.(*GetHlsSegmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_UpdateVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVisibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).UpdateVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_UpdateVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).UpdateVisibility(ctx, req.(*UpdateVisibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ShareVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ShareVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ShareVideo(ctx, req.(*ShareVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_UnshareVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).UnshareVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_UnshareVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).UnshareVideo(ctx, req.(*UnshareVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListCategories(ctx, req.(*ListCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_BrowseCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).BrowseCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_BrowseCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).BrowseCategory(ctx, req.(*BrowseCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetRelatedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedVideosReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetRelatedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetRelatedVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetRelatedVideos(ctx, req.(*GetRelatedVideosReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChaptersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetChapters(ctx, req.(*SetChaptersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetChaptersVTT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChaptersVTTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetChaptersVTT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetChaptersVTT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetChaptersVTT(ctx, req.(*GetChaptersVTTReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_CreateClip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).CreateClip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_CreateClip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).CreateClip(ctx, req.(*CreateClipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUploadsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListUploads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListUploads(ctx, req.(*ListUploadsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetChannelWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWatermarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetChannelWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetChannelWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetChannelWatermark(ctx, req.(*SetWatermarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetChannelWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatermarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetChannelWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetChannelWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetChannelWatermark(ctx, req.(*GetWatermarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetPlatformWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWatermarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetPlatformWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetPlatformWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetPlatformWatermark(ctx, req.(*SetWatermarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetPlatformWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatermarkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetPlatformWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetPlatformWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetPlatformWatermark(ctx, req.(*GetWatermarkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ReportQoE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportQoEReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ReportQoE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ReportQoE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ReportQoE(ctx, req.(*ReportQoEReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetQoESummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQoESummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetQoESummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetQoESummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetQoESummary(ctx, req.(*GetQoESummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ReportVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ReportVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ReportVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ReportVideo(ctx, req.(*ReportVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListReports(ctx, req.(*ListReportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ClaimReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ClaimReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ClaimReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ClaimReport(ctx, req.(*ClaimReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedStreamingServiceServer) UploadVideo(grpc.ClientStreamingServer[UploadVideoReq, UploadVideoRes]) error {
-	return status.Errorf(codes.Unimplemented, "method UploadVideo not implemented")
+func _StreamingService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ResolveReport(ctx, req.(*ResolveReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) GetVideo(context.Context, *GetVideoReq) (*GetVideoRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+
+func _StreamingService_ListModerationAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationAuditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListModerationAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListModerationAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListModerationAudit(ctx, req.(*ListModerationAuditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetContentRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContentRatingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetContentRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetContentRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetContentRating(ctx, req.(*SetContentRatingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListThumbnails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThumbnailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListThumbnails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListThumbnails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListThumbnails(ctx, req.(*ListThumbnailsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_UploadThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadThumbnailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).UploadThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_UploadThumbnail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).UploadThumbnail(ctx, req.(*UploadThumbnailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetActiveThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActiveThumbnailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetActiveThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetActiveThumbnail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetActiveThumbnail(ctx, req.(*SetActiveThumbnailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_RequestDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDownloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).RequestDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_RequestDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).RequestDownload(ctx, req.(*RequestDownloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetDownloadEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDownloadEnabledReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetDownloadEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetDownloadEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetDownloadEnabled(ctx, req.(*SetDownloadEnabledReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetMemberQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetMemberQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetMemberQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetMemberQuota(ctx, req.(*SetMemberQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).DeleteVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_DeleteVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).DeleteVideo(ctx, req.(*DeleteVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_CreatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_UpdatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).UpdatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_UpdatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).UpdatePlaylist(ctx, req.(*UpdatePlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_DeletePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).DeletePlaylist(ctx, req.(*DeletePlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListPlaylists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListPlaylists(ctx, req.(*ListPlaylistsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetPlaylist(ctx, req.(*GetPlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_AddPlaylistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlaylistItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).AddPlaylistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_AddPlaylistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).AddPlaylistItem(ctx, req.(*AddPlaylistItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_RemovePlaylistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlaylistItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).RemovePlaylistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_RemovePlaylistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).RemovePlaylistItem(ctx, req.(*RemovePlaylistItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ReorderPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ReorderPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ReorderPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ReorderPlaylist(ctx, req.(*ReorderPlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) Search(context.Context, *SearchReq) (*SearchRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+
+func _StreamingService_ReactToVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToVideoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ReactToVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ReactToVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ReactToVideo(ctx, req.(*ReactToVideoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) GetRecommendations(context.Context, *GetRecommendationsReq) (*GetRecommendationsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+
+func _StreamingService_GetVideoReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoReactionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetVideoReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetVideoReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetVideoReactions(ctx, req.(*GetVideoReactionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) GetIndexM3U8(context.Context, *GetIndexM3U8Req) (*GetIndexM3U8Res, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIndexM3U8 not implemented")
+
+func _StreamingService_ListLikedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLikedVideosReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListLikedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListLikedVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListLikedVideos(ctx, req.(*ListLikedVideosReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) GetHlsSegment(context.Context, *GetHlsSegmentReq) (*GetHlsSegmentRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHlsSegment not implemented")
+
+func _StreamingService_PostComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).PostComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_PostComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).PostComment(ctx, req.(*PostCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingServiceServer) mustEmbedUnimplementedStreamingServiceServer() {}
-func (UnimplementedStreamingServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeStreamingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StreamingServiceServer will
-// result in compilation errors.
-type UnsafeStreamingServiceServer interface {
-	mustEmbedUnimplementedStreamingServiceServer()
+func _StreamingService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).EditComment(ctx, req.(*EditCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStreamingServiceServer(s grpc.ServiceRegistrar, srv StreamingServiceServer) {
-	// If the following call pancis, it indicates UnimplementedStreamingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _StreamingService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&StreamingService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingService_UploadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(StreamingServiceServer).UploadVideo(&grpc.GenericServerStream[UploadVideoReq, UploadVideoRes]{ServerStream: stream})
+func _StreamingService_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_PinComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).PinComment(ctx, req.(*PinCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type StreamingService_UploadVideoServer = grpc.ClientStreamingServer[UploadVideoReq, UploadVideoRes]
+func _StreamingService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListComments(ctx, req.(*ListCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _StreamingService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoReq)
+func _StreamingService_ListCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRepliesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingServiceServer).GetVideo(ctx, in)
+		return srv.(StreamingServiceServer).ListCommentReplies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingService_GetVideo_FullMethodName,
+		FullMethod: StreamingService_ListCommentReplies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingServiceServer).GetVideo(ctx, req.(*GetVideoReq))
+		return srv.(StreamingServiceServer).ListCommentReplies(ctx, req.(*ListCommentRepliesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchReq)
+func _StreamingService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingServiceServer).Search(ctx, in)
+		return srv.(StreamingServiceServer).GetTrending(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingService_Search_FullMethodName,
+		FullMethod: StreamingService_GetTrending_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingServiceServer).Search(ctx, req.(*SearchReq))
+		return srv.(StreamingServiceServer).GetTrending(ctx, req.(*GetTrendingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecommendationsReq)
+func _StreamingService_GetShortsFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortsFeedReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingServiceServer).GetRecommendations(ctx, in)
+		return srv.(StreamingServiceServer).GetShortsFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingService_GetRecommendations_FullMethodName,
+		FullMethod: StreamingService_GetShortsFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsReq))
+		return srv.(StreamingServiceServer).GetShortsFeed(ctx, req.(*GetShortsFeedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingService_GetIndexM3U8_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexM3U8Req)
+func _StreamingService_FollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingServiceServer).GetIndexM3U8(ctx, in)
+		return srv.(StreamingServiceServer).FollowChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingService_GetIndexM3U8_FullMethodName,
+		FullMethod: StreamingService_FollowChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingServiceServer).GetIndexM3U8(ctx, req.(*GetIndexM3U8Req))
+		return srv.(StreamingServiceServer).FollowChannel(ctx, req.(*FollowChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingService_GetHlsSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHlsSegmentReq)
+func _StreamingService_UnfollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingServiceServer).GetHlsSegment(ctx, in)
+		return srv.(StreamingServiceServer).UnfollowChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingService_GetHlsSegment_FullMethodName,
+		FullMethod: StreamingService_UnfollowChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingServiceServer).GetHlsSegment(ctx, req.(*GetHlsSegmentReq))
+		return srv.(StreamingServiceServer).UnfollowChannel(ctx, req.(*FollowChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -313,6 +2079,210 @@ var StreamingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHlsSegment",
 			Handler:    _StreamingService_GetHlsSegment_Handler,
 		},
+		{
+			MethodName: "UpdateVisibility",
+			Handler:    _StreamingService_UpdateVisibility_Handler,
+		},
+		{
+			MethodName: "ShareVideo",
+			Handler:    _StreamingService_ShareVideo_Handler,
+		},
+		{
+			MethodName: "UnshareVideo",
+			Handler:    _StreamingService_UnshareVideo_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _StreamingService_ListCategories_Handler,
+		},
+		{
+			MethodName: "BrowseCategory",
+			Handler:    _StreamingService_BrowseCategory_Handler,
+		},
+		{
+			MethodName: "GetRelatedVideos",
+			Handler:    _StreamingService_GetRelatedVideos_Handler,
+		},
+		{
+			MethodName: "SetChapters",
+			Handler:    _StreamingService_SetChapters_Handler,
+		},
+		{
+			MethodName: "GetChaptersVTT",
+			Handler:    _StreamingService_GetChaptersVTT_Handler,
+		},
+		{
+			MethodName: "CreateClip",
+			Handler:    _StreamingService_CreateClip_Handler,
+		},
+		{
+			MethodName: "ListUploads",
+			Handler:    _StreamingService_ListUploads_Handler,
+		},
+		{
+			MethodName: "SetChannelWatermark",
+			Handler:    _StreamingService_SetChannelWatermark_Handler,
+		},
+		{
+			MethodName: "GetChannelWatermark",
+			Handler:    _StreamingService_GetChannelWatermark_Handler,
+		},
+		{
+			MethodName: "SetPlatformWatermark",
+			Handler:    _StreamingService_SetPlatformWatermark_Handler,
+		},
+		{
+			MethodName: "GetPlatformWatermark",
+			Handler:    _StreamingService_GetPlatformWatermark_Handler,
+		},
+		{
+			MethodName: "ReportQoE",
+			Handler:    _StreamingService_ReportQoE_Handler,
+		},
+		{
+			MethodName: "GetQoESummary",
+			Handler:    _StreamingService_GetQoESummary_Handler,
+		},
+		{
+			MethodName: "ReportVideo",
+			Handler:    _StreamingService_ReportVideo_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _StreamingService_ListReports_Handler,
+		},
+		{
+			MethodName: "ClaimReport",
+			Handler:    _StreamingService_ClaimReport_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _StreamingService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "ListModerationAudit",
+			Handler:    _StreamingService_ListModerationAudit_Handler,
+		},
+		{
+			MethodName: "SetContentRating",
+			Handler:    _StreamingService_SetContentRating_Handler,
+		},
+		{
+			MethodName: "ListThumbnails",
+			Handler:    _StreamingService_ListThumbnails_Handler,
+		},
+		{
+			MethodName: "UploadThumbnail",
+			Handler:    _StreamingService_UploadThumbnail_Handler,
+		},
+		{
+			MethodName: "SetActiveThumbnail",
+			Handler:    _StreamingService_SetActiveThumbnail_Handler,
+		},
+		{
+			MethodName: "RequestDownload",
+			Handler:    _StreamingService_RequestDownload_Handler,
+		},
+		{
+			MethodName: "SetDownloadEnabled",
+			Handler:    _StreamingService_SetDownloadEnabled_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _StreamingService_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "SetMemberQuota",
+			Handler:    _StreamingService_SetMemberQuota_Handler,
+		},
+		{
+			MethodName: "DeleteVideo",
+			Handler:    _StreamingService_DeleteVideo_Handler,
+		},
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _StreamingService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "UpdatePlaylist",
+			Handler:    _StreamingService_UpdatePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _StreamingService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _StreamingService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _StreamingService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "AddPlaylistItem",
+			Handler:    _StreamingService_AddPlaylistItem_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistItem",
+			Handler:    _StreamingService_RemovePlaylistItem_Handler,
+		},
+		{
+			MethodName: "ReorderPlaylist",
+			Handler:    _StreamingService_ReorderPlaylist_Handler,
+		},
+		{
+			MethodName: "ReactToVideo",
+			Handler:    _StreamingService_ReactToVideo_Handler,
+		},
+		{
+			MethodName: "GetVideoReactions",
+			Handler:    _StreamingService_GetVideoReactions_Handler,
+		},
+		{
+			MethodName: "ListLikedVideos",
+			Handler:    _StreamingService_ListLikedVideos_Handler,
+		},
+		{
+			MethodName: "PostComment",
+			Handler:    _StreamingService_PostComment_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _StreamingService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _StreamingService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _StreamingService_PinComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _StreamingService_ListComments_Handler,
+		},
+		{
+			MethodName: "ListCommentReplies",
+			Handler:    _StreamingService_ListCommentReplies_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _StreamingService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetShortsFeed",
+			Handler:    _StreamingService_GetShortsFeed_Handler,
+		},
+		{
+			MethodName: "FollowChannel",
+			Handler:    _StreamingService_FollowChannel_Handler,
+		},
+		{
+			MethodName: "UnfollowChannel",
+			Handler:    _StreamingService_UnfollowChannel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -61,8 +61,8 @@ var (
 // 	3.	不要頻繁變更這些函數變數，避免影響 Go 內部優化。
 
 // GenerateJWTWrapper 讓 `memberUseCase` test mock使用這個包裝函數
-func GenerateJWTWrapper(memberID, role string) (string, error) {
-	return GenerateJWTFunc(memberID, role, config.EnvConfig.MemberService)
+func GenerateJWTWrapper(memberID, role string, age AgeClaims) (string, error) {
+	return GenerateJWTFunc(memberID, role, config.EnvConfig.MemberService, age)
 }
 
 // ParseJWTWrapper 讓 `memberUseCase`  test mock使用這個包裝函數
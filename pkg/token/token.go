@@ -15,16 +15,25 @@ const (
 	RoleAdmin RoleType = "admin"
 	// RoleUser is the user role
 	RoleUser RoleType = "user"
-	// RoleMember is the member role
+	// RoleMember is the member role（免費方案）
 	RoleMember RoleType = "member"
+	// RolePremium is the paid member role
+	RolePremium RoleType = "premium"
 	// RoleGuest is the guest role
 	RoleGuest RoleType = "guest"
 )
 
+// AgeClaims 會員的年齡資料，用於分級內容的觀看限制
+type AgeClaims struct {
+	Birthdate   string `json:"birthdate,omitempty"`    // YYYY-MM-DD，未設定為空值
+	AgeVerified bool   `json:"age_verified,omitempty"` // 已驗證成年
+}
+
 // Claims structure for custom claims in JWT
 type Claims struct {
 	MemberID string `json:"user_id"`
 	Role     string `json:"role"`
+	AgeClaims
 	jwt.RegisteredClaims
 }
 
@@ -35,10 +44,11 @@ var (
 )
 
 // GenerateJWT generates a JWT token
-func GenerateJWT(memberID, role, issuer string) (string, error) {
+func GenerateJWT(memberID, role, issuer string, age AgeClaims) (string, error) {
 	claims := Claims{
-		MemberID: memberID,
-		Role:     role,
+		MemberID:  memberID,
+		Role:      role,
+		AgeClaims: age,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenExpiration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),